@@ -0,0 +1,147 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BlockID identifies a beacon block, either by a well-known name, a slot or a block root.
+// The zero value is not a valid block ID.
+type BlockID struct {
+	idType idType
+	slot   spec.Slot
+	root   spec.Root
+}
+
+// BlockIDHead is the block ID for the block at the head of the chain.
+func BlockIDHead() BlockID {
+	return BlockID{idType: idTypeHead}
+}
+
+// BlockIDGenesis is the block ID for the genesis block.
+func BlockIDGenesis() BlockID {
+	return BlockID{idType: idTypeGenesis}
+}
+
+// BlockIDFinalized is the block ID for the latest finalized checkpoint block.
+func BlockIDFinalized() BlockID {
+	return BlockID{idType: idTypeFinalized}
+}
+
+// BlockIDJustified is the block ID for the latest justified checkpoint block.
+// This is not part of the standard API, so implementations resolve it from the chain's finality.
+func BlockIDJustified() BlockID {
+	return BlockID{idType: idTypeJustified}
+}
+
+// BlockIDSlot is the block ID for the block at the given slot.
+func BlockIDSlot(slot spec.Slot) BlockID {
+	return BlockID{idType: idTypeSlot, slot: slot}
+}
+
+// BlockIDRoot is the block ID for the block with the given block root.
+func BlockIDRoot(root spec.Root) BlockID {
+	return BlockID{idType: idTypeRoot, root: root}
+}
+
+// ParseBlockID parses a block ID in its API representation.
+func ParseBlockID(input string) (BlockID, error) {
+	idType, slot, root, err := parseID(input)
+	if err != nil {
+		return BlockID{}, err
+	}
+	return BlockID{idType: idType, slot: slot, root: root}, nil
+}
+
+// IsValid returns true if the block ID has been set.
+func (b BlockID) IsValid() bool {
+	return b.idType != idTypeUnknown
+}
+
+// IsHead returns true if the block ID is for the head block.
+func (b BlockID) IsHead() bool {
+	return b.idType == idTypeHead
+}
+
+// IsGenesis returns true if the block ID is for the genesis block.
+func (b BlockID) IsGenesis() bool {
+	return b.idType == idTypeGenesis
+}
+
+// IsFinalized returns true if the block ID is for the latest finalized checkpoint block.
+func (b BlockID) IsFinalized() bool {
+	return b.idType == idTypeFinalized
+}
+
+// IsJustified returns true if the block ID is for the latest justified checkpoint block.
+func (b BlockID) IsJustified() bool {
+	return b.idType == idTypeJustified
+}
+
+// Slot returns the slot of the block ID, and true if the block ID is for a slot.
+func (b BlockID) Slot() (spec.Slot, bool) {
+	return b.slot, b.idType == idTypeSlot
+}
+
+// Root returns the block root of the block ID, and true if the block ID is for a block root.
+func (b BlockID) Root() (spec.Root, bool) {
+	return b.root, b.idType == idTypeRoot
+}
+
+// String returns the API representation of the block ID.
+func (b BlockID) String() string {
+	return formatID(b.idType, b.slot, b.root)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b BlockID) MarshalJSON() ([]byte, error) {
+	if !b.IsValid() {
+		return nil, errors.New("invalid block ID")
+	}
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BlockID) UnmarshalJSON(input []byte) error {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	blockID, err := ParseBlockID(data)
+	if err != nil {
+		return err
+	}
+	*b = blockID
+	return nil
+}
+
+// ResolvedBlockID is a block ID resolved to concrete values.
+type ResolvedBlockID struct {
+	// Slot is the slot of the block.
+	Slot spec.Slot
+	// Epoch is the epoch of the block.
+	Epoch spec.Epoch
+	// Root is the root of the block.
+	Root spec.Root
+}
+
+// String returns a string version of the structure.
+func (r *ResolvedBlockID) String() string {
+	return fmt.Sprintf("slot %d epoch %d root %#x", r.Slot, r.Epoch, r.Root)
+}
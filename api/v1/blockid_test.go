@@ -0,0 +1,117 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestParseBlockID(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected api.BlockID
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "no ID supplied",
+		},
+		{
+			name:     "Head",
+			input:    "head",
+			expected: api.BlockIDHead(),
+		},
+		{
+			name:     "Genesis",
+			input:    "genesis",
+			expected: api.BlockIDGenesis(),
+		},
+		{
+			name:     "Finalized",
+			input:    "finalized",
+			expected: api.BlockIDFinalized(),
+		},
+		{
+			name:     "Justified",
+			input:    "justified",
+			expected: api.BlockIDJustified(),
+		},
+		{
+			name:     "Slot",
+			input:    "0",
+			expected: api.BlockIDSlot(0),
+		},
+		{
+			name:     "Root",
+			input:    "0x0100000000000000000000000000000000000000000000000000000000000000",
+			expected: api.BlockIDRoot(spec.Root{0x01}),
+		},
+		{
+			name:  "RootLong",
+			input: "0x010000000000000000000000000000000000000000000000000000000000000000",
+			err:   "incorrect length 33 for root",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.ParseBlockID(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.False(t, res.IsValid())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.IsValid())
+			require.Equal(t, test.expected, res)
+			require.Equal(t, test.input, res.String())
+		})
+	}
+}
+
+func TestBlockIDAccessors(t *testing.T) {
+	require.False(t, api.BlockID{}.IsValid())
+	require.True(t, api.BlockIDHead().IsHead())
+	require.True(t, api.BlockIDGenesis().IsGenesis())
+	require.True(t, api.BlockIDFinalized().IsFinalized())
+	require.True(t, api.BlockIDJustified().IsJustified())
+
+	slot, isSlot := api.BlockIDSlot(5).Slot()
+	require.True(t, isSlot)
+	require.Equal(t, spec.Slot(5), slot)
+
+	root, isRoot := api.BlockIDRoot(spec.Root{0x01}).Root()
+	require.True(t, isRoot)
+	require.Equal(t, spec.Root{0x01}, root)
+}
+
+func TestBlockIDJSON(t *testing.T) {
+	var res api.BlockID
+	require.NoError(t, json.Unmarshal([]byte(`"12"`), &res))
+	require.Equal(t, api.BlockIDSlot(12), res)
+	rt, err := json.Marshal(res)
+	require.NoError(t, err)
+	require.Equal(t, `"12"`, string(rt))
+
+	require.EqualError(t, json.Unmarshal([]byte(`true`), &res), "invalid JSON: json: cannot unmarshal bool into Go value of type string")
+	_, err = json.Marshal(api.BlockID{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid block ID")
+}
@@ -0,0 +1,562 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ChainSpec is the typed specification of a chain, covering the phase0 preset and configuration values.
+type ChainSpec struct {
+	// ConfigName is the name of the configuration, for example "mainnet".
+	ConfigName string
+
+	// Genesis.
+	MinGenesisActiveValidatorCount uint64
+	MinGenesisTime                 time.Time
+	GenesisForkVersion             spec.Version
+	GenesisDelay                   time.Duration
+
+	// Time.
+	SecondsPerSlot                   time.Duration
+	SecondsPerETH1Block              time.Duration
+	SlotsPerEpoch                    uint64
+	MinAttestationInclusionDelay     uint64
+	MinSeedLookahead                 uint64
+	MaxSeedLookahead                 uint64
+	EpochsPerETH1VotingPeriod        uint64
+	SlotsPerHistoricalRoot           uint64
+	MinValidatorWithdrawabilityDelay uint64
+	ShardCommitteePeriod             uint64
+	MinEpochsToInactivityPenalty     uint64
+	ETH1FollowDistance               uint64
+	SafeSlotsToUpdateJustified       uint64
+
+	// Committees and validator churn.
+	MaxCommitteesPerSlot         uint64
+	TargetCommitteeSize          uint64
+	MaxValidatorsPerCommittee    uint64
+	ShuffleRoundCount            uint64
+	MinPerEpochChurnLimit        uint64
+	ChurnLimitQuotient           uint64
+	HysteresisQuotient           uint64
+	HysteresisDownwardMultiplier uint64
+	HysteresisUpwardMultiplier   uint64
+
+	// State list lengths.
+	EpochsPerHistoricalVector uint64
+	EpochsPerSlashingsVector  uint64
+	HistoricalRootsLimit      uint64
+	ValidatorRegistryLimit    uint64
+
+	// Gwei values.
+	MinDepositAmount          spec.Gwei
+	MaxEffectiveBalance       spec.Gwei
+	EjectionBalance           spec.Gwei
+	EffectiveBalanceIncrement spec.Gwei
+
+	// Rewards and penalties.
+	BaseRewardFactor               uint64
+	WhistleblowerRewardQuotient    uint64
+	ProposerRewardQuotient         uint64
+	InactivityPenaltyQuotient      uint64
+	MinSlashingPenaltyQuotient     uint64
+	ProportionalSlashingMultiplier uint64
+
+	// Maximum operations per block.
+	MaxProposerSlashings uint64
+	MaxAttesterSlashings uint64
+	MaxAttestations      uint64
+	MaxDeposits          uint64
+	MaxVoluntaryExits    uint64
+
+	// Domain types.
+	DomainBeaconProposer    spec.DomainType
+	DomainBeaconAttester    spec.DomainType
+	DomainRANDAO            spec.DomainType
+	DomainDeposit           spec.DomainType
+	DomainVoluntaryExit     spec.DomainType
+	DomainSelectionProof    spec.DomainType
+	DomainAggregateAndProof spec.DomainType
+
+	// Validator.
+	TargetAggregatorsPerCommittee     uint64
+	RandomSubnetsPerValidator         uint64
+	EpochsPerRandomSubnetSubscription uint64
+
+	// Deposit contract.
+	DepositChainID         uint64
+	DepositNetworkID       uint64
+	DepositContractAddress []byte
+	BLSWithdrawalPrefix    []byte
+
+	// Extra contains values for keys that are not known, as supplied.
+	Extra map[string]interface{}
+}
+
+// chainSpecField links a spec key to its field in the chain spec.
+type chainSpecField struct {
+	key      string
+	required bool
+	// value is a pointer to the field.
+	value interface{}
+}
+
+// fields returns the keys and fields of the chain spec.
+func (c *ChainSpec) fields() []*chainSpecField {
+	return []*chainSpecField{
+		{key: "CONFIG_NAME", value: &c.ConfigName},
+		{key: "MIN_GENESIS_ACTIVE_VALIDATOR_COUNT", required: true, value: &c.MinGenesisActiveValidatorCount},
+		{key: "MIN_GENESIS_TIME", required: true, value: &c.MinGenesisTime},
+		{key: "GENESIS_FORK_VERSION", required: true, value: &c.GenesisForkVersion},
+		{key: "GENESIS_DELAY", required: true, value: &c.GenesisDelay},
+		{key: "SECONDS_PER_SLOT", required: true, value: &c.SecondsPerSlot},
+		{key: "SECONDS_PER_ETH1_BLOCK", required: true, value: &c.SecondsPerETH1Block},
+		{key: "SLOTS_PER_EPOCH", required: true, value: &c.SlotsPerEpoch},
+		{key: "MIN_ATTESTATION_INCLUSION_DELAY", required: true, value: &c.MinAttestationInclusionDelay},
+		{key: "MIN_SEED_LOOKAHEAD", required: true, value: &c.MinSeedLookahead},
+		{key: "MAX_SEED_LOOKAHEAD", required: true, value: &c.MaxSeedLookahead},
+		{key: "EPOCHS_PER_ETH1_VOTING_PERIOD", required: true, value: &c.EpochsPerETH1VotingPeriod},
+		{key: "SLOTS_PER_HISTORICAL_ROOT", required: true, value: &c.SlotsPerHistoricalRoot},
+		{key: "MIN_VALIDATOR_WITHDRAWABILITY_DELAY", required: true, value: &c.MinValidatorWithdrawabilityDelay},
+		{key: "SHARD_COMMITTEE_PERIOD", required: true, value: &c.ShardCommitteePeriod},
+		{key: "MIN_EPOCHS_TO_INACTIVITY_PENALTY", required: true, value: &c.MinEpochsToInactivityPenalty},
+		{key: "ETH1_FOLLOW_DISTANCE", required: true, value: &c.ETH1FollowDistance},
+		{key: "SAFE_SLOTS_TO_UPDATE_JUSTIFIED", required: true, value: &c.SafeSlotsToUpdateJustified},
+		{key: "MAX_COMMITTEES_PER_SLOT", required: true, value: &c.MaxCommitteesPerSlot},
+		{key: "TARGET_COMMITTEE_SIZE", required: true, value: &c.TargetCommitteeSize},
+		{key: "MAX_VALIDATORS_PER_COMMITTEE", required: true, value: &c.MaxValidatorsPerCommittee},
+		{key: "SHUFFLE_ROUND_COUNT", required: true, value: &c.ShuffleRoundCount},
+		{key: "MIN_PER_EPOCH_CHURN_LIMIT", required: true, value: &c.MinPerEpochChurnLimit},
+		{key: "CHURN_LIMIT_QUOTIENT", required: true, value: &c.ChurnLimitQuotient},
+		{key: "HYSTERESIS_QUOTIENT", required: true, value: &c.HysteresisQuotient},
+		{key: "HYSTERESIS_DOWNWARD_MULTIPLIER", required: true, value: &c.HysteresisDownwardMultiplier},
+		{key: "HYSTERESIS_UPWARD_MULTIPLIER", required: true, value: &c.HysteresisUpwardMultiplier},
+		{key: "EPOCHS_PER_HISTORICAL_VECTOR", required: true, value: &c.EpochsPerHistoricalVector},
+		{key: "EPOCHS_PER_SLASHINGS_VECTOR", required: true, value: &c.EpochsPerSlashingsVector},
+		{key: "HISTORICAL_ROOTS_LIMIT", required: true, value: &c.HistoricalRootsLimit},
+		{key: "VALIDATOR_REGISTRY_LIMIT", required: true, value: &c.ValidatorRegistryLimit},
+		{key: "MIN_DEPOSIT_AMOUNT", required: true, value: &c.MinDepositAmount},
+		{key: "MAX_EFFECTIVE_BALANCE", required: true, value: &c.MaxEffectiveBalance},
+		{key: "EJECTION_BALANCE", required: true, value: &c.EjectionBalance},
+		{key: "EFFECTIVE_BALANCE_INCREMENT", required: true, value: &c.EffectiveBalanceIncrement},
+		{key: "BASE_REWARD_FACTOR", required: true, value: &c.BaseRewardFactor},
+		{key: "WHISTLEBLOWER_REWARD_QUOTIENT", required: true, value: &c.WhistleblowerRewardQuotient},
+		{key: "PROPOSER_REWARD_QUOTIENT", required: true, value: &c.ProposerRewardQuotient},
+		{key: "INACTIVITY_PENALTY_QUOTIENT", required: true, value: &c.InactivityPenaltyQuotient},
+		{key: "MIN_SLASHING_PENALTY_QUOTIENT", required: true, value: &c.MinSlashingPenaltyQuotient},
+		{key: "PROPORTIONAL_SLASHING_MULTIPLIER", required: true, value: &c.ProportionalSlashingMultiplier},
+		{key: "MAX_PROPOSER_SLASHINGS", required: true, value: &c.MaxProposerSlashings},
+		{key: "MAX_ATTESTER_SLASHINGS", required: true, value: &c.MaxAttesterSlashings},
+		{key: "MAX_ATTESTATIONS", required: true, value: &c.MaxAttestations},
+		{key: "MAX_DEPOSITS", required: true, value: &c.MaxDeposits},
+		{key: "MAX_VOLUNTARY_EXITS", required: true, value: &c.MaxVoluntaryExits},
+		{key: "DOMAIN_BEACON_PROPOSER", required: true, value: &c.DomainBeaconProposer},
+		{key: "DOMAIN_BEACON_ATTESTER", required: true, value: &c.DomainBeaconAttester},
+		{key: "DOMAIN_RANDAO", required: true, value: &c.DomainRANDAO},
+		{key: "DOMAIN_DEPOSIT", required: true, value: &c.DomainDeposit},
+		{key: "DOMAIN_VOLUNTARY_EXIT", required: true, value: &c.DomainVoluntaryExit},
+		{key: "DOMAIN_SELECTION_PROOF", required: true, value: &c.DomainSelectionProof},
+		{key: "DOMAIN_AGGREGATE_AND_PROOF", required: true, value: &c.DomainAggregateAndProof},
+		{key: "TARGET_AGGREGATORS_PER_COMMITTEE", required: true, value: &c.TargetAggregatorsPerCommittee},
+		{key: "RANDOM_SUBNETS_PER_VALIDATOR", value: &c.RandomSubnetsPerValidator},
+		{key: "EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION", value: &c.EpochsPerRandomSubnetSubscription},
+		{key: "DEPOSIT_CHAIN_ID", value: &c.DepositChainID},
+		{key: "DEPOSIT_NETWORK_ID", value: &c.DepositNetworkID},
+		{key: "DEPOSIT_CONTRACT_ADDRESS", value: &c.DepositContractAddress},
+		{key: "BLS_WITHDRAWAL_PREFIX", value: &c.BLSWithdrawalPrefix},
+	}
+}
+
+// NewChainSpec creates a chain spec from spec values, as returned by a SpecProvider.
+// Values may be either typed, as returned by the providers in this module, or strings as
+// returned by the standard API and found in configuration files.
+// Values for unknown keys are kept in Extra.
+func NewChainSpec(values map[string]interface{}) (*ChainSpec, error) {
+	c := &ChainSpec{
+		Extra: make(map[string]interface{}),
+	}
+	fields := make(map[string]*chainSpecField)
+	for _, field := range c.fields() {
+		fields[field.key] = field
+	}
+
+	for k, v := range values {
+		field, exists := fields[k]
+		if !exists {
+			c.Extra[k] = v
+			continue
+		}
+		if err := setChainSpecValue(field.value, v); err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", k)
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, field := range c.fields() {
+		if _, exists := values[field.key]; field.required && !exists {
+			missing = append(missing, field.key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing required values: %s", strings.Join(missing, ", "))
+	}
+
+	if c.SlotsPerEpoch == 0 {
+		return nil, errors.New("SLOTS_PER_EPOCH cannot be 0")
+	}
+	if c.SecondsPerSlot == 0 {
+		return nil, errors.New("SECONDS_PER_SLOT cannot be 0")
+	}
+
+	return c, nil
+}
+
+// SpecValues converts the string values of a spec, as returned by the standard API and
+// found in configuration files, to the types returned by the SpecProviders in this module.
+func SpecValues(data map[string]string) map[string]interface{} {
+	values := make(map[string]interface{})
+	for k, v := range data {
+		// Handle domains.
+		if strings.HasPrefix(k, "DOMAIN_") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				var domainType spec.DomainType
+				copy(domainType[:], byteVal)
+				values[k] = domainType
+				continue
+			}
+		}
+
+		// Handle fork versions.
+		if strings.HasSuffix(k, "_FORK_VERSION") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				var version spec.Version
+				copy(version[:], byteVal)
+				values[k] = version
+				continue
+			}
+		}
+
+		// Handle hex strings.
+		if strings.HasPrefix(v, "0x") {
+			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
+			if err == nil {
+				values[k] = byteVal
+				continue
+			}
+		}
+
+		// Handle times.
+		if strings.HasSuffix(k, "_TIME") {
+			intVal, err := strconv.ParseInt(v, 10, 64)
+			if err == nil && intVal != 0 {
+				values[k] = time.Unix(intVal, 0)
+				continue
+			}
+		}
+
+		// Handle durations.
+		if strings.HasPrefix(k, "SECONDS_PER_") || strings.HasSuffix(k, "_DELAY") {
+			intVal, err := strconv.ParseUint(v, 10, 64)
+			if err == nil && intVal != 0 {
+				values[k] = time.Duration(intVal) * time.Second
+				continue
+			}
+		}
+
+		// Handle integers.
+		intVal, err := strconv.ParseUint(v, 10, 64)
+		if err == nil {
+			values[k] = intVal
+			continue
+		}
+
+		// Assume string.
+		values[k] = v
+	}
+
+	return values
+}
+
+// SpecUint64 obtains an integer value from spec values, as returned by a SpecProvider.
+// Values are converted as they are by NewChainSpec, so durations are treated as a number of seconds.
+func SpecUint64(values map[string]interface{}, key string) (uint64, error) {
+	value, exists := values[key]
+	if !exists {
+		return 0, fmt.Errorf("%s not found in spec", key)
+	}
+	val, err := chainSpecUint64(value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid value for %s", key)
+	}
+	return val, nil
+}
+
+// SpecDomainType obtains a domain type value from spec values, as returned by a SpecProvider.
+func SpecDomainType(values map[string]interface{}, key string) (spec.DomainType, error) {
+	var domainType spec.DomainType
+	if err := specFixedBytes(values, key, domainType[:]); err != nil {
+		return spec.DomainType{}, err
+	}
+	return domainType, nil
+}
+
+// SpecVersion obtains a fork version value from spec values, as returned by a SpecProvider.
+func SpecVersion(values map[string]interface{}, key string) (spec.Version, error) {
+	var version spec.Version
+	if err := specFixedBytes(values, key, version[:]); err != nil {
+		return spec.Version{}, err
+	}
+	return version, nil
+}
+
+// specFixedBytes obtains a byte array value of the length of res from spec values, copying it in to res.
+func specFixedBytes(values map[string]interface{}, key string, res []byte) error {
+	value, exists := values[key]
+	if !exists {
+		return fmt.Errorf("%s not found in spec", key)
+	}
+	val, err := chainSpecBytes(value, len(res))
+	if err != nil {
+		return errors.Wrapf(err, "invalid value for %s", key)
+	}
+	copy(res, val)
+	return nil
+}
+
+// setChainSpecValue sets a chain spec field from a spec value.
+// nolint:gocyclo
+func setChainSpecValue(field interface{}, value interface{}) error {
+	switch f := field.(type) {
+	case *uint64:
+		val, err := chainSpecUint64(value)
+		if err != nil {
+			return err
+		}
+		*f = val
+	case *spec.Gwei:
+		val, err := chainSpecUint64(value)
+		if err != nil {
+			return err
+		}
+		*f = spec.Gwei(val)
+	case *time.Duration:
+		if val, isDuration := value.(time.Duration); isDuration {
+			*f = val
+			return nil
+		}
+		val, err := chainSpecUint64(value)
+		if err != nil {
+			return err
+		}
+		*f = time.Duration(val) * time.Second
+	case *time.Time:
+		if val, isTime := value.(time.Time); isTime {
+			*f = val
+			return nil
+		}
+		val, err := chainSpecUint64(value)
+		if err != nil {
+			return err
+		}
+		*f = time.Unix(int64(val), 0)
+	case *spec.DomainType:
+		val, err := chainSpecBytes(value, 4)
+		if err != nil {
+			return err
+		}
+		copy(f[:], val)
+	case *spec.Version:
+		val, err := chainSpecBytes(value, 4)
+		if err != nil {
+			return err
+		}
+		copy(f[:], val)
+	case *[]byte:
+		val, err := chainSpecBytes(value, -1)
+		if err != nil {
+			return err
+		}
+		*f = val
+	case *string:
+		val, isString := value.(string)
+		if !isString {
+			return fmt.Errorf("unexpected type %T", value)
+		}
+		*f = val
+	default:
+		return fmt.Errorf("unhandled field type %T", field)
+	}
+
+	return nil
+}
+
+// chainSpecUint64 obtains an integer from a spec value.
+// Durations are treated as a number of seconds, as spec providers return some counts of slots
+// and epochs as durations.
+func chainSpecUint64(value interface{}) (uint64, error) {
+	switch val := value.(type) {
+	case uint64:
+		return val, nil
+	case int:
+		if val < 0 {
+			return 0, fmt.Errorf("negative value %d", val)
+		}
+		return uint64(val), nil
+	case time.Duration:
+		return uint64(val / time.Second), nil
+	case string:
+		return strconv.ParseUint(val, 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected type %T", value)
+	}
+}
+
+// chainSpecBytes obtains a byte array from a spec value.
+// If length is not negative the byte array must be of the given length.
+func chainSpecBytes(value interface{}, length int) ([]byte, error) {
+	var res []byte
+	switch val := value.(type) {
+	case []byte:
+		res = val
+	case spec.DomainType:
+		res = val[:]
+	case spec.Version:
+		res = val[:]
+	case string:
+		var err error
+		if res, err = hex.DecodeString(strings.TrimPrefix(val, "0x")); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+	if length >= 0 && len(res) != length {
+		return nil, fmt.Errorf("incorrect length %d", len(res))
+	}
+	return res, nil
+}
+
+// chainSpecString returns the string representation of a spec value, as used by the standard API.
+func chainSpecString(value interface{}) string {
+	switch val := value.(type) {
+	case *uint64:
+		return fmt.Sprintf("%d", *val)
+	case *spec.Gwei:
+		return fmt.Sprintf("%d", *val)
+	case *time.Duration:
+		return fmt.Sprintf("%d", *val/time.Second)
+	case *time.Time:
+		if val.IsZero() {
+			return "0"
+		}
+		return fmt.Sprintf("%d", val.Unix())
+	case *spec.DomainType:
+		return fmt.Sprintf("%#x", *val)
+	case *spec.Version:
+		return fmt.Sprintf("%#x", *val)
+	case *[]byte:
+		return fmt.Sprintf("%#x", *val)
+	case *string:
+		return *val
+	case string:
+		return val
+	case []byte:
+		return fmt.Sprintf("%#x", val)
+	case spec.DomainType:
+		return fmt.Sprintf("%#x", val)
+	case spec.Version:
+		return fmt.Sprintf("%#x", val)
+	case time.Duration:
+		return fmt.Sprintf("%d", val/time.Second)
+	case time.Time:
+		return fmt.Sprintf("%d", val.Unix())
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+// Strings returns the chain spec as a map of string values, as used by the standard API.
+func (c *ChainSpec) Strings() map[string]string {
+	res := make(map[string]string)
+	for k, v := range c.Extra {
+		res[k] = chainSpecString(v)
+	}
+	for _, field := range c.fields() {
+		if !field.required && isZeroChainSpecValue(field.value) {
+			// Optional values that are not set are omitted.
+			continue
+		}
+		res[field.key] = chainSpecString(field.value)
+	}
+	return res
+}
+
+// isZeroChainSpecValue returns true if the field is unset.
+func isZeroChainSpecValue(field interface{}) bool {
+	switch f := field.(type) {
+	case *uint64:
+		return *f == 0
+	case *[]byte:
+		return len(*f) == 0
+	case *string:
+		return *f == ""
+	default:
+		return false
+	}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *ChainSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Strings())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *ChainSpec) UnmarshalJSON(input []byte) error {
+	var data map[string]string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	values := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		values[k] = v
+	}
+	chainSpec, err := NewChainSpec(values)
+	if err != nil {
+		return err
+	}
+	*c = *chainSpec
+	return nil
+}
+
+// String returns a string version of the structure.
+func (c *ChainSpec) String() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+	return string(data)
+}
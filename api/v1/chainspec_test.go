@@ -0,0 +1,367 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+// mainnetSpecValues returns the mainnet spec as supplied by the standard API.
+func mainnetSpecValues() map[string]interface{} {
+	return map[string]interface{}{
+		"BASE_REWARD_FACTOR":                    "64",
+		"BLS_WITHDRAWAL_PREFIX":                 "0x00",
+		"CHURN_LIMIT_QUOTIENT":                  "65536",
+		"CONFIG_NAME":                           "mainnet",
+		"DEPOSIT_CHAIN_ID":                      "1",
+		"DEPOSIT_CONTRACT_ADDRESS":              "0x00000000219ab540356cbb839cbe05303d7705fa",
+		"DEPOSIT_NETWORK_ID":                    "1",
+		"DOMAIN_AGGREGATE_AND_PROOF":            "0x06000000",
+		"DOMAIN_BEACON_ATTESTER":                "0x01000000",
+		"DOMAIN_BEACON_PROPOSER":                "0x00000000",
+		"DOMAIN_DEPOSIT":                        "0x03000000",
+		"DOMAIN_RANDAO":                         "0x02000000",
+		"DOMAIN_SELECTION_PROOF":                "0x05000000",
+		"DOMAIN_VOLUNTARY_EXIT":                 "0x04000000",
+		"EFFECTIVE_BALANCE_INCREMENT":           "1000000000",
+		"EJECTION_BALANCE":                      "16000000000",
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":         "64",
+		"EPOCHS_PER_HISTORICAL_VECTOR":          "65536",
+		"EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION": "256",
+		"EPOCHS_PER_SLASHINGS_VECTOR":           "8192",
+		"ETH1_FOLLOW_DISTANCE":                  "2048",
+		"GENESIS_DELAY":                         "604800",
+		"GENESIS_FORK_VERSION":                  "0x00000000",
+		"HISTORICAL_ROOTS_LIMIT":                "16777216",
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":        "1",
+		"HYSTERESIS_QUOTIENT":                   "4",
+		"HYSTERESIS_UPWARD_MULTIPLIER":          "5",
+		"INACTIVITY_PENALTY_QUOTIENT":           "67108864",
+		"MAX_ATTESTATIONS":                      "128",
+		"MAX_ATTESTER_SLASHINGS":                "2",
+		"MAX_COMMITTEES_PER_SLOT":               "64",
+		"MAX_DEPOSITS":                          "16",
+		"MAX_EFFECTIVE_BALANCE":                 "32000000000",
+		"MAX_PROPOSER_SLASHINGS":                "16",
+		"MAX_SEED_LOOKAHEAD":                    "4",
+		"MAX_VALIDATORS_PER_COMMITTEE":          "2048",
+		"MAX_VOLUNTARY_EXITS":                   "16",
+		"MIN_ATTESTATION_INCLUSION_DELAY":       "1",
+		"MIN_DEPOSIT_AMOUNT":                    "1000000000",
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":      "4",
+		"MIN_GENESIS_ACTIVE_VALIDATOR_COUNT":    "16384",
+		"MIN_GENESIS_TIME":                      "1606824000",
+		"MIN_PER_EPOCH_CHURN_LIMIT":             "4",
+		"MIN_SEED_LOOKAHEAD":                    "1",
+		"MIN_SLASHING_PENALTY_QUOTIENT":         "128",
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY":   "256",
+		"PROPORTIONAL_SLASHING_MULTIPLIER":      "1",
+		"PROPOSER_REWARD_QUOTIENT":              "8",
+		"RANDOM_SUBNETS_PER_VALIDATOR":          "1",
+		"SAFE_SLOTS_TO_UPDATE_JUSTIFIED":        "8",
+		"SECONDS_PER_ETH1_BLOCK":                "14",
+		"SECONDS_PER_SLOT":                      "12",
+		"SHARD_COMMITTEE_PERIOD":                "256",
+		"SHUFFLE_ROUND_COUNT":                   "90",
+		"SLOTS_PER_EPOCH":                       "32",
+		"SLOTS_PER_HISTORICAL_ROOT":             "8192",
+		"TARGET_AGGREGATORS_PER_COMMITTEE":      "16",
+		"TARGET_COMMITTEE_SIZE":                 "128",
+		"VALIDATOR_REGISTRY_LIMIT":              "1099511627776",
+		"WHISTLEBLOWER_REWARD_QUOTIENT":         "512",
+	}
+}
+
+func TestNewChainSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "Empty",
+			values: map[string]interface{}{},
+			err:    "missing required values: BASE_REWARD_FACTOR, CHURN_LIMIT_QUOTIENT, DOMAIN_AGGREGATE_AND_PROOF, DOMAIN_BEACON_ATTESTER, DOMAIN_BEACON_PROPOSER, DOMAIN_DEPOSIT, DOMAIN_RANDAO, DOMAIN_SELECTION_PROOF, DOMAIN_VOLUNTARY_EXIT, EFFECTIVE_BALANCE_INCREMENT, EJECTION_BALANCE, EPOCHS_PER_ETH1_VOTING_PERIOD, EPOCHS_PER_HISTORICAL_VECTOR, EPOCHS_PER_SLASHINGS_VECTOR, ETH1_FOLLOW_DISTANCE, GENESIS_DELAY, GENESIS_FORK_VERSION, HISTORICAL_ROOTS_LIMIT, HYSTERESIS_DOWNWARD_MULTIPLIER, HYSTERESIS_QUOTIENT, HYSTERESIS_UPWARD_MULTIPLIER, INACTIVITY_PENALTY_QUOTIENT, MAX_ATTESTATIONS, MAX_ATTESTER_SLASHINGS, MAX_COMMITTEES_PER_SLOT, MAX_DEPOSITS, MAX_EFFECTIVE_BALANCE, MAX_PROPOSER_SLASHINGS, MAX_SEED_LOOKAHEAD, MAX_VALIDATORS_PER_COMMITTEE, MAX_VOLUNTARY_EXITS, MIN_ATTESTATION_INCLUSION_DELAY, MIN_DEPOSIT_AMOUNT, MIN_EPOCHS_TO_INACTIVITY_PENALTY, MIN_GENESIS_ACTIVE_VALIDATOR_COUNT, MIN_GENESIS_TIME, MIN_PER_EPOCH_CHURN_LIMIT, MIN_SEED_LOOKAHEAD, MIN_SLASHING_PENALTY_QUOTIENT, MIN_VALIDATOR_WITHDRAWABILITY_DELAY, PROPORTIONAL_SLASHING_MULTIPLIER, PROPOSER_REWARD_QUOTIENT, SAFE_SLOTS_TO_UPDATE_JUSTIFIED, SECONDS_PER_ETH1_BLOCK, SECONDS_PER_SLOT, SHARD_COMMITTEE_PERIOD, SHUFFLE_ROUND_COUNT, SLOTS_PER_EPOCH, SLOTS_PER_HISTORICAL_ROOT, TARGET_AGGREGATORS_PER_COMMITTEE, TARGET_COMMITTEE_SIZE, VALIDATOR_REGISTRY_LIMIT, WHISTLEBLOWER_REWARD_QUOTIENT",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				delete(values, "SLOTS_PER_EPOCH")
+				return values
+			}(),
+			err: "missing required values: SLOTS_PER_EPOCH",
+		},
+		{
+			name: "SlotsPerEpochInvalid",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["SLOTS_PER_EPOCH"] = "-1"
+				return values
+			}(),
+			err: "invalid value for SLOTS_PER_EPOCH: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name: "SlotsPerEpochWrongType",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["SLOTS_PER_EPOCH"] = true
+				return values
+			}(),
+			err: "invalid value for SLOTS_PER_EPOCH: unexpected type bool",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["SLOTS_PER_EPOCH"] = "0"
+				return values
+			}(),
+			err: "SLOTS_PER_EPOCH cannot be 0",
+		},
+		{
+			name: "SecondsPerSlotZero",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["SECONDS_PER_SLOT"] = uint64(0)
+				return values
+			}(),
+			err: "SECONDS_PER_SLOT cannot be 0",
+		},
+		{
+			name: "DomainDepositInvalid",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["DOMAIN_DEPOSIT"] = "0xinvalid"
+				return values
+			}(),
+			err: "invalid value for DOMAIN_DEPOSIT: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name: "DomainDepositShort",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["DOMAIN_DEPOSIT"] = "0x030000"
+				return values
+			}(),
+			err: "invalid value for DOMAIN_DEPOSIT: incorrect length 3",
+		},
+		{
+			name:   "Strings",
+			values: mainnetSpecValues(),
+		},
+		{
+			name: "Typed",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["SLOTS_PER_EPOCH"] = uint64(32)
+				values["SECONDS_PER_SLOT"] = 12 * time.Second
+				values["MIN_GENESIS_TIME"] = time.Unix(1606824000, 0)
+				values["MIN_ATTESTATION_INCLUSION_DELAY"] = time.Second
+				values["DOMAIN_DEPOSIT"] = spec.DomainType{0x03, 0x00, 0x00, 0x00}
+				values["GENESIS_FORK_VERSION"] = []byte{0x00, 0x00, 0x00, 0x00}
+				values["DEPOSIT_CONTRACT_ADDRESS"] = []byte{0x00, 0x00, 0x00, 0x00, 0x21, 0x9a, 0xb5, 0x40, 0x35, 0x6c, 0xbb, 0x83, 0x9c, 0xbe, 0x05, 0x30, 0x3d, 0x77, 0x05, 0xfa}
+				return values
+			}(),
+		},
+		{
+			name: "Extra",
+			values: func() map[string]interface{} {
+				values := mainnetSpecValues()
+				values["UNKNOWN_VALUE"] = "unknown"
+				return values
+			}(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.NewChainSpec(test.values)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "mainnet", res.ConfigName)
+			require.Equal(t, uint64(32), res.SlotsPerEpoch)
+			require.Equal(t, 12*time.Second, res.SecondsPerSlot)
+			require.Equal(t, int64(1606824000), res.MinGenesisTime.Unix())
+			require.Equal(t, uint64(1), res.MinAttestationInclusionDelay)
+			require.Equal(t, spec.Gwei(32000000000), res.MaxEffectiveBalance)
+			require.Equal(t, spec.DomainType{0x03, 0x00, 0x00, 0x00}, res.DomainDeposit)
+			require.Equal(t, spec.Version{0x00, 0x00, 0x00, 0x00}, res.GenesisForkVersion)
+			require.Len(t, res.DepositContractAddress, 20)
+			for k, v := range test.values {
+				if k == "UNKNOWN_VALUE" {
+					require.Equal(t, v, res.Extra[k])
+				}
+			}
+		})
+	}
+}
+
+func TestChainSpecJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type map[string]string",
+		},
+		{
+			name: "Good",
+			input: func() []byte {
+				values := mainnetSpecValues()
+				values["UNKNOWN_VALUE"] = "unknown"
+				data, err := json.Marshal(values)
+				require.NoError(t, err)
+				return data
+			}(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.ChainSpec
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				require.JSONEq(t, string(test.input), string(rt))
+				require.JSONEq(t, string(rt), res.String())
+			}
+		})
+	}
+}
+
+func TestSpecValues(t *testing.T) {
+	values := api.SpecValues(map[string]string{
+		"DOMAIN_DEPOSIT":         "0x03000000",
+		"GENESIS_FORK_VERSION":   "0x00000001",
+		"DEPOSIT_CONTRACT":       "0x00000000219ab540356cbb839cbe05303d7705fa",
+		"MIN_GENESIS_TIME":       "1606824000",
+		"SECONDS_PER_SLOT":       "12",
+		"GENESIS_DELAY":          "604800",
+		"SLOTS_PER_EPOCH":        "32",
+		"MIN_DEPOSIT_AMOUNT":     "0",
+		"CONFIG_NAME":            "mainnet",
+		"DOMAIN_INVALID":         "invalid",
+		"SECONDS_PER_ETH1_BLOCK": "0",
+	})
+
+	require.Equal(t, spec.DomainType{0x03, 0x00, 0x00, 0x00}, values["DOMAIN_DEPOSIT"])
+	require.Equal(t, spec.Version{0x00, 0x00, 0x00, 0x01}, values["GENESIS_FORK_VERSION"])
+	require.Equal(t, []byte{0x00, 0x00, 0x00, 0x00, 0x21, 0x9a, 0xb5, 0x40, 0x35, 0x6c, 0xbb, 0x83, 0x9c, 0xbe, 0x05, 0x30, 0x3d, 0x77, 0x05, 0xfa}, values["DEPOSIT_CONTRACT"])
+	require.Equal(t, time.Unix(1606824000, 0), values["MIN_GENESIS_TIME"])
+	require.Equal(t, 12*time.Second, values["SECONDS_PER_SLOT"])
+	require.Equal(t, 604800*time.Second, values["GENESIS_DELAY"])
+	require.Equal(t, uint64(32), values["SLOTS_PER_EPOCH"])
+	require.Equal(t, uint64(0), values["MIN_DEPOSIT_AMOUNT"])
+	require.Equal(t, "mainnet", values["CONFIG_NAME"])
+	require.Equal(t, "invalid", values["DOMAIN_INVALID"])
+	require.Equal(t, uint64(0), values["SECONDS_PER_ETH1_BLOCK"])
+}
+
+func TestSpecAccessors(t *testing.T) {
+	values := map[string]interface{}{
+		"SLOTS_PER_EPOCH":                     uint64(32),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": 256 * time.Second,
+		"MAX_DEPOSITS":                        "16",
+		"MAX_ATTESTATIONS":                    "many",
+		"DOMAIN_DEPOSIT":                      spec.DomainType{0x03, 0x00, 0x00, 0x00},
+		"DOMAIN_RANDAO":                       []byte{0x02, 0x00},
+		"GENESIS_FORK_VERSION":                "0x00000001",
+	}
+
+	tests := []struct {
+		name     string
+		accessor func() (interface{}, error)
+		expected interface{}
+		err      string
+	}{
+		{
+			name:     "Uint64",
+			accessor: func() (interface{}, error) { return api.SpecUint64(values, "SLOTS_PER_EPOCH") },
+			expected: uint64(32),
+		},
+		{
+			name:     "Uint64Duration",
+			accessor: func() (interface{}, error) { return api.SpecUint64(values, "MIN_VALIDATOR_WITHDRAWABILITY_DELAY") },
+			expected: uint64(256),
+		},
+		{
+			name:     "Uint64String",
+			accessor: func() (interface{}, error) { return api.SpecUint64(values, "MAX_DEPOSITS") },
+			expected: uint64(16),
+		},
+		{
+			name:     "Uint64Invalid",
+			accessor: func() (interface{}, error) { return api.SpecUint64(values, "MAX_ATTESTATIONS") },
+			err:      `invalid value for MAX_ATTESTATIONS: strconv.ParseUint: parsing "many": invalid syntax`,
+		},
+		{
+			name:     "Uint64Missing",
+			accessor: func() (interface{}, error) { return api.SpecUint64(values, "MAX_VOLUNTARY_EXITS") },
+			err:      "MAX_VOLUNTARY_EXITS not found in spec",
+		},
+		{
+			name:     "DomainType",
+			accessor: func() (interface{}, error) { return api.SpecDomainType(values, "DOMAIN_DEPOSIT") },
+			expected: spec.DomainType{0x03, 0x00, 0x00, 0x00},
+		},
+		{
+			name:     "DomainTypeShort",
+			accessor: func() (interface{}, error) { return api.SpecDomainType(values, "DOMAIN_RANDAO") },
+			err:      "invalid value for DOMAIN_RANDAO: incorrect length 2",
+		},
+		{
+			name:     "Version",
+			accessor: func() (interface{}, error) { return api.SpecVersion(values, "GENESIS_FORK_VERSION") },
+			expected: spec.Version{0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			name:     "VersionMissing",
+			accessor: func() (interface{}, error) { return api.SpecVersion(values, "ALTAIR_FORK_VERSION") },
+			err:      "ALTAIR_FORK_VERSION not found in spec",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := test.accessor()
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, res)
+			}
+		})
+	}
+}
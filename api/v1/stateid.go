@@ -0,0 +1,215 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// idType is the type of a state or block ID.
+type idType int
+
+const (
+	idTypeUnknown idType = iota
+	idTypeHead
+	idTypeGenesis
+	idTypeFinalized
+	idTypeJustified
+	idTypeSlot
+	idTypeRoot
+)
+
+// StateID identifies a beacon state, either by a well-known name, a slot or a state root.
+// The zero value is not a valid state ID.
+type StateID struct {
+	idType idType
+	slot   spec.Slot
+	root   spec.Root
+}
+
+// StateIDHead is the state ID for the state at the head of the chain.
+func StateIDHead() StateID {
+	return StateID{idType: idTypeHead}
+}
+
+// StateIDGenesis is the state ID for the genesis state.
+func StateIDGenesis() StateID {
+	return StateID{idType: idTypeGenesis}
+}
+
+// StateIDFinalized is the state ID for the latest finalized state.
+func StateIDFinalized() StateID {
+	return StateID{idType: idTypeFinalized}
+}
+
+// StateIDJustified is the state ID for the latest justified state.
+func StateIDJustified() StateID {
+	return StateID{idType: idTypeJustified}
+}
+
+// StateIDSlot is the state ID for the state at the given slot.
+func StateIDSlot(slot spec.Slot) StateID {
+	return StateID{idType: idTypeSlot, slot: slot}
+}
+
+// StateIDRoot is the state ID for the state with the given state root.
+func StateIDRoot(root spec.Root) StateID {
+	return StateID{idType: idTypeRoot, root: root}
+}
+
+// ParseStateID parses a state ID in its API representation.
+func ParseStateID(input string) (StateID, error) {
+	idType, slot, root, err := parseID(input)
+	if err != nil {
+		return StateID{}, err
+	}
+	return StateID{idType: idType, slot: slot, root: root}, nil
+}
+
+// IsValid returns true if the state ID has been set.
+func (s StateID) IsValid() bool {
+	return s.idType != idTypeUnknown
+}
+
+// IsHead returns true if the state ID is for the head state.
+func (s StateID) IsHead() bool {
+	return s.idType == idTypeHead
+}
+
+// IsGenesis returns true if the state ID is for the genesis state.
+func (s StateID) IsGenesis() bool {
+	return s.idType == idTypeGenesis
+}
+
+// IsFinalized returns true if the state ID is for the latest finalized state.
+func (s StateID) IsFinalized() bool {
+	return s.idType == idTypeFinalized
+}
+
+// IsJustified returns true if the state ID is for the latest justified state.
+func (s StateID) IsJustified() bool {
+	return s.idType == idTypeJustified
+}
+
+// Slot returns the slot of the state ID, and true if the state ID is for a slot.
+func (s StateID) Slot() (spec.Slot, bool) {
+	return s.slot, s.idType == idTypeSlot
+}
+
+// Root returns the state root of the state ID, and true if the state ID is for a state root.
+func (s StateID) Root() (spec.Root, bool) {
+	return s.root, s.idType == idTypeRoot
+}
+
+// String returns the API representation of the state ID.
+func (s StateID) String() string {
+	return formatID(s.idType, s.slot, s.root)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s StateID) MarshalJSON() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, errors.New("invalid state ID")
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *StateID) UnmarshalJSON(input []byte) error {
+	var data string
+	if err := json.Unmarshal(input, &data); err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+	stateID, err := ParseStateID(data)
+	if err != nil {
+		return err
+	}
+	*s = stateID
+	return nil
+}
+
+// ResolvedStateID is a state ID resolved to concrete values.
+type ResolvedStateID struct {
+	// Slot is the slot of the state.
+	Slot spec.Slot
+	// Epoch is the epoch of the state.
+	Epoch spec.Epoch
+	// Root is the root of the state.
+	Root spec.Root
+}
+
+// String returns a string version of the structure.
+func (r *ResolvedStateID) String() string {
+	return fmt.Sprintf("slot %d epoch %d root %#x", r.Slot, r.Epoch, r.Root)
+}
+
+// parseID parses the API representation of a state or block ID.
+func parseID(input string) (idType, spec.Slot, spec.Root, error) {
+	switch {
+	case input == "":
+		return idTypeUnknown, 0, spec.Root{}, errors.New("no ID supplied")
+	case input == "head":
+		return idTypeHead, 0, spec.Root{}, nil
+	case input == "genesis":
+		return idTypeGenesis, 0, spec.Root{}, nil
+	case input == "finalized":
+		return idTypeFinalized, 0, spec.Root{}, nil
+	case input == "justified":
+		return idTypeJustified, 0, spec.Root{}, nil
+	case strings.HasPrefix(input, "0x"):
+		data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		if err != nil {
+			return idTypeUnknown, 0, spec.Root{}, errors.Wrap(err, "invalid value for root")
+		}
+		if len(data) != rootLength {
+			return idTypeUnknown, 0, spec.Root{}, fmt.Errorf("incorrect length %d for root", len(data))
+		}
+		var root spec.Root
+		copy(root[:], data)
+		return idTypeRoot, 0, root, nil
+	default:
+		slot, err := strconv.ParseUint(input, 10, 64)
+		if err != nil {
+			return idTypeUnknown, 0, spec.Root{}, errors.Wrap(err, "invalid value for slot")
+		}
+		return idTypeSlot, spec.Slot(slot), spec.Root{}, nil
+	}
+}
+
+// formatID returns the API representation of a state or block ID.
+func formatID(idType idType, slot spec.Slot, root spec.Root) string {
+	switch idType {
+	case idTypeHead:
+		return "head"
+	case idTypeGenesis:
+		return "genesis"
+	case idTypeFinalized:
+		return "finalized"
+	case idTypeJustified:
+		return "justified"
+	case idTypeSlot:
+		return fmt.Sprintf("%d", slot)
+	case idTypeRoot:
+		return fmt.Sprintf("%#x", root)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	require "github.com/stretchr/testify/require"
+)
+
+func TestParseStateID(t *testing.T) {
+	root := spec.Root{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected api.StateID
+		err      string
+	}{
+		{
+			name: "Empty",
+			err:  "no ID supplied",
+		},
+		{
+			name:     "Head",
+			input:    "head",
+			expected: api.StateIDHead(),
+		},
+		{
+			name:     "Genesis",
+			input:    "genesis",
+			expected: api.StateIDGenesis(),
+		},
+		{
+			name:     "Finalized",
+			input:    "finalized",
+			expected: api.StateIDFinalized(),
+		},
+		{
+			name:     "Justified",
+			input:    "justified",
+			expected: api.StateIDJustified(),
+		},
+		{
+			name:     "Slot",
+			input:    "12345",
+			expected: api.StateIDSlot(12345),
+		},
+		{
+			name:  "SlotInvalid",
+			input: "-1",
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "Unknown",
+			input: "latest",
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"latest\": invalid syntax",
+		},
+		{
+			name:     "Root",
+			input:    "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20",
+			expected: api.StateIDRoot(root),
+		},
+		{
+			name:  "RootInvalid",
+			input: "0xinvalid",
+			err:   "invalid value for root: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "RootShort",
+			input: "0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			err:   "incorrect length 31 for root",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := api.ParseStateID(test.input)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.False(t, res.IsValid())
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, res.IsValid())
+			require.Equal(t, test.expected, res)
+			require.Equal(t, test.input, res.String())
+		})
+	}
+}
+
+func TestStateIDAccessors(t *testing.T) {
+	require.False(t, api.StateID{}.IsValid())
+	require.Equal(t, "", api.StateID{}.String())
+	require.True(t, api.StateIDHead().IsHead())
+	require.True(t, api.StateIDGenesis().IsGenesis())
+	require.True(t, api.StateIDFinalized().IsFinalized())
+	require.True(t, api.StateIDJustified().IsJustified())
+	require.False(t, api.StateIDHead().IsGenesis())
+
+	slot, isSlot := api.StateIDSlot(5).Slot()
+	require.True(t, isSlot)
+	require.Equal(t, spec.Slot(5), slot)
+	_, isSlot = api.StateIDHead().Slot()
+	require.False(t, isSlot)
+
+	root, isRoot := api.StateIDRoot(spec.Root{0x01}).Root()
+	require.True(t, isRoot)
+	require.Equal(t, spec.Root{0x01}, root)
+	_, isRoot = api.StateIDSlot(5).Root()
+	require.False(t, isRoot)
+}
+
+func TestStateIDJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type string",
+		},
+		{
+			name:  "Invalid",
+			input: []byte(`"0x01"`),
+			err:   "incorrect length 1 for root",
+		},
+		{
+			name:  "Good",
+			input: []byte(`"finalized"`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res api.StateID
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(res)
+				require.NoError(t, err)
+				require.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+
+	_, err := json.Marshal(api.StateID{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid state ID")
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations
+
+import (
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel                 zerolog.Level
+	logger                   zerolog.Logger
+	beaconCommitteesProvider eth2client.BeaconCommitteesProvider
+	slotsPerEpochProvider    eth2client.SlotsPerEpochProvider
+	cacheEpochs              int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithBeaconCommitteesProvider sets the beacon committees provider.
+func WithBeaconCommitteesProvider(provider eth2client.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithSlotsPerEpochProvider sets the slots per epoch provider.
+func WithSlotsPerEpochProvider(provider eth2client.SlotsPerEpochProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpochProvider = provider
+	})
+}
+
+// WithCacheEpochs sets the number of epochs for which committees are cached.
+func WithCacheEpochs(epochs int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.cacheEpochs = epochs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:    zerolog.GlobalLevel(),
+		logger:      zerologger.Logger,
+		cacheEpochs: 4,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.slotsPerEpochProvider == nil {
+		return nil, errors.New("no slots per epoch provider specified")
+	}
+	if parameters.cacheEpochs < 2 {
+		// We need at least the current and previous epoch.
+		return nil, errors.New("cache epochs must be at least 2")
+	}
+
+	return &parameters, nil
+}
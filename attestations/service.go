@@ -0,0 +1,253 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service resolves the validators that attested in attestations.
+type Service struct {
+	log                      zerolog.Logger
+	beaconCommitteesProvider eth2client.BeaconCommitteesProvider
+	slotsPerEpoch            uint64
+
+	// Committees do not change once an epoch has started, so they are
+	// fetched once and kept for recent epochs.
+	committees   map[spec.Epoch]map[committeeKey][]spec.ValidatorIndex
+	epochs       []spec.Epoch
+	cacheEpochs  int
+	fetches      map[spec.Epoch]*committeesFetch
+	committeesMu sync.Mutex
+}
+
+// committeesFetch is a fetch of the committees for an epoch that is in progress.
+// Lookups for the epoch wait for the fetch rather than making their own request, unless the
+// fetch is abandoned because the context of the lookup that made it ends.
+type committeesFetch struct {
+	done       chan struct{}
+	committees map[committeeKey][]spec.ValidatorIndex
+	err        error
+}
+
+// committeeKey is the key for a committee within an epoch.
+type committeeKey struct {
+	slot  spec.Slot
+	index spec.CommitteeIndex
+}
+
+// New creates a new attestations service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "attestations").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	slotsPerEpoch, err := parameters.slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+
+	s := &Service{
+		log:                      log,
+		beaconCommitteesProvider: parameters.beaconCommitteesProvider,
+		slotsPerEpoch:            slotsPerEpoch,
+		committees:               make(map[spec.Epoch]map[committeeKey][]spec.ValidatorIndex),
+		epochs:                   make([]spec.Epoch, 0, parameters.cacheEpochs),
+		fetches:                  make(map[spec.Epoch]*committeesFetch),
+		cacheEpochs:              parameters.cacheEpochs,
+	}
+
+	return s, nil
+}
+
+// AttestingIndices provides the indices of the validators that attested in the given attestation,
+// in the order in which they appear in the committee.
+func (s *Service) AttestingIndices(ctx context.Context, attestation *spec.Attestation) ([]spec.ValidatorIndex, error) {
+	if attestation == nil {
+		return nil, errors.New("no attestation supplied")
+	}
+	if attestation.Data == nil {
+		return nil, errors.New("no attestation data supplied")
+	}
+
+	committee, err := s.committee(ctx, attestation.Data.Slot, attestation.Data.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if attestation.AggregationBits.Len() != uint64(len(committee)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee length %d", attestation.AggregationBits.Len(), len(committee))
+	}
+
+	res := make([]spec.ValidatorIndex, 0, len(committee))
+	for i := range committee {
+		if attestation.AggregationBits.BitAt(uint64(i)) {
+			res = append(res, committee[i])
+		}
+	}
+	return res, nil
+}
+
+// AttestingIndicesForAttestations provides the indices of the validators that attested in each of the given
+// attestations, for example those in a block or an attestation pool.
+func (s *Service) AttestingIndicesForAttestations(ctx context.Context, attestations []*spec.Attestation) ([][]spec.ValidatorIndex, error) {
+	res := make([][]spec.ValidatorIndex, len(attestations))
+	for i := range attestations {
+		indices, err := s.AttestingIndices(ctx, attestations[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain attesting indices for attestation %d", i)
+		}
+		res[i] = indices
+	}
+	return res, nil
+}
+
+// IndexedAttestation converts an attestation to an indexed attestation.
+// This is get_indexed_attestation in the Ethereum 2 specification.
+func (s *Service) IndexedAttestation(ctx context.Context, attestation *spec.Attestation) (*spec.IndexedAttestation, error) {
+	indices, err := s.AttestingIndices(ctx, attestation)
+	if err != nil {
+		return nil, err
+	}
+
+	attestingIndices := make([]uint64, len(indices))
+	for i := range indices {
+		attestingIndices[i] = uint64(indices[i])
+	}
+	sort.Slice(attestingIndices, func(i, j int) bool { return attestingIndices[i] < attestingIndices[j] })
+
+	return &spec.IndexedAttestation{
+		AttestingIndices: attestingIndices,
+		Data:             attestation.Data,
+		Signature:        attestation.Signature,
+	}, nil
+}
+
+// committee provides the committee for the given slot and index, fetching the committees for the epoch if required.
+func (s *Service) committee(ctx context.Context, slot spec.Slot, index spec.CommitteeIndex) ([]spec.ValidatorIndex, error) {
+	epochCommittees, err := s.epochCommittees(ctx, spec.Epoch(uint64(slot)/s.slotsPerEpoch), slot)
+	if err != nil {
+		return nil, err
+	}
+
+	committee, exists := epochCommittees[committeeKey{slot: slot, index: index}]
+	if !exists {
+		return nil, fmt.Errorf("no committee found for slot %d index %d", slot, index)
+	}
+	return committee, nil
+}
+
+// epochCommittees provides the committees for an epoch, fetching them if required.
+// The lock is not held while fetching, so lookups for other epochs are not held up by a slow request.
+func (s *Service) epochCommittees(ctx context.Context, epoch spec.Epoch, slot spec.Slot) (map[committeeKey][]spec.ValidatorIndex, error) {
+	for {
+		s.committeesMu.Lock()
+		if epochCommittees, exists := s.committees[epoch]; exists {
+			s.committeesMu.Unlock()
+			return epochCommittees, nil
+		}
+
+		if fetch, fetching := s.fetches[epoch]; fetching {
+			// Another lookup is fetching the committees for this epoch; wait for it.
+			s.committeesMu.Unlock()
+			select {
+			case <-fetch.done:
+			case <-ctx.Done():
+				return nil, errors.Wrap(ctx.Err(), "failed to obtain beacon committees")
+			}
+			if fetch.err != nil && ctx.Err() == nil && isContextError(fetch.err) {
+				// The fetch was abandoned by the lookup that made it rather than failing, so try again.
+				continue
+			}
+			return fetch.committees, fetch.err
+		}
+
+		fetch := &committeesFetch{
+			done: make(chan struct{}),
+		}
+		s.fetches[epoch] = fetch
+		s.committeesMu.Unlock()
+
+		fetch.committees, fetch.err = s.fetchCommittees(ctx, epoch, slot)
+
+		s.committeesMu.Lock()
+		delete(s.fetches, epoch)
+		if fetch.err == nil {
+			s.cacheCommittees(epoch, fetch.committees)
+		}
+		s.committeesMu.Unlock()
+		close(fetch.done)
+
+		return fetch.committees, fetch.err
+	}
+}
+
+// isContextError returns true if the error is the result of a context being cancelled or timing out.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// fetchCommittees fetches the committees for an epoch.
+func (s *Service) fetchCommittees(ctx context.Context, epoch spec.Epoch, slot spec.Slot) (map[committeeKey][]spec.ValidatorIndex, error) {
+	s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching committees for epoch")
+	// The committees returned are for the epoch of the state, so use the slot of the attestation as the state.
+	committees, err := s.beaconCommitteesProvider.BeaconCommittees(ctx, fmt.Sprintf("%d", slot))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon committees")
+	}
+	epochCommittees := make(map[committeeKey][]spec.ValidatorIndex, len(committees))
+	for _, committee := range committees {
+		if spec.Epoch(uint64(committee.Slot)/s.slotsPerEpoch) != epoch {
+			continue
+		}
+		epochCommittees[committeeKey{slot: committee.Slot, index: committee.Index}] = committee.Validators
+	}
+	return epochCommittees, nil
+}
+
+// cacheCommittees caches the committees for an epoch, evicting the earliest epoch if the cache is full.
+// committeesMu must be held.
+func (s *Service) cacheCommittees(epoch spec.Epoch, epochCommittees map[committeeKey][]spec.ValidatorIndex) {
+	if len(s.epochs) == s.cacheEpochs {
+		// Evict the earliest epoch, as it is the least likely to be seen again.
+		earliest := 0
+		for i := range s.epochs {
+			if s.epochs[i] < s.epochs[earliest] {
+				earliest = i
+			}
+		}
+		delete(s.committees, s.epochs[earliest])
+		s.epochs = append(s.epochs[:earliest], s.epochs[earliest+1:]...)
+	}
+	s.committees[epoch] = epochCommittees
+	s.epochs = append(s.epochs, epoch)
+}
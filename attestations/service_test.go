@@ -0,0 +1,405 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestations_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/attestations"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+// testCommittees provides deterministic committees of 4 validators, 2 per slot, 4 slots per epoch.
+type testCommittees struct {
+	calls int
+	err   error
+}
+
+func (c *testCommittees) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	slot, err := strconv.ParseUint(stateID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	startSlot := slot - slot%4
+	committees := make([]*api.BeaconCommittee, 0)
+	for s := startSlot; s < startSlot+4; s++ {
+		for i := uint64(0); i < 2; i++ {
+			base := spec.ValidatorIndex(s*100 + i*10)
+			committees = append(committees, &api.BeaconCommittee{
+				Slot:       spec.Slot(s),
+				Index:      spec.CommitteeIndex(i),
+				Validators: []spec.ValidatorIndex{base + 3, base + 1, base + 2, base},
+			})
+		}
+	}
+	return committees, nil
+}
+
+func (c *testCommittees) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	return 4, nil
+}
+
+func bits(set ...uint64) bitfield.Bitlist {
+	res := bitfield.NewBitlist(4)
+	for _, i := range set {
+		res.SetBitAt(i, true)
+	}
+	return res
+}
+
+func attestation(slot spec.Slot, index spec.CommitteeIndex, aggregationBits bitfield.Bitlist) *spec.Attestation {
+	return &spec.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &spec.AttestationData{
+			Slot:   slot,
+			Index:  index,
+			Source: &spec.Checkpoint{},
+			Target: &spec.Checkpoint{Epoch: spec.Epoch(slot / 4)},
+		},
+		Signature: spec.BLSSignature{0x01},
+	}
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	committees := &testCommittees{}
+
+	tests := []struct {
+		name   string
+		params []attestations.Parameter
+		err    string
+	}{
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []attestations.Parameter{
+				attestations.WithSlotsPerEpochProvider(committees),
+			},
+			err: "problem with parameters: no beacon committees provider specified",
+		},
+		{
+			name: "SlotsPerEpochProviderMissing",
+			params: []attestations.Parameter{
+				attestations.WithBeaconCommitteesProvider(committees),
+			},
+			err: "problem with parameters: no slots per epoch provider specified",
+		},
+		{
+			name: "CacheEpochsTooLow",
+			params: []attestations.Parameter{
+				attestations.WithBeaconCommitteesProvider(committees),
+				attestations.WithSlotsPerEpochProvider(committees),
+				attestations.WithCacheEpochs(1),
+			},
+			err: "problem with parameters: cache epochs must be at least 2",
+		},
+		{
+			name: "Good",
+			params: []attestations.Parameter{
+				attestations.WithBeaconCommitteesProvider(committees),
+				attestations.WithSlotsPerEpochProvider(committees),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := attestations.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newService(ctx context.Context, committees *testCommittees) (*attestations.Service, error) {
+	return attestations.New(ctx,
+		attestations.WithBeaconCommitteesProvider(committees),
+		attestations.WithSlotsPerEpochProvider(committees),
+	)
+}
+
+func TestAttestingIndices(t *testing.T) {
+	ctx := context.Background()
+	s, err := newService(ctx, &testCommittees{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		attestation *spec.Attestation
+		res         []spec.ValidatorIndex
+		err         string
+	}{
+		{
+			name: "Nil",
+			err:  "no attestation supplied",
+		},
+		{
+			name:        "DataNil",
+			attestation: &spec.Attestation{AggregationBits: bits(0)},
+			err:         "no attestation data supplied",
+		},
+		{
+			name:        "CommitteeMissing",
+			attestation: attestation(5, 2, bits(0)),
+			err:         "no committee found for slot 5 index 2",
+		},
+		{
+			name:        "BitsLengthWrong",
+			attestation: attestation(5, 1, bitfield.NewBitlist(3)),
+			err:         "aggregation bits length 3 does not match committee length 4",
+		},
+		{
+			name:        "None",
+			attestation: attestation(5, 1, bits()),
+			res:         []spec.ValidatorIndex{},
+		},
+		{
+			name:        "Single",
+			attestation: attestation(5, 1, bits(1)),
+			res:         []spec.ValidatorIndex{511},
+		},
+		{
+			name:        "CommitteeOrder",
+			attestation: attestation(6, 0, bits(0, 1, 3)),
+			res:         []spec.ValidatorIndex{603, 601, 600},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.AttestingIndices(ctx, test.attestation)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestAttestingIndicesProviderError(t *testing.T) {
+	ctx := context.Background()
+	s, err := newService(ctx, &testCommittees{err: errors.New("unavailable")})
+	require.NoError(t, err)
+
+	_, err = s.AttestingIndices(ctx, attestation(5, 1, bits(1)))
+	require.EqualError(t, err, "failed to obtain beacon committees: unavailable")
+}
+
+func TestAttestingIndicesForAttestations(t *testing.T) {
+	ctx := context.Background()
+	s, err := newService(ctx, &testCommittees{})
+	require.NoError(t, err)
+
+	res, err := s.AttestingIndicesForAttestations(ctx, []*spec.Attestation{
+		attestation(5, 0, bits(0)),
+		// Previous epoch, as included in a block.
+		attestation(3, 1, bits(2, 3)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]spec.ValidatorIndex{{503}, {312, 310}}, res)
+
+	_, err = s.AttestingIndicesForAttestations(ctx, []*spec.Attestation{
+		attestation(5, 0, bits(0)),
+		attestation(5, 3, bits(0)),
+	})
+	require.EqualError(t, err, "failed to obtain attesting indices for attestation 1: no committee found for slot 5 index 3")
+}
+
+func TestCommitteeCache(t *testing.T) {
+	ctx := context.Background()
+	committees := &testCommittees{}
+	s, err := attestations.New(ctx,
+		attestations.WithBeaconCommitteesProvider(committees),
+		attestations.WithSlotsPerEpochProvider(committees),
+		attestations.WithCacheEpochs(2),
+	)
+	require.NoError(t, err)
+
+	// All slots in an epoch share a single fetch.
+	for slot := spec.Slot(4); slot < 8; slot++ {
+		_, err := s.AttestingIndices(ctx, attestation(slot, 0, bits(0)))
+		require.NoError(t, err)
+	}
+	require.Equal(t, 1, committees.calls)
+
+	// The previous epoch is fetched separately, and both are retained.
+	_, err = s.AttestingIndices(ctx, attestation(3, 0, bits(0)))
+	require.NoError(t, err)
+	require.Equal(t, 2, committees.calls)
+	_, err = s.AttestingIndices(ctx, attestation(7, 1, bits(0)))
+	require.NoError(t, err)
+	require.Equal(t, 2, committees.calls)
+
+	// A new epoch evicts the earliest.
+	_, err = s.AttestingIndices(ctx, attestation(8, 0, bits(0)))
+	require.NoError(t, err)
+	require.Equal(t, 3, committees.calls)
+	_, err = s.AttestingIndices(ctx, attestation(4, 0, bits(0)))
+	require.NoError(t, err)
+	require.Equal(t, 3, committees.calls)
+	_, err = s.AttestingIndices(ctx, attestation(3, 0, bits(0)))
+	require.NoError(t, err)
+	require.Equal(t, 4, committees.calls)
+}
+
+// blockingCommittees provides committees as testCommittees, but holds fetches for the first epoch until released.
+type blockingCommittees struct {
+	testCommittees
+	mu      sync.Mutex
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingCommittees) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	slot, err := strconv.ParseUint(stateID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if slot < 4 {
+		c.started <- struct{}{}
+		<-c.release
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.testCommittees.BeaconCommittees(ctx, stateID)
+}
+
+func TestCommitteeFetchConcurrency(t *testing.T) {
+	ctx := context.Background()
+	committees := &blockingCommittees{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	s, err := attestations.New(ctx,
+		attestations.WithBeaconCommitteesProvider(committees),
+		attestations.WithSlotsPerEpochProvider(committees),
+	)
+	require.NoError(t, err)
+
+	// Start lookups for the first epoch, which are held behind the first fetch.
+	var wg sync.WaitGroup
+	lookup := func(slot spec.Slot) {
+		defer wg.Done()
+		_, err := s.AttestingIndices(ctx, attestation(slot, 0, bits(0)))
+		require.NoError(t, err)
+	}
+	wg.Add(1)
+	go lookup(0)
+	<-committees.started
+	for slot := spec.Slot(1); slot < 4; slot++ {
+		wg.Add(1)
+		go lookup(slot)
+	}
+
+	// A lookup for another epoch is not held up.
+	_, err = s.AttestingIndices(ctx, attestation(4, 0, bits(0)))
+	require.NoError(t, err)
+
+	// A lookup waiting for the held fetch can give up.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.AttestingIndices(cancelledCtx, attestation(2, 0, bits(0)))
+	require.ErrorIs(t, err, context.Canceled)
+
+	// The held lookups share a single fetch.
+	close(committees.release)
+	wg.Wait()
+	committees.mu.Lock()
+	defer committees.mu.Unlock()
+	require.Equal(t, 2, committees.calls)
+}
+
+// abandonedCommittees provides committees as testCommittees, but the first fetch runs until its context ends.
+type abandonedCommittees struct {
+	testCommittees
+	mu      sync.Mutex
+	fetched bool
+	started chan struct{}
+}
+
+func (c *abandonedCommittees) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	c.mu.Lock()
+	first := !c.fetched
+	c.fetched = true
+	c.mu.Unlock()
+	if first {
+		close(c.started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return c.testCommittees.BeaconCommittees(ctx, stateID)
+}
+
+func TestCommitteeFetchAbandoned(t *testing.T) {
+	ctx := context.Background()
+	committees := &abandonedCommittees{
+		started: make(chan struct{}),
+	}
+	s, err := attestations.New(ctx,
+		attestations.WithBeaconCommitteesProvider(committees),
+		attestations.WithSlotsPerEpochProvider(committees),
+	)
+	require.NoError(t, err)
+
+	// The first lookup makes the fetch, and gives up on it.
+	fetchCtx, cancel := context.WithCancel(ctx)
+	fetchErr := make(chan error, 1)
+	go func() {
+		_, err := s.AttestingIndices(fetchCtx, attestation(0, 0, bits(0)))
+		fetchErr <- err
+	}()
+	<-committees.started
+
+	// A lookup waiting for the fetch with a live context is not failed by the other lookup giving up.
+	lookupErr := make(chan error, 1)
+	go func() {
+		_, err := s.AttestingIndices(ctx, attestation(1, 0, bits(0)))
+		lookupErr <- err
+	}()
+	cancel()
+	require.ErrorIs(t, <-fetchErr, context.Canceled)
+	require.NoError(t, <-lookupErr)
+}
+
+func TestIndexedAttestation(t *testing.T) {
+	ctx := context.Background()
+	s, err := newService(ctx, &testCommittees{})
+	require.NoError(t, err)
+
+	att := attestation(6, 0, bits(0, 1, 3))
+	res, err := s.IndexedAttestation(ctx, att)
+	require.NoError(t, err)
+	// Indices are sorted, unlike the committee order.
+	require.Equal(t, []uint64{600, 601, 603}, res.AttestingIndices)
+	require.Equal(t, att.Data, res.Data)
+	require.Equal(t, att.Signature, res.Signature)
+
+	_, err = s.IndexedAttestation(ctx, nil)
+	require.EqualError(t, err, "no attestation supplied")
+}
@@ -0,0 +1,48 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConnectAttempt is the result of an unsuccessful attempt to connect with an implementation.
+type ConnectAttempt struct {
+	// Implementation is the implementation used in the attempt.
+	Implementation Implementation
+	// Err is the reason the attempt failed.
+	Err error
+}
+
+// ConnectError is returned when none of the implementations could connect to the beacon node.
+type ConnectError struct {
+	// Attempts are the failed attempts, in order of preference.
+	Attempts []*ConnectAttempt
+}
+
+// Error implements error.
+func (e *ConnectError) Error() string {
+	var b strings.Builder
+	b.WriteString("failed to connect to Ethereum 2 client with any known method")
+	for i, attempt := range e.Attempts {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString("; ")
+		}
+		b.WriteString(fmt.Sprintf("%v: %v", attempt.Implementation, attempt.Err))
+	}
+	return b.String()
+}
@@ -0,0 +1,38 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auto
+
+import "fmt"
+
+// Implementation is an implementation of the client that can be used to connect to a beacon node.
+type Implementation int
+
+const (
+	// ImplementationStandardHTTP is the standard HTTP API, provided by the standardhttp package.
+	ImplementationStandardHTTP Implementation = iota + 1
+	// ImplementationPrysmGRPC is the Prysm gRPC API, provided by the prysmgrpc package.
+	ImplementationPrysmGRPC
+)
+
+// String returns a string representation of the implementation.
+func (i Implementation) String() string {
+	switch i {
+	case ImplementationStandardHTTP:
+		return "standard HTTP"
+	case ImplementationPrysmGRPC:
+		return "Prysm gRPC"
+	default:
+		return fmt.Sprintf("unknown implementation %d", int(i))
+	}
+}
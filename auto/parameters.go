@@ -14,16 +14,23 @@
 package auto
 
 import (
+	"fmt"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
 )
 
 type parameters struct {
 	logLevel zerolog.Level
+	logger   zerolog.Logger
 	address  string
 	timeout  time.Duration
+	// implementations are the implementations to try, in order of preference.
+	implementations        []Implementation
+	connectionStateHandler client.ConnectionStateHandlerFunc
 }
 
 // Parameter is the interface for service parameters.
@@ -44,6 +51,15 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -58,11 +74,32 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithImplementations sets the implementations to try, in order of preference.
+// For example, WithImplementations(ImplementationStandardHTTP) will only connect using the standard API.
+func WithImplementations(implementations ...Implementation) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.implementations = implementations
+	})
+}
+
+// WithConnectionStateHandler sets a handler to be called when the state of the connection to the beacon node changes.
+// Only changes in the state of the chosen implementation's connection are passed to the handler.
+func WithConnectionStateHandler(handler client.ConnectionStateHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionStateHandler = handler
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
 		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
 		timeout:  2 * time.Minute,
+		implementations: []Implementation{
+			ImplementationStandardHTTP,
+			ImplementationPrysmGRPC,
+		},
 	}
 	for _, p := range params {
 		if params != nil {
@@ -73,6 +110,19 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	if len(parameters.implementations) == 0 {
+		return nil, errors.New("no implementations specified")
+	}
+	seen := make(map[Implementation]bool)
+	for _, implementation := range parameters.implementations {
+		if implementation != ImplementationStandardHTTP && implementation != ImplementationPrysmGRPC {
+			return nil, fmt.Errorf("unsupported implementation %d", int(implementation))
+		}
+		if seen[implementation] {
+			return nil, fmt.Errorf("duplicate implementation %v", implementation)
+		}
+		seen[implementation] = true
+	}
 
 	return &parameters, nil
 }
@@ -15,19 +15,17 @@ package auto
 
 import (
 	"context"
+	"sync"
 
 	client "github.com/attestantio/go-eth2-client"
 	"github.com/attestantio/go-eth2-client/prysmgrpc"
 	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
 	"github.com/pkg/errors"
-	"github.com/rs/zerolog"
-	zerologger "github.com/rs/zerolog/log"
 )
 
-// log is a service-wide logger.
-var log zerolog.Logger
-
 // New creates a new Ethereum 2 client service, trying different implementations at the given address.
+// The implementations are tried concurrently, and the most preferred implementation that connects is returned.
+// The implementation chosen is reported by the service's Name(), and the beacon node software by its NodeFamily().
 func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
 	if err != nil {
@@ -35,34 +33,133 @@ func New(ctx context.Context, params ...Parameter) (client.Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "auto").Logger()
+	log := parameters.logger.With().Str("service", "client").Str("impl", "auto").Str("address", parameters.address).Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
-	// Try standard.
-	standardClient, err := tryStandard(ctx, parameters)
-	if err == nil {
-		return standardClient, nil
+	// Try all implementations at the same time.
+	// Each attempt has its own context, so that services not chosen can be closed.
+	results := make([]chan *attemptResult, len(parameters.implementations))
+	cancels := make([]context.CancelFunc, len(parameters.implementations))
+	relays := make([]*connectionStateRelay, len(parameters.implementations))
+	for i, implementation := range parameters.implementations {
+		results[i] = make(chan *attemptResult, 1)
+		var attemptCtx context.Context
+		attemptCtx, cancels[i] = context.WithCancel(ctx)
+		if parameters.connectionStateHandler != nil {
+			relays[i] = &connectionStateRelay{handler: parameters.connectionStateHandler}
+		}
+		go func(ctx context.Context, implementation Implementation, relay *connectionStateRelay, res chan<- *attemptResult) {
+			service, err := try(ctx, implementation, parameters, relay)
+			res <- &attemptResult{
+				service: service,
+				err:     err,
+			}
+		}(attemptCtx, implementation, relays[i], results[i])
+	}
+
+	// Take the results in order of preference.
+	chosen := -1
+	var service client.Service
+	connectErr := &ConnectError{
+		Attempts: make([]*ConnectAttempt, 0, len(parameters.implementations)),
+	}
+	for i, implementation := range parameters.implementations {
+		res := <-results[i]
+		if res.err == nil {
+			chosen = i
+			service = res.service
+			break
+		}
+		log.Debug().Stringer("implementation", implementation).Err(res.err).Msg("Attempt to connect failed")
+		connectErr.Attempts = append(connectErr.Attempts, &ConnectAttempt{
+			Implementation: implementation,
+			Err:            res.err,
+		})
+	}
+
+	// Close any other services, including those still connecting.
+	for i := range cancels {
+		if i != chosen {
+			cancels[i]()
+		}
+	}
+
+	if chosen == -1 {
+		return nil, connectErr
+	}
+	if relays[chosen] != nil {
+		relays[chosen].choose()
+	}
+
+	if e := log.Debug(); e.Enabled() {
+		family := client.FamilyUnknown
+		if provider, isProvider := service.(client.NodeFamilyProvider); isProvider {
+			family, _ = provider.NodeFamily(ctx)
+		}
+		e.Stringer("implementation", parameters.implementations[chosen]).Stringer("family", family).Msg("Connected")
+	}
+
+	return service, nil
+}
+
+// attemptResult is the result of an attempt to connect with an implementation.
+type attemptResult struct {
+	service client.Service
+	err     error
+}
+
+// connectionStateRelay passes on changes in the state of an attempt's connection once the attempt has been chosen,
+// so that the handler does not see the connections of attempts that are closed.
+type connectionStateRelay struct {
+	handler client.ConnectionStateHandlerFunc
+	mu      sync.Mutex
+	chosen  bool
+	state   *client.ConnectionState
+}
+
+// handle handles a change in the state of the connection.
+func (r *connectionStateRelay) handle(state client.ConnectionState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state = &state
+	if r.chosen {
+		r.handler(state)
 	}
-	log.Trace().Err(err).Msg("Attempt to connect via standard API failed")
+}
 
-	// Try prysm.
-	prysmClient, err := tryPrysm(ctx, parameters)
-	if err == nil {
-		return prysmClient, nil
+// choose marks the attempt as chosen, passing on the current state of its connection.
+func (r *connectionStateRelay) choose() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.chosen = true
+	if r.state != nil {
+		r.handler(*r.state)
 	}
-	log.Trace().Err(err).Msg("Attempt to connect via Prysm API failed")
+}
 
-	// No luck
-	return nil, errors.New("failed to connect to Ethereum 2 client with any known method")
+// try attempts to connect with the given implementation.
+func try(ctx context.Context, implementation Implementation, parameters *parameters, relay *connectionStateRelay) (client.Service, error) {
+	switch implementation {
+	case ImplementationStandardHTTP:
+		return tryStandard(ctx, parameters, relay)
+	case ImplementationPrysmGRPC:
+		return tryPrysm(ctx, parameters, relay)
+	default:
+		return nil, errors.New("unsupported implementation")
+	}
 }
 
-func tryStandard(ctx context.Context, parameters *parameters) (*standardhttp.Service, error) {
+func tryStandard(ctx context.Context, parameters *parameters, relay *connectionStateRelay) (*standardhttp.Service, error) {
 	standardhttpParameters := make([]standardhttp.Parameter, 0)
+	standardhttpParameters = append(standardhttpParameters, standardhttp.WithLogger(parameters.logger))
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithLogLevel(parameters.logLevel))
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithAddress(parameters.address))
 	standardhttpParameters = append(standardhttpParameters, standardhttp.WithTimeout(parameters.timeout))
+	if relay != nil {
+		standardhttpParameters = append(standardhttpParameters, standardhttp.WithConnectionStateHandler(relay.handle))
+	}
 	client, err := standardhttp.New(ctx, standardhttpParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection with standard API")
@@ -70,11 +167,15 @@ func tryStandard(ctx context.Context, parameters *parameters) (*standardhttp.Ser
 	return client, nil
 }
 
-func tryPrysm(ctx context.Context, parameters *parameters) (*prysmgrpc.Service, error) {
+func tryPrysm(ctx context.Context, parameters *parameters, relay *connectionStateRelay) (*prysmgrpc.Service, error) {
 	prysmParameters := make([]prysmgrpc.Parameter, 0)
+	prysmParameters = append(prysmParameters, prysmgrpc.WithLogger(parameters.logger))
 	prysmParameters = append(prysmParameters, prysmgrpc.WithLogLevel(parameters.logLevel))
 	prysmParameters = append(prysmParameters, prysmgrpc.WithAddress(parameters.address))
 	prysmParameters = append(prysmParameters, prysmgrpc.WithTimeout(parameters.timeout))
+	if relay != nil {
+		prysmParameters = append(prysmParameters, prysmgrpc.WithConnectionStateHandler(relay.handle))
+	}
 	client, err := prysmgrpc.New(ctx, prysmParameters...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed when trying to open connection to prysm")
@@ -15,7 +15,9 @@ package auto_test
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -27,46 +29,104 @@ import (
 
 func TestService(t *testing.T) {
 	tests := []struct {
-		name    string
-		address string
-		err     string
-		version string
+		name            string
+		address         string
+		implementations []auto.Implementation
+		err             string
+		attempts        int
+		version         string
+		family          eth2client.Family
 	}{
 		{
 			name: "AddressMissing",
 			err:  "problem with parameters: no address specified",
 		},
+		{
+			name:            "ImplementationsEmpty",
+			address:         os.Getenv("PRYSMGRPC_ADDRESS"),
+			implementations: []auto.Implementation{},
+			err:             "problem with parameters: no implementations specified",
+		},
+		{
+			name:            "ImplementationsDuplicate",
+			address:         os.Getenv("PRYSMGRPC_ADDRESS"),
+			implementations: []auto.Implementation{auto.ImplementationPrysmGRPC, auto.ImplementationPrysmGRPC},
+			err:             "problem with parameters: duplicate implementation Prysm gRPC",
+		},
+		{
+			name:            "ImplementationsUnknown",
+			address:         os.Getenv("PRYSMGRPC_ADDRESS"),
+			implementations: []auto.Implementation{auto.Implementation(99)},
+			err:             "problem with parameters: unsupported implementation 99",
+		},
 		{
 			name:    "Prysm",
 			address: os.Getenv("PRYSMGRPC_ADDRESS"),
 			version: "Prysm",
+			family:  eth2client.FamilyPrysm,
+		},
+		{
+			name:            "PrysmOnly",
+			address:         os.Getenv("PRYSMGRPC_ADDRESS"),
+			implementations: []auto.Implementation{auto.ImplementationPrysmGRPC},
+			version:         "Prysm",
+			family:          eth2client.FamilyPrysm,
+		},
+		{
+			name:            "PrysmStandardOnly",
+			address:         os.Getenv("PRYSMGRPC_ADDRESS"),
+			implementations: []auto.Implementation{auto.ImplementationStandardHTTP},
+			err:             "failed to connect to Ethereum 2 client with any known method: standard HTTP: ",
+			attempts:        1,
 		},
 		{
 			name:    "Lighthouse",
 			address: os.Getenv("LIGHTHOUSEHTTP_ADDRESS"),
 			version: "Lighthouse",
+			family:  eth2client.FamilyLighthouse,
+		},
+		{
+			name:            "LighthousePrysmFirst",
+			address:         os.Getenv("LIGHTHOUSEHTTP_ADDRESS"),
+			implementations: []auto.Implementation{auto.ImplementationPrysmGRPC, auto.ImplementationStandardHTTP},
+			version:         "Lighthouse",
+			family:          eth2client.FamilyLighthouse,
 		},
 		{
-			name:    "BadPort",
-			address: "localhost:22",
-			err:     "failed to connect to Ethereum 2 client with any known method",
+			name:     "BadPort",
+			address:  "localhost:22",
+			err:      "failed to connect to Ethereum 2 client with any known method: standard HTTP: ",
+			attempts: 2,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			service, err := auto.New(context.Background(),
+			params := []auto.Parameter{
 				auto.WithLogLevel(zerolog.Disabled),
-				auto.WithTimeout(60*time.Second),
+				auto.WithTimeout(60 * time.Second),
 				auto.WithAddress(test.address),
-			)
+			}
+			if test.implementations != nil {
+				params = append(params, auto.WithImplementations(test.implementations...))
+			}
+			service, err := auto.New(context.Background(), params...)
 			if test.err != "" {
-				require.EqualError(t, err, test.err)
+				require.Error(t, err)
+				require.True(t, strings.HasPrefix(err.Error(), test.err), err.Error())
+				if test.attempts > 0 {
+					var connectErr *auto.ConnectError
+					require.True(t, errors.As(err, &connectErr))
+					require.Len(t, connectErr.Attempts, test.attempts)
+				}
 			} else {
 				require.NoError(t, err)
 				version, err := service.(eth2client.NodeVersionProvider).NodeVersion(context.Background())
 				require.NoError(t, err)
 				require.Contains(t, version, test.version)
+				family, err := service.(eth2client.NodeFamilyProvider).NodeFamily(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, test.family, family)
 			}
 		})
 	}
@@ -0,0 +1,467 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Family is the family of the beacon node software behind a service.
+type Family int
+
+const (
+	// FamilyUnknown is a beacon node that could not be identified.
+	FamilyUnknown Family = iota
+	// FamilyLighthouse is a Lighthouse beacon node.
+	FamilyLighthouse
+	// FamilyTeku is a Teku beacon node.
+	FamilyTeku
+	// FamilyPrysm is a Prysm beacon node.
+	FamilyPrysm
+	// FamilyNimbus is a Nimbus beacon node.
+	FamilyNimbus
+)
+
+var familyStrings = [...]string{
+	"unknown",
+	"lighthouse",
+	"teku",
+	"prysm",
+	"nimbus",
+}
+
+// String returns a string representation of the family.
+func (f Family) String() string {
+	if f < 0 || int(f) >= len(familyStrings) {
+		return "unknown"
+	}
+	return familyStrings[f]
+}
+
+// ParseFamily parses the family from a node version string, for example "Lighthouse/v1.4.0-3b4865c/x86_64-linux".
+func ParseFamily(version string) Family {
+	name := strings.ToLower(strings.TrimSpace(version))
+	if idx := strings.Index(name, "/"); idx != -1 {
+		name = name[:idx]
+	}
+	for i := range familyStrings {
+		if i != int(FamilyUnknown) && strings.HasPrefix(name, familyStrings[i]) {
+			return Family(i)
+		}
+	}
+	return FamilyUnknown
+}
+
+// Capabilities are the capabilities of a service.
+type Capabilities struct {
+	// Family is the family of the beacon node, obtained from its version.
+	Family Family
+	// NodeVersion is the version reported by the beacon node, if available.
+	NodeVersion string
+	// Providers are the names of the provider and lifecycle interfaces implemented by the service, for example
+	// "GenesisProvider" or "Closer".
+	Providers []string
+	// Answered reports, for each probed provider interface, if the beacon node answered the probe.
+	// It is nil if the service was not probed.
+	Answered map[string]bool
+}
+
+// Implements returns true if the service implements the named provider interface.
+func (c *Capabilities) Implements(provider string) bool {
+	for i := range c.Providers {
+		if c.Providers[i] == provider {
+			return true
+		}
+	}
+	return false
+}
+
+// Supports returns true if the service implements the named provider interface and,
+// if the service was probed, the beacon node did not fail to answer the probe.
+func (c *Capabilities) Supports(provider string) bool {
+	if !c.Implements(provider) {
+		return false
+	}
+	if c.Answered == nil {
+		return true
+	}
+	answered, probed := c.Answered[provider]
+	return !probed || answered
+}
+
+// capability is a provider interface that can be discovered.
+type capability struct {
+	name       string
+	implements func(Service) bool
+	// probe is a cheap call to the beacon node that uses the interface; nil if there is no suitable call.
+	probe func(context.Context, Service) error
+}
+
+// capabilities are the provider and lifecycle interfaces that can be discovered.
+// Submitters are not probed, as a probe would have side effects.
+var capabilities = []*capability{
+	{
+		name:       "AggregateAndProofDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(AggregateAndProofDomainProvider); return isProvider },
+	},
+	{
+		name:       "AggregateAttestationProvider",
+		implements: func(s Service) bool { _, isProvider := s.(AggregateAttestationProvider); return isProvider },
+	},
+	{
+		name:       "AggregateAttestationsSubmitter",
+		implements: func(s Service) bool { _, isProvider := s.(AggregateAttestationsSubmitter); return isProvider },
+	},
+	{
+		name:       "AttestationDataProvider",
+		implements: func(s Service) bool { _, isProvider := s.(AttestationDataProvider); return isProvider },
+	},
+	{
+		name:       "AttestationPoolProvider",
+		implements: func(s Service) bool { _, isProvider := s.(AttestationPoolProvider); return isProvider },
+	},
+	{
+		name:       "AttestationsSubmitter",
+		implements: func(s Service) bool { _, isProvider := s.(AttestationsSubmitter); return isProvider },
+	},
+	{
+		name:       "AttesterDutiesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(AttesterDutiesProvider); return isProvider },
+	},
+	{
+		name:       "BeaconAttesterDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconAttesterDomainProvider); return isProvider },
+	},
+	{
+		name:       "BeaconBlockHeadersProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconBlockHeadersProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(BeaconBlockHeadersProvider).BeaconBlockHeader(ctx, "head")
+			return err
+		},
+	},
+	{
+		name:       "BeaconBlockProposalProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconBlockProposalProvider); return isProvider },
+	},
+	{
+		name:       "BeaconBlockRootProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconBlockRootProvider); return isProvider },
+	},
+	{
+		name:       "BeaconBlockSubmitter",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconBlockSubmitter); return isProvider },
+	},
+	{
+		name:       "BeaconChainHeadUpdatedHandler",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconChainHeadUpdatedHandler); return isProvider },
+	},
+	{
+		name:       "BeaconChainHeadUpdatedSource",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconChainHeadUpdatedSource); return isProvider },
+	},
+	{
+		name:       "BeaconCommitteeSubscriptionsSubmitter",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconCommitteeSubscriptionsSubmitter); return isProvider },
+	},
+	{
+		name:       "BeaconCommitteesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconCommitteesProvider); return isProvider },
+	},
+	{
+		name:       "BeaconProposerDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconProposerDomainProvider); return isProvider },
+	},
+	{
+		name:       "BeaconStateProvider",
+		implements: func(s Service) bool { _, isProvider := s.(BeaconStateProvider); return isProvider },
+	},
+	{
+		name:       "BlockIDResolver",
+		implements: func(s Service) bool { _, isProvider := s.(BlockIDResolver); return isProvider },
+	},
+	{
+		name:       "ChainSpecProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ChainSpecProvider); return isProvider },
+	},
+	{
+		name:       "Closer",
+		implements: func(s Service) bool { _, isProvider := s.(Closer); return isProvider },
+	},
+	{
+		name:       "ConnectionStateProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ConnectionStateProvider); return isProvider },
+	},
+	{
+		name:       "DepositContractProvider",
+		implements: func(s Service) bool { _, isProvider := s.(DepositContractProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(DepositContractProvider).DepositContractAddress(ctx)
+			return err
+		},
+	},
+	{
+		name:       "DepositDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(DepositDomainProvider); return isProvider },
+	},
+	{
+		name:       "DomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(DomainProvider); return isProvider },
+	},
+	{
+		name:       "EpochFromStateIDProvider",
+		implements: func(s Service) bool { _, isProvider := s.(EpochFromStateIDProvider); return isProvider },
+	},
+	{
+		name:       "EventsProvider",
+		implements: func(s Service) bool { _, isProvider := s.(EventsProvider); return isProvider },
+	},
+	{
+		name:       "FarFutureEpochProvider",
+		implements: func(s Service) bool { _, isProvider := s.(FarFutureEpochProvider); return isProvider },
+	},
+	{
+		name:       "FinalityProvider",
+		implements: func(s Service) bool { _, isProvider := s.(FinalityProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(FinalityProvider).Finality(ctx, "head")
+			return err
+		},
+	},
+	{
+		name:       "ForkProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ForkProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(ForkProvider).Fork(ctx, "head")
+			return err
+		},
+	},
+	{
+		name:       "ForkScheduleProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ForkScheduleProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(ForkScheduleProvider).ForkSchedule(ctx)
+			return err
+		},
+	},
+	{
+		name:       "GenesisProvider",
+		implements: func(s Service) bool { _, isProvider := s.(GenesisProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(GenesisProvider).Genesis(ctx)
+			return err
+		},
+	},
+	{
+		name:       "GenesisTimeProvider",
+		implements: func(s Service) bool { _, isProvider := s.(GenesisTimeProvider); return isProvider },
+	},
+	{
+		name:       "GenesisValidatorsRootProvider",
+		implements: func(s Service) bool { _, isProvider := s.(GenesisValidatorsRootProvider); return isProvider },
+	},
+	{
+		name:       "NodeFamilyProvider",
+		implements: func(s Service) bool { _, isProvider := s.(NodeFamilyProvider); return isProvider },
+	},
+	{
+		name:       "NodeSyncingProvider",
+		implements: func(s Service) bool { _, isProvider := s.(NodeSyncingProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(NodeSyncingProvider).NodeSyncing(ctx)
+			return err
+		},
+	},
+	{
+		name:       "NodeVersionProvider",
+		implements: func(s Service) bool { _, isProvider := s.(NodeVersionProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(NodeVersionProvider).NodeVersion(ctx)
+			return err
+		},
+	},
+	{
+		name:       "ProposerDutiesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ProposerDutiesProvider); return isProvider },
+	},
+	{
+		name:       "PrysmAggregateAttestationProvider",
+		implements: func(s Service) bool { _, isProvider := s.(PrysmAggregateAttestationProvider); return isProvider },
+	},
+	{
+		name:       "PrysmAttesterDutiesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(PrysmAttesterDutiesProvider); return isProvider },
+	},
+	{
+		name:       "PrysmProposerDutiesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(PrysmProposerDutiesProvider); return isProvider },
+	},
+	{
+		name:       "PrysmValidatorBalancesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(PrysmValidatorBalancesProvider); return isProvider },
+	},
+	{
+		name:       "RANDAODomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(RANDAODomainProvider); return isProvider },
+	},
+	{
+		name:       "SelectionProofDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SelectionProofDomainProvider); return isProvider },
+	},
+	{
+		name:       "SignedBeaconBlockProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SignedBeaconBlockProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(SignedBeaconBlockProvider).SignedBeaconBlock(ctx, "head")
+			return err
+		},
+	},
+	{
+		name:       "SlotDurationProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SlotDurationProvider); return isProvider },
+	},
+	{
+		name:       "SlotFromStateIDProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SlotFromStateIDProvider); return isProvider },
+	},
+	{
+		name:       "SlotsPerEpochProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SlotsPerEpochProvider); return isProvider },
+	},
+	{
+		name:       "SpecProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SpecProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(SpecProvider).Spec(ctx)
+			return err
+		},
+	},
+	{
+		name:       "StateIDResolver",
+		implements: func(s Service) bool { _, isProvider := s.(StateIDResolver); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(StateIDResolver).ResolveStateID(ctx, api.StateIDHead())
+			return err
+		},
+	},
+	{
+		name:       "SyncStateProvider",
+		implements: func(s Service) bool { _, isProvider := s.(SyncStateProvider); return isProvider },
+	},
+	{
+		name:       "TargetAggregatorsPerCommitteeProvider",
+		implements: func(s Service) bool { _, isProvider := s.(TargetAggregatorsPerCommitteeProvider); return isProvider },
+	},
+	{
+		name:       "ValidatorBalancesProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorBalancesProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(ValidatorBalancesProvider).ValidatorBalances(ctx, "head", []spec.ValidatorIndex{0})
+			return err
+		},
+	},
+	{
+		name:       "ValidatorIDProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorIDProvider); return isProvider },
+	},
+	{
+		name:       "ValidatorIndexProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorIndexProvider); return isProvider },
+	},
+	{
+		name:       "ValidatorPubKeyProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorPubKeyProvider); return isProvider },
+	},
+	{
+		name:       "ValidatorsProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorsProvider); return isProvider },
+		probe: func(ctx context.Context, s Service) error {
+			_, err := s.(ValidatorsProvider).Validators(ctx, "head", []spec.ValidatorIndex{0})
+			return err
+		},
+	},
+	{
+		name:       "ValidatorsWithoutBalanceProvider",
+		implements: func(s Service) bool { _, isProvider := s.(ValidatorsWithoutBalanceProvider); return isProvider },
+	},
+	{
+		name:       "VoluntaryExitDomainProvider",
+		implements: func(s Service) bool { _, isProvider := s.(VoluntaryExitDomainProvider); return isProvider },
+	},
+	{
+		name:       "VoluntaryExitSubmitter",
+		implements: func(s Service) bool { _, isProvider := s.(VoluntaryExitSubmitter); return isProvider },
+	},
+}
+
+// DiscoverCapabilities reports the capabilities of a service.
+// If probe is true then the beacon node is sent a cheap request for each provider interface that has one,
+// to find out if the node answers it.
+func DiscoverCapabilities(ctx context.Context, service Service, probe bool) (*Capabilities, error) {
+	if service == nil {
+		return nil, errors.New("no service supplied")
+	}
+
+	res := &Capabilities{
+		Providers: make([]string, 0, len(capabilities)),
+	}
+	if probe {
+		res.Answered = make(map[string]bool)
+	}
+
+	for _, capability := range capabilities {
+		if !capability.implements(service) {
+			continue
+		}
+		res.Providers = append(res.Providers, capability.name)
+		if probe && capability.probe != nil {
+			res.Answered[capability.name] = answered(capability.probe(ctx, service))
+		}
+	}
+
+	if provider, isProvider := service.(NodeVersionProvider); isProvider {
+		version, err := provider.NodeVersion(ctx)
+		if err == nil {
+			res.NodeVersion = version
+			res.Family = ParseFamily(version)
+		}
+	}
+	if provider, isProvider := service.(NodeFamilyProvider); isProvider && res.Family == FamilyUnknown {
+		family, err := provider.NodeFamily(ctx)
+		if err == nil {
+			res.Family = family
+		}
+	}
+
+	return res, nil
+}
+
+// answered returns true if the error from a probe shows that the beacon node answered the request.
+// The node has answered if the request succeeded, or if it was rejected for a reason other than the
+// endpoint being unknown or unsupported.
+func answered(err error) bool {
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrNotSupported) {
+		return false
+	}
+	var apiErr *APIError
+	return errors.As(err, &apiErr)
+}
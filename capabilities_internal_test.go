@@ -0,0 +1,57 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCapabilitiesComplete ensures that every interface in service.go can be discovered.
+func TestCapabilitiesComplete(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "service.go", nil, 0)
+	require.NoError(t, err)
+
+	// Service is common to all services.
+	excluded := map[string]bool{
+		"Service": true,
+	}
+
+	interfaces := make([]string, 0)
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			if _, isInterface := typeSpec.Type.(*ast.InterfaceType); isInterface && !excluded[typeSpec.Name.Name] {
+				interfaces = append(interfaces, typeSpec.Name.Name)
+			}
+		}
+	}
+	sort.Strings(interfaces)
+
+	names := make([]string, 0, len(capabilities))
+	for _, capability := range capabilities {
+		names = append(names, capability.name)
+	}
+	require.True(t, sort.StringsAreSorted(names), "capabilities are not sorted by name")
+	require.Equal(t, interfaces, names)
+}
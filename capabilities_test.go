@@ -0,0 +1,199 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// probedService is a service whose probes return preset errors.
+type probedService struct {
+	version    string
+	versionErr error
+	genesisErr error
+	syncingErr error
+}
+
+func (s *probedService) Name() string    { return "probed" }
+func (s *probedService) Address() string { return "probed" }
+
+func (s *probedService) NodeVersion(ctx context.Context) (string, error) {
+	return s.version, s.versionErr
+}
+
+func (s *probedService) Genesis(ctx context.Context) (*api.Genesis, error) {
+	return &api.Genesis{}, s.genesisErr
+}
+
+func (s *probedService) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	return &api.SyncState{}, s.syncingErr
+}
+
+// lifecycleService is a service that implements the lifecycle interfaces.
+type lifecycleService struct {
+	probedService
+}
+
+func (s *lifecycleService) Close(ctx context.Context) error { return nil }
+func (s *lifecycleService) Wait(ctx context.Context) error  { return nil }
+
+func (s *lifecycleService) ConnectionState() client.ConnectionState {
+	return client.ConnectionStateConnected
+}
+
+func (s *lifecycleService) AddOnBeaconChainHeadUpdatedHandler(ctx context.Context, handler client.BeaconChainHeadUpdatedHandler) error {
+	return nil
+}
+
+func TestParseFamily(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected client.Family
+	}{
+		{version: "", expected: client.FamilyUnknown},
+		{version: "Lighthouse/v1.4.0-3b4865c/x86_64-linux", expected: client.FamilyLighthouse},
+		{version: "teku/v21.5.0/linux-x86_64/-privatebuild-openjdk64bitservervm-java-11", expected: client.FamilyTeku},
+		{version: "Prysm/v1.3.9/5f3f7ba2d5e6bd1b4b5b85eb3e86d0d2f7e2d3f0", expected: client.FamilyPrysm},
+		{version: "Nimbus/v1.3.0-1c5a9c5b-stateofus", expected: client.FamilyNimbus},
+		{version: "Other/v1.0.0", expected: client.FamilyUnknown},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			family := client.ParseFamily(test.version)
+			require.Equal(t, test.expected, family)
+		})
+	}
+}
+
+func TestFamilyString(t *testing.T) {
+	require.Equal(t, "unknown", client.FamilyUnknown.String())
+	require.Equal(t, "lighthouse", client.FamilyLighthouse.String())
+	require.Equal(t, "teku", client.FamilyTeku.String())
+	require.Equal(t, "prysm", client.FamilyPrysm.String())
+	require.Equal(t, "nimbus", client.FamilyNimbus.String())
+	require.Equal(t, "unknown", client.Family(99).String())
+}
+
+func TestDiscoverCapabilities(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := client.DiscoverCapabilities(ctx, nil, false)
+	require.EqualError(t, err, "no service supplied")
+
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		service   client.Service
+		probe     bool
+		family    client.Family
+		providers []string
+		answered  map[string]bool
+		supports  map[string]bool
+	}{
+		{
+			name:    "Mock",
+			service: mockService,
+			probe:   true,
+			family:  client.FamilyUnknown,
+			supports: map[string]bool{
+				"GenesisProvider":             true,
+				"ForkProvider":                true,
+				"BeaconBlockProposalProvider": true,
+				"BeaconStateProvider":         true,
+			},
+		},
+		{
+			name:      "NotProbed",
+			service:   &probedService{version: "Lighthouse/v1.4.0", genesisErr: client.ErrNotFound},
+			family:    client.FamilyLighthouse,
+			providers: []string{"GenesisProvider", "NodeSyncingProvider", "NodeVersionProvider"},
+			supports: map[string]bool{
+				"GenesisProvider": true,
+			},
+		},
+		{
+			name:      "Lifecycle",
+			service:   &lifecycleService{probedService{version: "Prysm/v1.3.9"}},
+			family:    client.FamilyPrysm,
+			providers: []string{"BeaconChainHeadUpdatedSource", "Closer", "ConnectionStateProvider", "GenesisProvider", "NodeSyncingProvider", "NodeVersionProvider"},
+			supports: map[string]bool{
+				"Closer":                  true,
+				"ConnectionStateProvider": true,
+			},
+		},
+		{
+			name: "Probed",
+			service: &probedService{
+				version:    "teku/v21.5.0",
+				genesisErr: client.NewAPIError("GET", "/eth/v1/beacon/genesis", 404, nil),
+				syncingErr: client.NewAPIError("GET", "/eth/v1/node/syncing", 500, nil),
+			},
+			probe:     true,
+			family:    client.FamilyTeku,
+			providers: []string{"GenesisProvider", "NodeSyncingProvider", "NodeVersionProvider"},
+			answered: map[string]bool{
+				"GenesisProvider":     false,
+				"NodeSyncingProvider": true,
+				"NodeVersionProvider": true,
+			},
+			supports: map[string]bool{
+				"GenesisProvider":     false,
+				"NodeSyncingProvider": true,
+				"ForkProvider":        false,
+			},
+		},
+		{
+			name: "ConnectionFailure",
+			service: &probedService{
+				versionErr: errors.New("connection refused"),
+				genesisErr: errors.New("connection refused"),
+				syncingErr: errors.New("connection refused"),
+			},
+			probe:     true,
+			providers: []string{"GenesisProvider", "NodeSyncingProvider", "NodeVersionProvider"},
+			answered: map[string]bool{
+				"GenesisProvider":     false,
+				"NodeSyncingProvider": false,
+				"NodeVersionProvider": false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capabilities, err := client.DiscoverCapabilities(ctx, test.service, test.probe)
+			require.NoError(t, err)
+			require.Equal(t, test.family, capabilities.Family)
+			if test.providers != nil {
+				require.Equal(t, test.providers, capabilities.Providers)
+			}
+			if test.answered != nil || !test.probe {
+				require.Equal(t, test.answered, capabilities.Answered)
+			}
+			for provider, supported := range test.supports {
+				require.Equal(t, supported, capabilities.Supports(provider), provider)
+			}
+		})
+	}
+}
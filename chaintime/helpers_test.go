@@ -0,0 +1,103 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testChain provides chain time values.
+type testChain struct {
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	err           error
+}
+
+func (c *testChain) GenesisTime(ctx context.Context) (time.Time, error) {
+	return c.genesisTime, c.err
+}
+
+func (c *testChain) SlotDuration(ctx context.Context) (time.Duration, error) {
+	return c.slotDuration, nil
+}
+
+func (c *testChain) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	return c.slotsPerEpoch, nil
+}
+
+// testClock is a clock that only moves when advanced.
+type testClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*testWaiter
+}
+
+type testWaiter struct {
+	until time.Time
+	ch    chan time.Time
+}
+
+func newTestClock(now time.Time) *testClock {
+	return &testClock{
+		now: now,
+	}
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	waiter := &testWaiter{
+		until: c.now.Add(d),
+		ch:    make(chan time.Time, 1),
+	}
+	c.waiters = append(c.waiters, waiter)
+	return waiter.ch
+}
+
+// Advance moves the clock forward, releasing any waiters whose time has been reached.
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	waiters := make([]*testWaiter, 0, len(c.waiters))
+	for _, waiter := range c.waiters {
+		if waiter.until.After(c.now) {
+			waiters = append(waiters, waiter)
+		} else {
+			waiter.ch <- c.now
+		}
+	}
+	c.waiters = waiters
+}
+
+// waitForWaiter waits until something is waiting on the clock.
+func (c *testClock) waitForWaiter(t *testing.T) {
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.waiters) > 0
+	}, time.Second, time.Millisecond)
+}
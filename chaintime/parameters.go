@@ -0,0 +1,115 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel              zerolog.Level
+	logger                zerolog.Logger
+	genesisTimeProvider   eth2client.GenesisTimeProvider
+	slotDurationProvider  eth2client.SlotDurationProvider
+	slotsPerEpochProvider eth2client.SlotsPerEpochProvider
+	clock                 Clock
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithGenesisTimeProvider sets the genesis time provider.
+func WithGenesisTimeProvider(provider eth2client.GenesisTimeProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTimeProvider = provider
+	})
+}
+
+// WithSlotDurationProvider sets the slot duration provider.
+func WithSlotDurationProvider(provider eth2client.SlotDurationProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDurationProvider = provider
+	})
+}
+
+// WithSlotsPerEpochProvider sets the slots per epoch provider.
+func WithSlotsPerEpochProvider(provider eth2client.SlotsPerEpochProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpochProvider = provider
+	})
+}
+
+// WithClock sets the clock used to obtain the current time.
+// If not supplied the system clock is used.
+func WithClock(clock Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
+		clock:    systemClock{},
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.genesisTimeProvider == nil {
+		return nil, errors.New("no genesis time provider specified")
+	}
+	if parameters.slotDurationProvider == nil {
+		return nil, errors.New("no slot duration provider specified")
+	}
+	if parameters.slotsPerEpochProvider == nil {
+		return nil, errors.New("no slots per epoch provider specified")
+	}
+	if parameters.clock == nil {
+		return nil, errors.New("no clock specified")
+	}
+
+	return &parameters, nil
+}
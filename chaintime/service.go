@@ -0,0 +1,136 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"context"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service provides chain time information, such as the current slot and epoch.
+type Service struct {
+	log           zerolog.Logger
+	genesisTime   time.Time
+	slotDuration  time.Duration
+	slotsPerEpoch uint64
+	clock         Clock
+}
+
+// New creates a new chain time service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "chaintime").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	genesisTime, err := parameters.genesisTimeProvider.GenesisTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain genesis time")
+	}
+	slotDuration, err := parameters.slotDurationProvider.SlotDuration(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slot duration")
+	}
+	if slotDuration == 0 {
+		return nil, errors.New("slot duration cannot be 0")
+	}
+	slotsPerEpoch, err := parameters.slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+	log.Trace().Time("genesis_time", genesisTime).Dur("slot_duration", slotDuration).Uint64("slots_per_epoch", slotsPerEpoch).Msg("Obtained chain time values")
+
+	s := &Service{
+		log:           log,
+		genesisTime:   genesisTime,
+		slotDuration:  slotDuration,
+		slotsPerEpoch: slotsPerEpoch,
+		clock:         parameters.clock,
+	}
+
+	return s, nil
+}
+
+// GenesisTime provides the time of the chain's genesis.
+func (s *Service) GenesisTime() time.Time {
+	return s.genesisTime
+}
+
+// SlotDuration provides the duration of a slot.
+func (s *Service) SlotDuration() time.Duration {
+	return s.slotDuration
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// StartOfSlot provides the time at which a given slot starts.
+func (s *Service) StartOfSlot(slot spec.Slot) time.Time {
+	return s.genesisTime.Add(time.Duration(slot) * s.slotDuration)
+}
+
+// StartOfEpoch provides the time at which a given epoch starts.
+func (s *Service) StartOfEpoch(epoch spec.Epoch) time.Time {
+	return s.StartOfSlot(s.FirstSlotOfEpoch(epoch))
+}
+
+// CurrentSlot provides the current slot.
+func (s *Service) CurrentSlot() spec.Slot {
+	return s.SlotAtTime(s.clock.Now())
+}
+
+// CurrentEpoch provides the current epoch.
+func (s *Service) CurrentEpoch() spec.Epoch {
+	return s.SlotToEpoch(s.CurrentSlot())
+}
+
+// SlotAtTime provides the slot at a given time.
+// Times before genesis return slot 0.
+func (s *Service) SlotAtTime(timestamp time.Time) spec.Slot {
+	if timestamp.Before(s.genesisTime) {
+		return 0
+	}
+	return spec.Slot(timestamp.Sub(s.genesisTime) / s.slotDuration)
+}
+
+// EpochAtTime provides the epoch at a given time.
+// Times before genesis return epoch 0.
+func (s *Service) EpochAtTime(timestamp time.Time) spec.Epoch {
+	return s.SlotToEpoch(s.SlotAtTime(timestamp))
+}
+
+// SlotToEpoch provides the epoch of a given slot.
+func (s *Service) SlotToEpoch(slot spec.Slot) spec.Epoch {
+	return spec.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// FirstSlotOfEpoch provides the first slot of the given epoch.
+func (s *Service) FirstSlotOfEpoch(epoch spec.Epoch) spec.Slot {
+	return spec.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
@@ -0,0 +1,211 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	chain := &testChain{
+		genesisTime:   time.Unix(1600000000, 0),
+		slotDuration:  12 * time.Second,
+		slotsPerEpoch: 32,
+	}
+
+	tests := []struct {
+		name   string
+		params []chaintime.Parameter
+		err    string
+	}{
+		{
+			name: "GenesisTimeProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+			},
+			err: "problem with parameters: no genesis time provider specified",
+		},
+		{
+			name: "SlotDurationProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+			},
+			err: "problem with parameters: no slot duration provider specified",
+		},
+		{
+			name: "SlotsPerEpochProviderMissing",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(chain),
+			},
+			err: "problem with parameters: no slots per epoch provider specified",
+		},
+		{
+			name: "ClockNil",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+				chaintime.WithClock(nil),
+			},
+			err: "problem with parameters: no clock specified",
+		},
+		{
+			name: "GenesisTimeError",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(&testChain{err: errors.New("mock error")}),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+			},
+			err: "failed to obtain genesis time: mock error",
+		},
+		{
+			name: "SlotDurationZero",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(&testChain{slotsPerEpoch: 32}),
+				chaintime.WithSlotsPerEpochProvider(chain),
+			},
+			err: "slot duration cannot be 0",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(&testChain{slotDuration: time.Second}),
+			},
+			err: "slots per epoch cannot be 0",
+		},
+		{
+			name: "Good",
+			params: []chaintime.Parameter{
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := chaintime.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimes(t *testing.T) {
+	ctx := context.Background()
+
+	genesisTime := time.Unix(1600000000, 0)
+	chain := &testChain{
+		genesisTime:   genesisTime,
+		slotDuration:  12 * time.Second,
+		slotsPerEpoch: 32,
+	}
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		slot  spec.Slot
+		epoch spec.Epoch
+	}{
+		{
+			name:  "BeforeGenesis",
+			now:   genesisTime.Add(-time.Hour),
+			slot:  0,
+			epoch: 0,
+		},
+		{
+			name:  "Genesis",
+			now:   genesisTime,
+			slot:  0,
+			epoch: 0,
+		},
+		{
+			name:  "EndOfFirstSlot",
+			now:   genesisTime.Add(12*time.Second - time.Nanosecond),
+			slot:  0,
+			epoch: 0,
+		},
+		{
+			name:  "StartOfSecondSlot",
+			now:   genesisTime.Add(12 * time.Second),
+			slot:  1,
+			epoch: 0,
+		},
+		{
+			name:  "StartOfSecondEpoch",
+			now:   genesisTime.Add(32 * 12 * time.Second),
+			slot:  32,
+			epoch: 1,
+		},
+		{
+			name:  "Later",
+			now:   genesisTime.Add(100*32*12*time.Second + 5*12*time.Second + 500*time.Millisecond),
+			slot:  3205,
+			epoch: 100,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := chaintime.New(ctx,
+				chaintime.WithLogLevel(zerolog.Disabled),
+				chaintime.WithGenesisTimeProvider(chain),
+				chaintime.WithSlotDurationProvider(chain),
+				chaintime.WithSlotsPerEpochProvider(chain),
+				chaintime.WithClock(newTestClock(test.now)),
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, genesisTime, s.GenesisTime())
+			require.Equal(t, 12*time.Second, s.SlotDuration())
+			require.Equal(t, uint64(32), s.SlotsPerEpoch())
+			require.Equal(t, test.slot, s.CurrentSlot())
+			require.Equal(t, test.epoch, s.CurrentEpoch())
+			require.Equal(t, test.slot, s.SlotAtTime(test.now))
+			require.Equal(t, test.epoch, s.EpochAtTime(test.now))
+			require.Equal(t, test.epoch, s.SlotToEpoch(test.slot))
+			require.Equal(t, genesisTime.Add(time.Duration(test.slot)*12*time.Second), s.StartOfSlot(test.slot))
+			require.Equal(t, spec.Slot(uint64(test.epoch)*32), s.FirstSlotOfEpoch(test.epoch))
+			require.Equal(t, genesisTime.Add(time.Duration(test.epoch)*32*12*time.Second), s.StartOfEpoch(test.epoch))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime
+
+import (
+	"context"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SlotStartTicker provides a channel that receives each slot as it starts.
+// The channel is closed when the context is done.
+func (s *Service) SlotStartTicker(ctx context.Context) <-chan spec.Slot {
+	return s.slotTicker(ctx, 0)
+}
+
+// SlotOneThirdTicker provides a channel that receives each slot when one third of it
+// has elapsed, which is when attestations for the slot are expected.
+// The channel is closed when the context is done.
+func (s *Service) SlotOneThirdTicker(ctx context.Context) <-chan spec.Slot {
+	return s.slotTicker(ctx, s.slotDuration/3)
+}
+
+// SlotTwoThirdsTicker provides a channel that receives each slot when two thirds of it
+// have elapsed, which is when aggregate attestations for the slot are expected.
+// The channel is closed when the context is done.
+func (s *Service) SlotTwoThirdsTicker(ctx context.Context) <-chan spec.Slot {
+	return s.slotTicker(ctx, s.slotDuration*2/3)
+}
+
+// EpochTicker provides a channel that receives each epoch as it starts.
+// The channel is closed when the context is done.
+func (s *Service) EpochTicker(ctx context.Context) <-chan spec.Epoch {
+	ch := make(chan spec.Epoch)
+	go s.tick(ctx,
+		func(n uint64) time.Time { return s.StartOfEpoch(spec.Epoch(n)) },
+		func(t time.Time) uint64 { return uint64(s.EpochAtTime(t)) },
+		func(n uint64) bool {
+			select {
+			case ch <- spec.Epoch(n):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		},
+		func() { close(ch) },
+	)
+	return ch
+}
+
+// slotTicker provides a channel that receives each slot when the given offset into the slot is reached.
+func (s *Service) slotTicker(ctx context.Context, offset time.Duration) <-chan spec.Slot {
+	ch := make(chan spec.Slot)
+	go s.tick(ctx,
+		func(n uint64) time.Time { return s.StartOfSlot(spec.Slot(n)).Add(offset) },
+		func(t time.Time) uint64 { return uint64(s.SlotAtTime(t.Add(-offset))) },
+		func(n uint64) bool {
+			select {
+			case ch <- spec.Slot(n):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		},
+		func() { close(ch) },
+	)
+	return ch
+}
+
+// tick waits for successive tick times, calling send for each one.
+// tickTime provides the time of the given tick, and tickAt provides the latest tick at or before a time.
+// Ticks that are missed, for example because the receiver was slow, are skipped rather than sent late.
+func (s *Service) tick(ctx context.Context,
+	tickTime func(n uint64) time.Time,
+	tickAt func(t time.Time) uint64,
+	send func(n uint64) bool,
+	done func(),
+) {
+	defer done()
+
+	now := s.clock.Now()
+	next := tickAt(now)
+	if tickTime(next).Before(now) {
+		next++
+	}
+	for {
+		if wait := tickTime(next).Sub(now); wait > 0 {
+			select {
+			case <-ctx.Done():
+				s.log.Trace().Msg("Context done; stopping ticker")
+				return
+			case <-s.clock.After(wait):
+			}
+		}
+		if !send(next) {
+			s.log.Trace().Msg("Context done; stopping ticker")
+			return
+		}
+
+		now = s.clock.Now()
+		if latest := tickAt(now); latest > next {
+			s.log.Trace().Uint64("missed_from", next+1).Uint64("missed_to", latest).Msg("Missed ticks")
+			next = latest
+			if tickTime(next).Before(now) {
+				next++
+			}
+		} else {
+			next++
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaintime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/chaintime"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func newTickerService(t *testing.T, now time.Time) (*chaintime.Service, *testClock) {
+	chain := &testChain{
+		genesisTime:   time.Unix(1600000000, 0),
+		slotDuration:  12 * time.Second,
+		slotsPerEpoch: 4,
+	}
+	clock := newTestClock(now)
+	s, err := chaintime.New(context.Background(),
+		chaintime.WithLogLevel(zerolog.Disabled),
+		chaintime.WithGenesisTimeProvider(chain),
+		chaintime.WithSlotDurationProvider(chain),
+		chaintime.WithSlotsPerEpochProvider(chain),
+		chaintime.WithClock(clock),
+	)
+	require.NoError(t, err)
+	return s, clock
+}
+
+func receiveSlot(t *testing.T, ch <-chan spec.Slot) spec.Slot {
+	select {
+	case slot, ok := <-ch:
+		require.True(t, ok)
+		return slot
+	case <-time.After(time.Second):
+		require.FailNow(t, "no tick received")
+	}
+	return 0
+}
+
+// requireClosed requires that a slot ticker channel is closed.
+func requireClosed(t *testing.T, ch <-chan spec.Slot) {
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "ticker not closed")
+	}
+}
+
+func TestSlotTickers(t *testing.T) {
+	genesisTime := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		ticker func(*chaintime.Service, context.Context) <-chan spec.Slot
+		offset time.Duration
+		first  spec.Slot
+	}{
+		{
+			name:   "StartBeforeGenesis",
+			now:    genesisTime.Add(-30 * time.Second),
+			ticker: (*chaintime.Service).SlotStartTicker,
+			first:  0,
+		},
+		{
+			name:   "StartAtSlotStart",
+			now:    genesisTime.Add(24 * time.Second),
+			ticker: (*chaintime.Service).SlotStartTicker,
+			first:  2,
+		},
+		{
+			name:   "StartMidSlot",
+			now:    genesisTime.Add(30 * time.Second),
+			ticker: (*chaintime.Service).SlotStartTicker,
+			first:  3,
+		},
+		{
+			name:   "OneThird",
+			now:    genesisTime.Add(30 * time.Second),
+			ticker: (*chaintime.Service).SlotOneThirdTicker,
+			offset: 4 * time.Second,
+			first:  3,
+		},
+		{
+			name:   "TwoThirdsSameSlot",
+			now:    genesisTime.Add(26 * time.Second),
+			ticker: (*chaintime.Service).SlotTwoThirdsTicker,
+			offset: 8 * time.Second,
+			first:  2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, clock := newTickerService(t, test.now)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ch := test.ticker(s, ctx)
+			for i := spec.Slot(0); i < 3; i++ {
+				expected := test.first + i
+				tickTime := s.StartOfSlot(expected).Add(test.offset)
+				if wait := tickTime.Sub(clock.Now()); wait > 0 {
+					clock.waitForWaiter(t)
+					clock.Advance(wait)
+				}
+				require.Equal(t, expected, receiveSlot(t, ch))
+				require.Equal(t, tickTime, clock.Now())
+			}
+
+			cancel()
+			requireClosed(t, ch)
+		})
+	}
+}
+
+func TestSlotTickerMissed(t *testing.T) {
+	genesisTime := time.Unix(1600000000, 0)
+	s, clock := newTickerService(t, genesisTime)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := s.SlotStartTicker(ctx)
+	require.Equal(t, spec.Slot(0), receiveSlot(t, ch))
+
+	// Advance past a number of slots without receiving; missed ticks are skipped.
+	clock.waitForWaiter(t)
+	clock.Advance(12 * time.Second)
+	clock.Advance(50 * time.Second)
+	require.Equal(t, spec.Slot(1), receiveSlot(t, ch))
+	clock.waitForWaiter(t)
+	clock.Advance(10 * time.Second)
+	require.Equal(t, spec.Slot(6), receiveSlot(t, ch))
+
+	cancel()
+	requireClosed(t, ch)
+}
+
+func TestEpochTicker(t *testing.T) {
+	genesisTime := time.Unix(1600000000, 0)
+	s, clock := newTickerService(t, genesisTime.Add(time.Minute))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := s.EpochTicker(ctx)
+	for _, expected := range []spec.Epoch{2, 3} {
+		clock.waitForWaiter(t)
+		clock.Advance(s.StartOfEpoch(expected).Sub(clock.Now()))
+		select {
+		case epoch := <-ch:
+			require.Equal(t, expected, epoch)
+		case <-time.After(time.Second):
+			require.FailNow(t, "no tick received")
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "ticker not closed")
+	}
+}
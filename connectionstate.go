@@ -0,0 +1,45 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// ConnectionState is the state of a service's connection to its beacon node.
+type ConnectionState int
+
+const (
+	// ConnectionStateDisconnected is a connection that cannot reach the beacon node, or that has been closed.
+	ConnectionStateDisconnected ConnectionState = iota
+	// ConnectionStateDegraded is a connection that reaches the beacon node but is not fully functional, for example
+	// because the node is returning server errors or an event stream is not connected.
+	ConnectionStateDegraded
+	// ConnectionStateConnected is a fully functional connection.
+	ConnectionStateConnected
+)
+
+var connectionStateStrings = [...]string{
+	"disconnected",
+	"degraded",
+	"connected",
+}
+
+// String returns a string representation of the connection state.
+func (c ConnectionState) String() string {
+	if c < 0 || int(c) >= len(connectionStateStrings) {
+		return "unknown"
+	}
+	return connectionStateStrings[c]
+}
+
+// ConnectionStateHandlerFunc is the handler for changes in connection state.
+// It is called synchronously from the service, so should not block.
+type ConnectionStateHandlerFunc func(state ConnectionState)
@@ -0,0 +1,29 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionStateString(t *testing.T) {
+	require.Equal(t, "disconnected", client.ConnectionStateDisconnected.String())
+	require.Equal(t, "degraded", client.ConnectionStateDegraded.String())
+	require.Equal(t, "connected", client.ConnectionStateConnected.String())
+	require.Equal(t, "unknown", client.ConnectionState(99).String())
+	require.Equal(t, "unknown", client.ConnectionState(-1).String())
+}
@@ -0,0 +1,185 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrNotSupported is returned when a client does not support an operation.
+	ErrNotSupported = errors.New("not supported")
+	// ErrNotFound is returned when the requested data is not available.
+	ErrNotFound = errors.New("not found")
+	// ErrBadRequest is returned when a request is rejected as invalid.
+	ErrBadRequest = errors.New("bad request")
+	// ErrSyncing is returned when a node cannot service a request because it is syncing.
+	ErrSyncing = errors.New("node is syncing")
+	// ErrTimeout is returned when a request does not complete in time.
+	ErrTimeout = errors.New("timed out")
+	// ErrClosed is returned when a service is used after it has been closed.
+	ErrClosed = errors.New("service is closed")
+)
+
+// APIError is an error returned by a beacon node API.
+// It matches the sentinel errors in this package with errors.Is according to its status code.
+type APIError struct {
+	// Method is the method of the request, for example "GET".
+	Method string
+	// Endpoint is the endpoint of the request.
+	Endpoint string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the error code supplied in the response body, if any.
+	Code int
+	// Message is the error message supplied in the response body, or the body itself if it could not be parsed.
+	Message string
+	// Failures are the per-item failures supplied in the response body for requests with multiple items.
+	Failures []*APIErrorFailure
+}
+
+// APIErrorFailure is the failure of an individual item in a request.
+type APIErrorFailure struct {
+	// Index is the index of the item in the request.
+	Index int `json:"index"`
+	// Message is the reason for the failure.
+	Message string `json:"message"`
+}
+
+// apiErrorJSON is the standard API representation of an error.
+type apiErrorJSON struct {
+	Code     int                `json:"code"`
+	Message  string             `json:"message"`
+	Failures []*APIErrorFailure `json:"failures"`
+}
+
+// NewAPIError creates an API error from a response, parsing the body if it is a standard API error.
+func NewAPIError(method string, endpoint string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		Endpoint:   endpoint,
+		StatusCode: statusCode,
+	}
+
+	var data apiErrorJSON
+	if err := json.Unmarshal(body, &data); err == nil && (data.Code != 0 || data.Message != "") {
+		apiErr.Code = data.Code
+		apiErr.Message = data.Message
+		apiErr.Failures = data.Failures
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s failed with status %d", e.Method, e.StatusCode))
+	if e.Message != "" {
+		b.WriteString(": ")
+		b.WriteString(e.Message)
+	}
+	for _, failure := range e.Failures {
+		b.WriteString(fmt.Sprintf("; item %d: %s", failure.Index, failure.Message))
+	}
+	return b.String()
+}
+
+// Is returns true if the target is the sentinel error corresponding to the status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrSyncing:
+		return e.StatusCode == http.StatusServiceUnavailable
+	case ErrTimeout:
+		return e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusGatewayTimeout
+	case ErrNotSupported:
+		return e.StatusCode == http.StatusNotImplemented || e.StatusCode == http.StatusMethodNotAllowed
+	default:
+		return false
+	}
+}
+
+// BatchError is returned when a node rejects some of the items in a batch submission.
+// Items that are not listed in the failures were accepted by the node, and should not be resubmitted.
+type BatchError struct {
+	// Items is the number of items in the submission.
+	Items int
+	// Failures are the items rejected by the node, in index order.
+	Failures []*APIErrorFailure
+	err      error
+}
+
+// NewBatchError creates a batch error for a submission of the given number of items.
+func NewBatchError(items int, failures []*APIErrorFailure, err error) *BatchError {
+	sorted := make([]*APIErrorFailure, len(failures))
+	copy(sorted, failures)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+
+	return &BatchError{
+		Items:    items,
+		Failures: sorted,
+		err:      err,
+	}
+}
+
+// Error implements error.
+func (e *BatchError) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("%d of %d items failed", len(e.Failures), e.Items)
+	}
+	return fmt.Sprintf("%d of %d items failed: %v", len(e.Failures), e.Items, e.err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BatchError) Unwrap() error {
+	return e.err
+}
+
+// Failed returns true if the item at the given index was rejected.
+func (e *BatchError) Failed(index int) bool {
+	for _, failure := range e.Failures {
+		if failure.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// Succeeded returns the indices of the items that were not rejected.
+func (e *BatchError) Succeeded() []int {
+	failed := make(map[int]bool, len(e.Failures))
+	for _, failure := range e.Failures {
+		failed[failure.Index] = true
+	}
+	succeeded := make([]int, 0, e.Items)
+	for i := 0; i < e.Items; i++ {
+		if !failed[i] {
+			succeeded = append(succeeded, i)
+		}
+	}
+	return succeeded
+}
@@ -0,0 +1,195 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       []byte
+		expected   *client.APIError
+		msg        string
+		is         error
+	}{
+		{
+			name:       "Empty",
+			statusCode: 500,
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 500,
+			},
+			msg: "GET failed with status 500",
+		},
+		{
+			name:       "Unstructured",
+			statusCode: 503,
+			body:       []byte("Service unavailable\n"),
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 503,
+				Message:    "Service unavailable",
+			},
+			msg: "GET failed with status 503: Service unavailable",
+			is:  client.ErrSyncing,
+		},
+		{
+			name:       "Structured",
+			statusCode: 404,
+			body:       []byte(`{"code":404,"message":"State not found"}`),
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 404,
+				Code:       404,
+				Message:    "State not found",
+			},
+			msg: "GET failed with status 404: State not found",
+			is:  client.ErrNotFound,
+		},
+		{
+			name:       "Failures",
+			statusCode: 400,
+			body:       []byte(`{"code":400,"message":"Some failed","failures":[{"index":1,"message":"invalid signature"},{"index":3,"message":"unknown committee"}]}`),
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 400,
+				Code:       400,
+				Message:    "Some failed",
+				Failures: []*client.APIErrorFailure{
+					{Index: 1, Message: "invalid signature"},
+					{Index: 3, Message: "unknown committee"},
+				},
+			},
+			msg: "GET failed with status 400: Some failed; item 1: invalid signature; item 3: unknown committee",
+			is:  client.ErrBadRequest,
+		},
+		{
+			name:       "Timeout",
+			statusCode: 504,
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 504,
+			},
+			msg: "GET failed with status 504",
+			is:  client.ErrTimeout,
+		},
+		{
+			name:       "NotImplemented",
+			statusCode: 501,
+			expected: &client.APIError{
+				Method:     "GET",
+				Endpoint:   "/eth/v1/node/version",
+				StatusCode: 501,
+			},
+			msg: "GET failed with status 501",
+			is:  client.ErrNotSupported,
+		},
+	}
+
+	sentinels := []error{client.ErrNotFound, client.ErrBadRequest, client.ErrSyncing, client.ErrTimeout, client.ErrNotSupported}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiErr := client.NewAPIError("GET", "/eth/v1/node/version", test.statusCode, test.body)
+			require.Equal(t, test.expected, apiErr)
+			require.EqualError(t, apiErr, test.msg)
+
+			// Sentinels and the API error itself must be reachable through wrapping.
+			for _, err := range []error{
+				pkgerrors.Wrap(apiErr, "failed to request"),
+				fmt.Errorf("failed to request: %w", apiErr),
+			} {
+				for _, sentinel := range sentinels {
+					require.Equal(t, sentinel == test.is, errors.Is(err, sentinel), sentinel.Error())
+				}
+				var target *client.APIError
+				require.True(t, errors.As(err, &target))
+				require.Equal(t, test.statusCode, target.StatusCode)
+			}
+		})
+	}
+}
+
+func TestBatchError(t *testing.T) {
+	apiErr := client.NewAPIError("POST", "/eth/v1/beacon/pool/attestations", 400,
+		[]byte(`{"code":400,"message":"some attestations failed","failures":[{"index":3,"message":"invalid signature"},{"index":1,"message":"unknown block"}]}`))
+
+	tests := []struct {
+		name      string
+		items     int
+		failures  []*client.APIErrorFailure
+		err       error
+		msg       string
+		indices   []int
+		succeeded []int
+	}{
+		{
+			name:      "NoFailures",
+			items:     2,
+			msg:       "0 of 2 items failed",
+			succeeded: []int{0, 1},
+		},
+		{
+			name:      "Failures",
+			items:     5,
+			failures:  apiErr.Failures,
+			err:       pkgerrors.Wrap(apiErr, "failed to submit beacon attestations"),
+			msg:       "2 of 5 items failed: failed to submit beacon attestations: POST failed with status 400: some attestations failed; item 3: invalid signature; item 1: unknown block",
+			indices:   []int{1, 3},
+			succeeded: []int{0, 2, 4},
+		},
+		{
+			name:  "AllFailed",
+			items: 2,
+			failures: []*client.APIErrorFailure{
+				{Index: 0, Message: "bad"},
+				{Index: 1, Message: "bad"},
+			},
+			msg:       "2 of 2 items failed",
+			indices:   []int{0, 1},
+			succeeded: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			batchErr := client.NewBatchError(test.items, test.failures, test.err)
+			require.EqualError(t, batchErr, test.msg)
+			require.Equal(t, test.succeeded, batchErr.Succeeded())
+			require.Len(t, batchErr.Failures, len(test.indices))
+			for i, index := range test.indices {
+				require.Equal(t, index, batchErr.Failures[i].Index)
+				require.True(t, batchErr.Failed(index))
+			}
+			if test.err != nil {
+				var unwrapped *client.APIError
+				require.True(t, errors.As(batchErr, &unwrapped))
+				require.True(t, errors.Is(batchErr, client.ErrBadRequest))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	ctx := context.Background()
+	_, mockService, service := newClient(ctx, t)
+
+	eventsCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var mu sync.Mutex
+	heads := make([]*api.HeadEvent, 0)
+	require.NoError(t, service.Events(eventsCtx, []string{"head"}, func(event *api.Event) {
+		mu.Lock()
+		heads = append(heads, event.Data.(*api.HeadEvent))
+		mu.Unlock()
+	}))
+
+	// The client connects to the stream after a delay, so keep advancing the chain until
+	// it sees an event.
+	for i := 0; i < 50; i++ {
+		require.NoError(t, mockService.Advance(ctx))
+		time.Sleep(100 * time.Millisecond)
+		mu.Lock()
+		received := len(heads)
+		mu.Unlock()
+		if received > 0 {
+			break
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, heads)
+	header, err := mockService.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.LessOrEqual(t, uint64(heads[len(heads)-1].Slot), uint64(header.Header.Message.Slot))
+}
@@ -0,0 +1,69 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode
+
+import (
+	"net/http"
+	"time"
+)
+
+// Fault is a fault injected in to the responses of the node.
+type Fault struct {
+	// Latency is the delay before the node responds.
+	Latency time.Duration
+	// StatusCode is the status code of the response; if set, the node responds with a standard error.
+	StatusCode int
+	// Message is the message in the standard error.
+	Message string
+	// Body replaces the body of the response, for example to send a malformed body.
+	Body []byte
+}
+
+// Latency is a fault that delays responses by the given duration.
+func Latency(latency time.Duration) *Fault {
+	return &Fault{
+		Latency: latency,
+	}
+}
+
+// Error is a fault that responds with the given status code and message.
+func Error(statusCode int, message string) *Fault {
+	return &Fault{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+}
+
+// NotFound is a fault that responds as if the requested item does not exist.
+func NotFound() *Fault {
+	return Error(http.StatusNotFound, "not found")
+}
+
+// Syncing is a fault that responds as if the node is syncing.
+func Syncing() *Fault {
+	return Error(http.StatusServiceUnavailable, "beacon node is currently syncing and not serving requests")
+}
+
+// Malformed is a fault that responds with a body that is not valid JSON.
+func Malformed() *Fault {
+	return &Fault{
+		Body: []byte(`{"data":`),
+	}
+}
+
+// faultEntry is a fault for requests with paths that start with a prefix.
+type faultEntry struct {
+	prefix string
+	fault  *Fault
+}
@@ -0,0 +1,113 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/fakenode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		prefix   string
+		fault    *fakenode.Fault
+		sentinel error
+		err      string
+	}{
+		{
+			name:     "NotFound",
+			prefix:   "/eth/v1/beacon/headers/",
+			fault:    fakenode.NotFound(),
+			sentinel: client.ErrNotFound,
+		},
+		{
+			name:     "Syncing",
+			prefix:   "/eth/v1/beacon/",
+			fault:    fakenode.Syncing(),
+			sentinel: client.ErrSyncing,
+		},
+		{
+			name:     "BadRequest",
+			prefix:   "/eth/v1/beacon/headers/",
+			fault:    fakenode.Error(http.StatusBadRequest, "invalid block ID"),
+			sentinel: client.ErrBadRequest,
+		},
+		{
+			name:   "Malformed",
+			prefix: "/eth/v1/beacon/headers/",
+			fault:  fakenode.Malformed(),
+			err:    "failed to parse beacon block header: unexpected EOF",
+		},
+		{
+			name:     "Latency",
+			prefix:   "",
+			fault:    fakenode.Latency(time.Second),
+			sentinel: client.ErrTimeout,
+		},
+		{
+			name:   "OtherPrefix",
+			prefix: "/eth/v1/validator/",
+			fault:  fakenode.NotFound(),
+		},
+	}
+
+	server, _, service := newClient(ctx, t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server.InjectFault(test.prefix, test.fault)
+			defer server.ClearFaults()
+
+			opCtx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+			defer cancel()
+			_, err := service.BeaconBlockHeader(opCtx, "head")
+			switch {
+			case test.sentinel != nil:
+				require.True(t, errors.Is(err, test.sentinel), err)
+			case test.err != "":
+				require.EqualError(t, err, test.err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSyncing(t *testing.T) {
+	ctx := context.Background()
+	server, _, service := newClient(ctx, t)
+
+	server.SetSyncing(true)
+	syncState, err := service.NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.True(t, syncState.IsSyncing)
+	_, err = service.BeaconBlockHeader(ctx, "head")
+	require.True(t, errors.Is(err, client.ErrSyncing))
+
+	server.SetSyncing(false)
+	syncState, err = service.NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.False(t, syncState.IsSyncing)
+	_, err = service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode
+
+import (
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel zerolog.Level
+	logger   zerolog.Logger
+	service  client.Service
+	fixtures map[string][]byte
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithService sets the service that provides the data for the node.
+// If not supplied, a mock service is used.
+func WithService(service client.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithFixture sets a fixed response body for a request.
+// The request is either a path, for example "/eth/v1/node/version", or a path and query,
+// for example "/eth/v1/beacon/pool/attestations?slot=1".
+func WithFixture(request string, body []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.fixtures[request] = body
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
+		fixtures: make(map[string][]byte),
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	return &parameters, nil
+}
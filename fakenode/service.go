@@ -0,0 +1,242 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/interceptor"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/server"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Server is a fake beacon node, serving the standard API over HTTP from a service.
+type Server struct {
+	log zerolog.Logger
+
+	handler  http.Handler
+	server   *httptest.Server
+	fixtures map[string][]byte
+
+	faultsMu sync.RWMutex
+	faults   []*faultEntry
+	syncing  bool
+}
+
+// New creates a new fake beacon node, listening on a local address.
+// The node is closed when the context is done.
+func New(ctx context.Context, params ...Parameter) (*Server, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "fakenode").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	service := parameters.service
+	if service == nil {
+		service, err = mock.New(ctx, mock.WithLogger(parameters.logger), mock.WithLogLevel(parameters.logLevel))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create mock service")
+		}
+	}
+
+	s := &Server{
+		log:      log,
+		fixtures: parameters.fixtures,
+	}
+
+	// Pass the service through an interceptor, so that the node can report that it is syncing.
+	// Any service is accepted, with the server answering calls that it does not support with 501.
+	service, err = interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithInterceptors(s.interceptSyncing),
+		interceptor.WithAllProviders(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create interceptor")
+	}
+	s.handler, err = server.New(ctx,
+		server.WithLogger(parameters.logger),
+		server.WithLogLevel(parameters.logLevel),
+		server.WithService(service),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create server")
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	log.Trace().Str("address", s.server.URL).Msg("Started")
+
+	// Close the server on context done.
+	go func(s *Server) {
+		<-ctx.Done()
+		log.Trace().Msg("Context done; closing server")
+		s.Close()
+	}(s)
+
+	return s, nil
+}
+
+// Address provides the address of the node, for example "http://127.0.0.1:33333".
+func (s *Server) Address() string {
+	return s.server.URL
+}
+
+// Close closes the node.
+func (s *Server) Close() {
+	s.server.CloseClientConnections()
+	s.server.Close()
+}
+
+// InjectFault injects a fault in to the responses for requests with paths that start with the given prefix.
+// An empty prefix injects the fault in to all responses.
+// If more than one fault matches a request, the most recently injected is used.
+func (s *Server) InjectFault(prefix string, fault *Fault) {
+	s.faultsMu.Lock()
+	s.faults = append(s.faults, &faultEntry{
+		prefix: prefix,
+		fault:  fault,
+	})
+	s.faultsMu.Unlock()
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.faultsMu.Lock()
+	s.faults = nil
+	s.faultsMu.Unlock()
+}
+
+// SetSyncing sets if the node is syncing.
+// A syncing node reports that it is syncing, and responds to requests outside of
+// /eth/v1/node with a 503 error.
+func (s *Server) SetSyncing(syncing bool) {
+	s.faultsMu.Lock()
+	s.syncing = syncing
+	s.faultsMu.Unlock()
+}
+
+// interceptSyncing alters the sync state reported by the service if the node is syncing.
+func (s *Server) interceptSyncing(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+	next(ctx, call)
+	if call.Method != "NodeSyncing" || call.Err != nil {
+		return
+	}
+	syncState, isSyncState := call.Results[0].(*api.SyncState)
+	if !isSyncState || syncState == nil {
+		return
+	}
+
+	s.faultsMu.RLock()
+	syncing := s.syncing
+	s.faultsMu.RUnlock()
+	if syncing {
+		// Copy the state rather than alter that returned by the service.
+		res := &api.SyncState{
+			HeadSlot:     syncState.HeadSlot,
+			SyncDistance: syncState.SyncDistance,
+			IsSyncing:    true,
+		}
+		if res.SyncDistance == 0 {
+			res.SyncDistance = 1
+		}
+		call.Results[0] = res
+	}
+}
+
+// fault provides the fault for a request, if any.
+func (s *Server) fault(path string) *Fault {
+	s.faultsMu.RLock()
+	defer s.faultsMu.RUnlock()
+
+	for i := len(s.faults) - 1; i >= 0; i-- {
+		if strings.HasPrefix(path, s.faults[i].prefix) {
+			return s.faults[i].fault
+		}
+	}
+	if s.syncing && !strings.HasPrefix(path, "/eth/v1/node/") {
+		return Syncing()
+	}
+	return nil
+}
+
+// serveHTTP serves a request.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.log.Trace().Str("method", r.Method).Str("url", r.URL.String()).Msg("Request")
+
+	if fault := s.fault(r.URL.Path); fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.StatusCode != 0 {
+			writeError(w, fault.StatusCode, fault.Message)
+			return
+		}
+		if fault.Body != nil {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(fault.Body)
+			return
+		}
+	}
+
+	if body, exists := s.fixtures[r.URL.RequestURI()]; exists {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+		return
+	}
+	if body, exists := s.fixtures[r.URL.Path]; exists {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+		return
+	}
+
+	s.handler.ServeHTTP(w, r)
+}
+
+// errorJSON is the standard API representation of an error.
+type errorJSON struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	body, err := json.Marshal(&errorJSON{
+		Code:    statusCode,
+		Message: message,
+	})
+	if err != nil {
+		body = []byte(`{}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,206 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fakenode_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/fakenode"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// newClient creates a fake node backed by a manually-advanced mock, and a standard HTTP client for it.
+func newClient(ctx context.Context, t *testing.T, params ...fakenode.Parameter) (*fakenode.Server, *mock.Service, *standardhttp.Service) {
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, mockService.Advance(ctx))
+	}
+	server, err := fakenode.New(ctx, append([]fakenode.Parameter{fakenode.WithService(mockService)}, params...)...)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithAddress(server.Address()),
+		standardhttp.WithTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	return server, mockService, service
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := fakenode.New(ctx)
+	require.NoError(t, err)
+	defer server.Close()
+	require.Contains(t, server.Address(), "http://127.0.0.1:")
+
+	service, err := standardhttp.New(ctx, standardhttp.WithAddress(server.Address()))
+	require.NoError(t, err)
+	version, err := service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, version)
+}
+
+func TestFixture(t *testing.T) {
+	ctx := context.Background()
+
+	_, _, service := newClient(ctx, t,
+		fakenode.WithFixture("/eth/v1/node/version", []byte(`{"data":{"version":"Fixture/v1.0.0"}}`)),
+	)
+	version, err := service.NodeVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "Fixture/v1.0.0", version)
+}
+
+func TestEndpoints(t *testing.T) {
+	ctx := context.Background()
+	_, mockService, service := newClient(ctx, t)
+
+	syncState, err := service.NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(20), syncState.HeadSlot)
+	require.False(t, syncState.IsSyncing)
+
+	genesis, err := service.Genesis(ctx)
+	require.NoError(t, err)
+	mockGenesis, err := mockService.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mockGenesis.GenesisValidatorsRoot, genesis.GenesisValidatorsRoot)
+	require.Equal(t, mockGenesis.GenesisTime.Unix(), genesis.GenesisTime.Unix())
+
+	slotsPerEpoch, err := service.SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	mockSlotsPerEpoch, err := mockService.SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mockSlotsPerEpoch, slotsPerEpoch)
+
+	slotDuration, err := service.SlotDuration(ctx)
+	require.NoError(t, err)
+	mockSlotDuration, err := mockService.SlotDuration(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mockSlotDuration, slotDuration)
+
+	header, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	mockHeader, err := mockService.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, mockHeader.Root, header.Root)
+
+	block, err := service.SignedBeaconBlock(ctx, "10")
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(10), block.Message.Slot)
+
+	stateRoot, err := service.StateRoot(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, mockHeader.Header.Message.StateRoot[:], stateRoot)
+
+	fork, err := service.Fork(ctx, "head")
+	require.NoError(t, err)
+	require.NotNil(t, fork)
+
+	finality, err := service.Finality(ctx, "head")
+	require.NoError(t, err)
+	mockFinality, err := mockService.Finality(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, mockFinality.Finalized.Epoch, finality.Finalized.Epoch)
+
+	validators, err := service.Validators(ctx, "head", []spec.ValidatorIndex{1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, validators, 3)
+	byPubKey, err := service.ValidatorsByPubKey(ctx, "head", []spec.BLSPubKey{validators[2].Validator.PublicKey})
+	require.NoError(t, err)
+	require.Len(t, byPubKey, 1)
+	require.NotNil(t, byPubKey[2])
+
+	balances, err := service.ValidatorBalances(ctx, "head", []spec.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	mockBalances, err := mockService.ValidatorBalances(ctx, "head", []spec.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, mockBalances, balances)
+
+	committees, err := service.BeaconCommittees(ctx, "head")
+	require.NoError(t, err)
+	require.NotEmpty(t, committees)
+
+	attesterDuties, err := service.AttesterDuties(ctx, 2, []spec.ValidatorIndex{0, 1, 2, 3})
+	require.NoError(t, err)
+	require.Len(t, attesterDuties, 4)
+
+	proposerDuties, err := service.ProposerDuties(ctx, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, proposerDuties, int(slotsPerEpoch))
+
+	attestationData, err := service.AttestationData(ctx, 20, 0)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(20), attestationData.Slot)
+
+	attestations, err := service.AttestationPool(ctx, 19)
+	require.NoError(t, err)
+	require.NotNil(t, attestations)
+
+	proposal, err := service.BeaconBlockProposal(ctx, 21, spec.BLSSignature{}, []byte("graffiti"))
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(21), proposal.Slot)
+}
+
+func TestSubmissions(t *testing.T) {
+	ctx := context.Background()
+	_, mockService, service := newClient(ctx, t)
+
+	attestationData, err := service.AttestationData(ctx, 20, 0)
+	require.NoError(t, err)
+	attestation := &spec.Attestation{
+		AggregationBits: []byte{0x01, 0x01},
+		Data:            attestationData,
+	}
+	require.NoError(t, service.SubmitAttestations(ctx, []*spec.Attestation{attestation}))
+	require.Len(t, mockService.SubmittedAttestations(), 1)
+
+	block, err := service.SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	require.NoError(t, service.SubmitBeaconBlock(ctx, block))
+	require.Len(t, mockService.SubmittedBeaconBlocks(), 1)
+}
+
+func TestNotSupported(t *testing.T) {
+	ctx := context.Background()
+
+	// A service that provides nothing.
+	server, err := fakenode.New(ctx, fakenode.WithService(&emptyService{}))
+	require.NoError(t, err)
+	defer server.Close()
+
+	_, err = standardhttp.New(ctx, standardhttp.WithAddress(server.Address()))
+	require.Error(t, err)
+	require.ErrorIs(t, err, client.ErrNotSupported)
+}
+
+// emptyService is a service that provides no data.
+type emptyService struct{}
+
+func (s *emptyService) Name() string {
+	return "empty"
+}
+
+func (s *emptyService) Address() string {
+	return "empty"
+}
@@ -7,18 +7,24 @@ require (
 	github.com/ferranbt/fastssz v0.0.0-20210316165225-412ceaa5950e
 	github.com/goccy/go-yaml v1.8.9
 	github.com/gogo/protobuf v1.3.2
+	github.com/golang/snappy v0.0.4
+	github.com/kilic/bls12-381 v0.1.0
 	github.com/klauspost/cpuid/v2 v2.0.6 // indirect
 	github.com/kr/pretty v0.2.0 // indirect
 	github.com/minio/sha256-simd v1.0.0 // indirect
 	github.com/mitchellh/mapstructure v1.4.1 // indirect
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.10.0
 	github.com/prysmaticlabs/ethereumapis v0.0.0-20200812153649-a842fc47c2c3
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20210202205921-7fcea7c45dc8
 	github.com/r3labs/sse/v2 v2.3.0
 	github.com/rs/zerolog v1.21.0
 	github.com/stretchr/testify v1.7.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
 	golang.org/x/net v0.0.0-20210410081132-afb366fc7cd1 // indirect
-	golang.org/x/sys v0.0.0-20210403161142-5e06dd20ab57 // indirect
+	golang.org/x/sys v0.0.0-20210423185535-09eb48e85fd7 // indirect
 	google.golang.org/genproto v0.0.0-20210406143921-e86de6bf7a46 // indirect
 	google.golang.org/grpc v1.37.0
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
@@ -0,0 +1,403 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// gen generates the wrapper for the interceptor package from the provider interfaces in service.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// implementations are the packages containing services whose sets of provider interfaces are wrapped exactly.
+var implementations = []string{
+	"mock",
+	"prysmgrpc",
+	"standardhttp/v1",
+	"static",
+}
+
+// param is a parameter or result of a method.
+type param struct {
+	name string
+	typ  string
+}
+
+// method is a method of a provider interface.
+type method struct {
+	iface   string
+	name    string
+	params  []*param
+	results []*param
+	err     bool
+}
+
+// iface is a provider interface.
+type iface struct {
+	name    string
+	methods []*method
+}
+
+func main() {
+	root := flag.String("root", "..", "root directory of the module")
+	output := flag.String("output", "wrapper_generated.go", "output file")
+	flag.Parse()
+
+	if err := run(*root, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(root string, output string) error {
+	ifaces, err := parseInterfaces(filepath.Join(root, "service.go"))
+	if err != nil {
+		return err
+	}
+
+	// Obtain the set of interfaces implemented by each implementation.
+	sets := make(map[string][]string)
+	for _, implementation := range implementations {
+		methods, err := parseMethods(filepath.Join(root, implementation))
+		if err != nil {
+			return err
+		}
+		key := make([]byte, len(ifaces))
+		for i, iface := range ifaces {
+			key[i] = '1'
+			for _, method := range iface.methods {
+				if !methods[method.name] {
+					key[i] = '0'
+					break
+				}
+			}
+		}
+		sets[string(key)] = append(sets[string(key)], implementation)
+	}
+
+	src, err := generate(ifaces, sets)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(output, src, 0600)
+}
+
+// parseInterfaces parses the provider interfaces from a file.
+// Provider interfaces are those with methods that take a context as their first parameter.
+func parseInterfaces(path string) ([]*iface, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ifaces := make([]*iface, 0)
+	for _, decl := range file.Decls {
+		genDecl, isGenDecl := decl.(*ast.GenDecl)
+		if !isGenDecl || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			interfaceType, isInterface := typeSpec.Type.(*ast.InterfaceType)
+			if !isInterface || strings.HasSuffix(typeSpec.Name.Name, "Handler") {
+				continue
+			}
+			iface := &iface{name: typeSpec.Name.Name}
+			for _, field := range interfaceType.Methods.List {
+				funcType, isFunc := field.Type.(*ast.FuncType)
+				if !isFunc {
+					// Embedded interface.
+					continue
+				}
+				method, err := parseMethod(fset, iface.name, field.Names[0].Name, funcType)
+				if err != nil {
+					return nil, err
+				}
+				if method != nil {
+					iface.methods = append(iface.methods, method)
+				}
+			}
+			if len(iface.methods) > 0 {
+				ifaces = append(ifaces, iface)
+			}
+		}
+	}
+
+	sort.Slice(ifaces, func(i, j int) bool {
+		return ifaces[i].name < ifaces[j].name
+	})
+	return ifaces, nil
+}
+
+// parseMethod parses a method of an interface, returning nil if it does not take a context.
+func parseMethod(fset *token.FileSet, ifaceName string, name string, funcType *ast.FuncType) (*method, error) {
+	params := expand(fset, funcType.Params, "arg")
+	if len(params) == 0 || params[0].typ != "context.Context" {
+		return nil, nil
+	}
+	results := expand(fset, funcType.Results, "res")
+	method := &method{
+		iface:   ifaceName,
+		name:    name,
+		params:  params[1:],
+		results: results,
+	}
+	if len(results) > 0 && results[len(results)-1].typ == "error" {
+		method.err = true
+		method.results = results[:len(results)-1]
+	}
+	for i := range method.results {
+		method.results[i].name = fmt.Sprintf("res%d", i)
+	}
+
+	return method, nil
+}
+
+// expand expands a field list in to individual parameters.
+func expand(fset *token.FileSet, fields *ast.FieldList, prefix string) []*param {
+	res := make([]*param, 0)
+	if fields == nil {
+		return res
+	}
+	for _, field := range fields.List {
+		typ := typeString(fset, field.Type)
+		if len(field.Names) == 0 {
+			res = append(res, &param{name: fmt.Sprintf("%s%d", prefix, len(res)), typ: typ})
+			continue
+		}
+		for _, name := range field.Names {
+			res = append(res, &param{name: name.Name, typ: typ})
+		}
+	}
+	return res
+}
+
+// typeString provides the source of a type, qualifying types from the client package.
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	expr = qualify(expr)
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+// qualify qualifies exported identifiers in a type with the client package.
+func qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(e.Name[0])) {
+			return &ast.SelectorExpr{X: ast.NewIdent("client"), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt)}
+	default:
+		return expr
+	}
+}
+
+// parseMethods parses the names of the methods of the Service type in a package.
+func parseMethods(dir string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				funcDecl, isFunc := decl.(*ast.FuncDecl)
+				if !isFunc || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+					continue
+				}
+				recv := funcDecl.Recv.List[0].Type
+				if star, isStar := recv.(*ast.StarExpr); isStar {
+					recv = star.X
+				}
+				if ident, isIdent := recv.(*ast.Ident); isIdent && ident.Name == "Service" {
+					methods[funcDecl.Name.Name] = true
+				}
+			}
+		}
+	}
+	return methods, nil
+}
+
+// generate generates the source of the wrapper.
+func generate(ifaces []*iface, sets map[string][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by interceptor/gen. DO NOT EDIT.\n\n")
+	buf.WriteString("package interceptor\n\n")
+
+	// Imports.
+	var body bytes.Buffer
+	generateProviders(&body, ifaces)
+	generateSets(&body, ifaces, sets)
+	for _, iface := range ifaces {
+		for _, method := range iface.methods {
+			generateMethod(&body, method)
+		}
+	}
+	buf.WriteString("import (\n\t\"context\"\n")
+	if bytes.Contains(body.Bytes(), []byte("time.")) {
+		buf.WriteString("\t\"time\"\n")
+	}
+	buf.WriteString("\n\tclient \"github.com/attestantio/go-eth2-client\"\n")
+	if bytes.Contains(body.Bytes(), []byte("api.")) {
+		buf.WriteString("\tapi \"github.com/attestantio/go-eth2-client/api/v1\"\n")
+	}
+	if bytes.Contains(body.Bytes(), []byte("spec.")) {
+		buf.WriteString("\tspec \"github.com/attestantio/go-eth2-client/spec/phase0\"\n")
+	}
+	buf.WriteString(")\n\n")
+	buf.Write(body.Bytes())
+
+	return format.Source(buf.Bytes())
+}
+
+// generateProviders generates the list of provider interfaces.
+func generateProviders(buf *bytes.Buffer, ifaces []*iface) {
+	buf.WriteString("// providers are the provider interfaces, in the order used for the keys of wrapperSets.\n")
+	buf.WriteString("var providers = []func(client.Service) bool{\n")
+	for _, iface := range ifaces {
+		fmt.Fprintf(buf, "\tfunc(s client.Service) bool {\n\t\t_, isProvider := s.(client.%s)\n\t\treturn isProvider\n\t},\n", iface.name)
+	}
+	buf.WriteString("}\n\n")
+}
+
+// generateSets generates the wrappers for the sets of interfaces implemented by the services in this module.
+func generateSets(buf *bytes.Buffer, ifaces []*iface, sets map[string][]string) {
+	keys := make([]string, 0, len(sets))
+	for key := range sets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf.WriteString("// wrapperSets expose exactly the provider interfaces of the services in this module, keyed by the\n")
+	buf.WriteString("// providers that they implement.\n")
+	buf.WriteString("var wrapperSets = map[string]func(w *wrapper) client.Service{\n")
+	for _, key := range keys {
+		fmt.Fprintf(buf, "\t// %s.\n", strings.Join(sets[key], ", "))
+		fmt.Fprintf(buf, "\t%q: func(w *wrapper) client.Service {\n", key)
+		buf.WriteString("\t\treturn &struct {\n\t\t\tclient.Service\n")
+		count := 1
+		for i, iface := range ifaces {
+			if key[i] == '1' {
+				fmt.Fprintf(buf, "\t\t\tclient.%s\n", iface.name)
+				count++
+			}
+		}
+		buf.WriteString("\t\t}{")
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("w")
+		}
+		buf.WriteString("}\n\t},\n")
+	}
+	buf.WriteString("}\n\n")
+}
+
+// generateMethod generates a method of the wrapper.
+func generateMethod(buf *bytes.Buffer, method *method) {
+	params := make([]string, 0, len(method.params)+1)
+	params = append(params, "ctx context.Context")
+	args := make([]string, 0, len(method.params))
+	callArgs := make([]string, 0, len(method.params)+1)
+	callArgs = append(callArgs, "ctx")
+	for i, param := range method.params {
+		params = append(params, fmt.Sprintf("%s %s", param.name, param.typ))
+		args = append(args, param.name)
+		callArgs = append(callArgs, fmt.Sprintf("arg%d", i))
+	}
+	resultTypes := make([]string, 0, len(method.results)+1)
+	resultNames := make([]string, 0, len(method.results)+1)
+	for _, result := range method.results {
+		resultTypes = append(resultTypes, result.typ)
+		resultNames = append(resultNames, result.name)
+	}
+	if method.err {
+		resultTypes = append(resultTypes, "error")
+	}
+
+	fmt.Fprintf(buf, "// %s implements client.%s.\n", method.name, method.iface)
+	fmt.Fprintf(buf, "func (w *wrapper) %s(%s)", method.name, strings.Join(params, ", "))
+	switch len(resultTypes) {
+	case 0:
+		buf.WriteString(" {\n")
+	case 1:
+		fmt.Fprintf(buf, " %s {\n", resultTypes[0])
+	default:
+		fmt.Fprintf(buf, " (%s) {\n", strings.Join(resultTypes, ", "))
+	}
+
+	fmt.Fprintf(buf, "\tcall := &Call{\n\t\tMethod: %q,\n\t\tArgs: []interface{}{%s},\n\t}\n", method.name, strings.Join(args, ", "))
+	buf.WriteString("\tw.invoke(ctx, call, func(ctx context.Context, call *Call) {\n")
+	fmt.Fprintf(buf, "\t\tservice, isService := w.service.(client.%s)\n", method.iface)
+	fmt.Fprintf(buf, "\t\tif !isService {\n\t\t\tcall.Err = notSupported(%q)\n\t\t\treturn\n\t\t}\n", method.name)
+	for i, param := range method.params {
+		fmt.Fprintf(buf, "\t\targ%d, _ := call.Args[%d].(%s)\n", i, i, param.typ)
+	}
+	invocation := fmt.Sprintf("service.%s(%s)", method.name, strings.Join(callArgs, ", "))
+	returned := append([]string{}, resultNames...)
+	if method.err {
+		returned = append(returned, "err")
+	}
+	if len(returned) == 0 {
+		fmt.Fprintf(buf, "\t\t%s\n", invocation)
+	} else {
+		fmt.Fprintf(buf, "\t\t%s := %s\n", strings.Join(returned, ", "), invocation)
+	}
+	fmt.Fprintf(buf, "\t\tcall.Results = []interface{}{%s}\n", strings.Join(resultNames, ", "))
+	if method.err {
+		buf.WriteString("\t\tcall.Err = err\n")
+	}
+	buf.WriteString("\t})\n")
+
+	for i, result := range method.results {
+		fmt.Fprintf(buf, "\t%s, _ := call.result(%d).(%s)\n", result.name, i, result.typ)
+	}
+	if method.err {
+		resultNames = append(resultNames, "call.Err")
+	}
+	if len(resultNames) > 0 {
+		fmt.Fprintf(buf, "\treturn %s\n", strings.Join(resultNames, ", "))
+	}
+	buf.WriteString("}\n\n")
+}
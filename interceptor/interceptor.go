@@ -0,0 +1,143 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+// Call is a single call to a provider method passing through the interceptors.
+type Call struct {
+	// Method is the name of the provider method, for example "AttesterDuties".
+	Method string
+	// Args are the arguments to the method, excluding the context.
+	// Interceptors may replace arguments before passing the call on, but must keep their types.
+	Args []interface{}
+	// Results are the results of the method, excluding the error.
+	// They are populated when the call returns, and may be replaced by interceptors but must keep their types.
+	Results []interface{}
+	// Err is the error returned by the method.
+	Err error
+	// Duration is the time taken by the wrapped service to handle the call.
+	Duration time.Duration
+}
+
+// result returns the indexed result of the call, or nil if it is not present.
+func (c *Call) result(index int) interface{} {
+	if index >= len(c.Results) {
+		return nil
+	}
+	return c.Results[index]
+}
+
+// Invoker passes a call on towards the wrapped service.
+type Invoker func(ctx context.Context, call *Call)
+
+// Interceptor intercepts calls to provider methods.
+// An interceptor can inspect or alter the call before and after calling next, or short-circuit the
+// call by setting its results or error and returning without calling next.
+type Interceptor func(ctx context.Context, call *Call, next Invoker)
+
+// wrapper wraps a service, passing calls through a chain of interceptors.
+type wrapper struct {
+	service      eth2client.Service
+	interceptors []Interceptor
+	name         string
+	address      string
+}
+
+// New wraps a service with a chain of interceptors.
+//
+// The returned service implements exactly the provider interfaces of the wrapped service when the
+// wrapped service is one of those in this module (or a wrapper of one).  Other services are
+// rejected, as the wrapper could not reproduce their provider interfaces, unless WithAllProviders
+// is supplied.
+func New(ctx context.Context, params ...Parameter) (eth2client.Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &wrapper{
+		service:      parameters.service,
+		interceptors: parameters.interceptors,
+		name:         parameters.name,
+		address:      parameters.address,
+	}
+
+	if wrapperSet, exists := wrapperSets[providersKey(parameters.service)]; exists {
+		return wrapperSet(w), nil
+	}
+	if !parameters.allProviders {
+		return nil, errors.New("service provides an unrecognised set of interfaces")
+	}
+	return w, nil
+}
+
+// providersKey returns the key for wrapperSets of the providers implemented by a service.
+func providersKey(service eth2client.Service) string {
+	var key strings.Builder
+	for _, provider := range providers {
+		if provider(service) {
+			key.WriteByte('1')
+		} else {
+			key.WriteByte('0')
+		}
+	}
+	return key.String()
+}
+
+// Name returns the name of the client implementation.
+func (w *wrapper) Name() string {
+	if w.name != "" {
+		return w.name
+	}
+	return w.service.Name()
+}
+
+// Address returns the address of the client.
+func (w *wrapper) Address() string {
+	if w.address != "" {
+		return w.address
+	}
+	return w.service.Address()
+}
+
+// invoke passes a call through the interceptors to the handler.
+func (w *wrapper) invoke(ctx context.Context, call *Call, handler Invoker) {
+	next := func(ctx context.Context, call *Call) {
+		started := time.Now()
+		handler(ctx, call)
+		call.Duration = time.Since(started)
+	}
+	for i := len(w.interceptors) - 1; i >= 0; i-- {
+		interceptor := w.interceptors[i]
+		inner := next
+		next = func(ctx context.Context, call *Call) {
+			interceptor(ctx, call, inner)
+		}
+	}
+	next(ctx, call)
+}
+
+// notSupported returns the error for a method not supported by the wrapped service.
+func notSupported(method string) error {
+	return fmt.Errorf("wrapped service does not support %s: %w", method, eth2client.ErrNotSupported)
+}
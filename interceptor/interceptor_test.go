@@ -0,0 +1,286 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/interceptor"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyService struct{}
+
+func (s *emptyService) Name() string {
+	return "empty"
+}
+
+func (s *emptyService) Address() string {
+	return "empty"
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []interceptor.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []interceptor.Parameter{
+				interceptor.WithInterceptors(func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {}),
+			},
+			err: "no service specified",
+		},
+		{
+			name: "InterceptorMissing",
+			params: []interceptor.Parameter{
+				interceptor.WithService(service),
+				interceptor.WithInterceptors(nil),
+			},
+			err: "interceptor 0 missing",
+		},
+		{
+			name: "NoInterceptors",
+			params: []interceptor.Parameter{
+				interceptor.WithService(service),
+			},
+		},
+		{
+			name: "Good",
+			params: []interceptor.Parameter{
+				interceptor.WithService(service),
+				interceptor.WithInterceptors(func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := interceptor.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNameAddress(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	wrapped, err := interceptor.New(ctx, interceptor.WithService(service))
+	require.NoError(t, err)
+	require.Equal(t, service.Name(), wrapped.Name())
+	require.Equal(t, service.Address(), wrapped.Address())
+
+	wrapped, err = interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithName("wrapped"),
+		interceptor.WithAddress("wrapped:1"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "wrapped", wrapped.Name())
+	require.Equal(t, "wrapped:1", wrapped.Address())
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	order := make([]string, 0)
+	tracer := func(name string) interceptor.Interceptor {
+		return func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+			order = append(order, name+" before")
+			next(ctx, call)
+			order = append(order, name+" after")
+		}
+	}
+	var seen *interceptor.Call
+	observer := func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+		next(ctx, call)
+		seen = call
+	}
+
+	wrapped, err := interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithInterceptors(tracer("first"), tracer("second"), observer),
+	)
+	require.NoError(t, err)
+
+	duties, err := wrapped.(eth2client.ProposerDutiesProvider).ProposerDuties(ctx, 1, []spec.ValidatorIndex{2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"first before", "second before", "second after", "first after"}, order)
+	require.Equal(t, "ProposerDuties", seen.Method)
+	require.Equal(t, []interface{}{spec.Epoch(1), []spec.ValidatorIndex{2}}, seen.Args)
+	require.Len(t, seen.Results, 1)
+	require.Equal(t, duties, seen.Results[0])
+	require.Nil(t, seen.Err)
+	require.NotZero(t, seen.Duration)
+}
+
+func TestShortCircuit(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	errShortCircuit := errors.New("short circuit")
+	called := false
+	wrapped, err := interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithInterceptors(
+			func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+				call.Err = errShortCircuit
+			},
+			func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+				called = true
+				next(ctx, call)
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	_, err = wrapped.(eth2client.GenesisProvider).Genesis(ctx)
+	require.Equal(t, errShortCircuit, err)
+	require.False(t, called)
+}
+
+func TestAlterArgsAndResults(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+		mock.WithGenesisTime(time.Now().Add(-time.Hour)),
+	)
+	require.NoError(t, err)
+
+	wrapped, err := interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithInterceptors(
+			func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+				call.Args[0] = spec.Slot(5)
+				next(ctx, call)
+			},
+			func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+				next(ctx, call)
+				call.Results[0] = &spec.AttestationData{Slot: call.Args[0].(spec.Slot) + 1}
+			},
+		),
+	)
+	require.NoError(t, err)
+
+	attestationData, err := wrapped.(eth2client.AttestationDataProvider).AttestationData(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(6), attestationData.Slot)
+}
+
+func TestInterfaces(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	wrapped, err := interceptor.New(ctx, interceptor.WithService(service))
+	require.NoError(t, err)
+
+	// Mock does not provide prysm-specific calls.
+	_, isProvider := wrapped.(eth2client.GenesisProvider)
+	require.True(t, isProvider)
+	_, isProvider = wrapped.(eth2client.PrysmAttesterDutiesProvider)
+	require.False(t, isProvider)
+
+	// Wrapping a wrapper exposes the same interfaces.
+	rewrapped, err := interceptor.New(ctx, interceptor.WithService(wrapped))
+	require.NoError(t, err)
+	_, isProvider = rewrapped.(eth2client.GenesisProvider)
+	require.True(t, isProvider)
+	_, isProvider = rewrapped.(eth2client.PrysmAttesterDutiesProvider)
+	require.False(t, isProvider)
+}
+
+func TestNotSupported(t *testing.T) {
+	ctx := context.Background()
+
+	wrapped, err := interceptor.New(ctx, interceptor.WithService(&emptyService{}), interceptor.WithAllProviders())
+	require.NoError(t, err)
+
+	provider, isProvider := wrapped.(eth2client.GenesisTimeProvider)
+	require.True(t, isProvider)
+	_, err = provider.GenesisTime(ctx)
+	require.True(t, errors.Is(err, eth2client.ErrNotSupported))
+}
+
+// genesisTimeService is a service that only provides the genesis time.
+type genesisTimeService struct {
+	emptyService
+}
+
+func (s *genesisTimeService) GenesisTime(ctx context.Context) (time.Time, error) {
+	return time.Unix(1606824023, 0), nil
+}
+
+func TestUnrecognisedProviders(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := interceptor.New(ctx, interceptor.WithService(&genesisTimeService{}))
+	require.EqualError(t, err, "service provides an unrecognised set of interfaces")
+
+	wrapped, err := interceptor.New(ctx, interceptor.WithService(&genesisTimeService{}), interceptor.WithAllProviders())
+	require.NoError(t, err)
+	genesisTime, err := wrapped.(eth2client.GenesisTimeProvider).GenesisTime(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1606824023, 0), genesisTime)
+	_, err = wrapped.(eth2client.GenesisProvider).Genesis(ctx)
+	require.True(t, errors.Is(err, eth2client.ErrNotSupported))
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := mock.New(ctx, mock.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	wrapped, err := interceptor.New(ctx,
+		interceptor.WithService(service),
+		interceptor.WithInterceptors(func(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+			opCtx, cancel := context.WithTimeout(ctx, time.Millisecond)
+			defer cancel()
+			<-opCtx.Done()
+			call.Err = opCtx.Err()
+		}),
+	)
+	require.NoError(t, err)
+
+	_, err = wrapped.(eth2client.NodeVersionProvider).NodeVersion(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,101 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interceptor
+
+import (
+	"fmt"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+)
+
+type parameters struct {
+	service      eth2client.Service
+	interceptors []Interceptor
+	name         string
+	address      string
+	allProviders bool
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithService sets the service to wrap.
+func WithService(service eth2client.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.service = service
+	})
+}
+
+// WithInterceptors sets the interceptors through which calls pass.
+// The first interceptor is the outermost, so sees calls first and results last.
+func WithInterceptors(interceptors ...Interceptor) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.interceptors = append(p.interceptors, interceptors...)
+	})
+}
+
+// WithName sets the name returned by the wrapper.
+// If not supplied the name of the wrapped service is returned.
+func WithName(name string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.name = name
+	})
+}
+
+// WithAddress sets the address returned by the wrapper.
+// If not supplied the address of the wrapped service is returned.
+func WithAddress(address string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.address = address
+	})
+}
+
+// WithAllProviders allows wrapping a service whose set of provider interfaces is not recognised.
+// The wrapper implements every provider interface, and returns an error wrapping
+// client.ErrNotSupported from methods that the wrapped service does not provide.
+func WithAllProviders() Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.allProviders = true
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.service == nil {
+		return nil, errors.New("no service specified")
+	}
+	for i := range parameters.interceptors {
+		if parameters.interceptors[i] == nil {
+			return nil, fmt.Errorf("interceptor %d missing", i)
+		}
+	}
+
+	return &parameters, nil
+}
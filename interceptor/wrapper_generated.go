@@ -0,0 +1,1709 @@
+// Code generated by interceptor/gen. DO NOT EDIT.
+
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// providers are the provider interfaces, in the order used for the keys of wrapperSets.
+var providers = []func(client.Service) bool{
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AggregateAndProofDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AggregateAttestationProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AggregateAttestationsSubmitter)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AttestationDataProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AttestationPoolProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AttestationsSubmitter)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.AttesterDutiesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconAttesterDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconBlockHeadersProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconBlockProposalProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconBlockRootProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconBlockSubmitter)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconChainHeadUpdatedSource)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconCommitteeSubscriptionsSubmitter)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconCommitteesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconProposerDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BeaconStateProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.BlockIDResolver)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ChainSpecProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.Closer)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.DepositContractProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.DepositDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.DomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.EpochFromStateIDProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.EventsProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.FarFutureEpochProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.FinalityProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ForkProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ForkScheduleProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.GenesisProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.GenesisTimeProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.GenesisValidatorsRootProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.NodeFamilyProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.NodeSyncingProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.NodeVersionProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ProposerDutiesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.PrysmAggregateAttestationProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.PrysmAttesterDutiesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.PrysmProposerDutiesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.PrysmValidatorBalancesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.RANDAODomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SelectionProofDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SignedBeaconBlockProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SlotDurationProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SlotFromStateIDProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SlotsPerEpochProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SpecProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.StateIDResolver)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.SyncStateProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.TargetAggregatorsPerCommitteeProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ValidatorBalancesProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ValidatorIndexProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ValidatorPubKeyProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ValidatorsProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.ValidatorsWithoutBalanceProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.VoluntaryExitDomainProvider)
+		return isProvider
+	},
+	func(s client.Service) bool {
+		_, isProvider := s.(client.VoluntaryExitSubmitter)
+		return isProvider
+	},
+}
+
+// wrapperSets expose exactly the provider interfaces of the services in this module, keyed by the
+// providers that they implement.
+var wrapperSets = map[string]func(w *wrapper) client.Service{
+	// static.
+	"100000010000000100101110010011110000000011010110010000010": func(w *wrapper) client.Service {
+		return &struct {
+			client.Service
+			client.AggregateAndProofDomainProvider
+			client.BeaconAttesterDomainProvider
+			client.BeaconProposerDomainProvider
+			client.ChainSpecProvider
+			client.DepositContractProvider
+			client.DepositDomainProvider
+			client.DomainProvider
+			client.FarFutureEpochProvider
+			client.ForkScheduleProvider
+			client.GenesisProvider
+			client.GenesisTimeProvider
+			client.GenesisValidatorsRootProvider
+			client.RANDAODomainProvider
+			client.SelectionProofDomainProvider
+			client.SlotDurationProvider
+			client.SlotsPerEpochProvider
+			client.SpecProvider
+			client.TargetAggregatorsPerCommitteeProvider
+			client.VoluntaryExitDomainProvider
+		}{w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w}
+	},
+	// prysmgrpc.
+	"101101110111110101110111110101111111100111111111010001111": func(w *wrapper) client.Service {
+		return &struct {
+			client.Service
+			client.AggregateAndProofDomainProvider
+			client.AggregateAttestationsSubmitter
+			client.AttestationDataProvider
+			client.AttestationsSubmitter
+			client.AttesterDutiesProvider
+			client.BeaconAttesterDomainProvider
+			client.BeaconBlockProposalProvider
+			client.BeaconBlockRootProvider
+			client.BeaconBlockSubmitter
+			client.BeaconChainHeadUpdatedSource
+			client.BeaconCommitteeSubscriptionsSubmitter
+			client.BeaconProposerDomainProvider
+			client.BlockIDResolver
+			client.ChainSpecProvider
+			client.Closer
+			client.DepositDomainProvider
+			client.DomainProvider
+			client.EpochFromStateIDProvider
+			client.EventsProvider
+			client.FarFutureEpochProvider
+			client.ForkProvider
+			client.GenesisProvider
+			client.GenesisTimeProvider
+			client.GenesisValidatorsRootProvider
+			client.NodeFamilyProvider
+			client.NodeSyncingProvider
+			client.NodeVersionProvider
+			client.ProposerDutiesProvider
+			client.PrysmAggregateAttestationProvider
+			client.PrysmValidatorBalancesProvider
+			client.RANDAODomainProvider
+			client.SelectionProofDomainProvider
+			client.SignedBeaconBlockProvider
+			client.SlotDurationProvider
+			client.SlotFromStateIDProvider
+			client.SlotsPerEpochProvider
+			client.SpecProvider
+			client.StateIDResolver
+			client.TargetAggregatorsPerCommitteeProvider
+			client.ValidatorsProvider
+			client.ValidatorsWithoutBalanceProvider
+			client.VoluntaryExitDomainProvider
+			client.VoluntaryExitSubmitter
+		}{w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w}
+	},
+	// standardhttp/v1.
+	"111111111101011111110111111111101111000011111111011001011": func(w *wrapper) client.Service {
+		return &struct {
+			client.Service
+			client.AggregateAndProofDomainProvider
+			client.AggregateAttestationProvider
+			client.AggregateAttestationsSubmitter
+			client.AttestationDataProvider
+			client.AttestationPoolProvider
+			client.AttestationsSubmitter
+			client.AttesterDutiesProvider
+			client.BeaconAttesterDomainProvider
+			client.BeaconBlockHeadersProvider
+			client.BeaconBlockProposalProvider
+			client.BeaconBlockSubmitter
+			client.BeaconCommitteeSubscriptionsSubmitter
+			client.BeaconCommitteesProvider
+			client.BeaconProposerDomainProvider
+			client.BeaconStateProvider
+			client.BlockIDResolver
+			client.ChainSpecProvider
+			client.Closer
+			client.DepositDomainProvider
+			client.DomainProvider
+			client.EpochFromStateIDProvider
+			client.EventsProvider
+			client.FarFutureEpochProvider
+			client.FinalityProvider
+			client.ForkProvider
+			client.ForkScheduleProvider
+			client.GenesisProvider
+			client.GenesisTimeProvider
+			client.NodeFamilyProvider
+			client.NodeSyncingProvider
+			client.NodeVersionProvider
+			client.ProposerDutiesProvider
+			client.RANDAODomainProvider
+			client.SelectionProofDomainProvider
+			client.SignedBeaconBlockProvider
+			client.SlotDurationProvider
+			client.SlotFromStateIDProvider
+			client.SlotsPerEpochProvider
+			client.SpecProvider
+			client.StateIDResolver
+			client.TargetAggregatorsPerCommitteeProvider
+			client.ValidatorBalancesProvider
+			client.ValidatorsProvider
+			client.VoluntaryExitDomainProvider
+			client.VoluntaryExitSubmitter
+		}{w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w}
+	},
+	// mock.
+	"111111111101011111111111111111110111000011111111011001011": func(w *wrapper) client.Service {
+		return &struct {
+			client.Service
+			client.AggregateAndProofDomainProvider
+			client.AggregateAttestationProvider
+			client.AggregateAttestationsSubmitter
+			client.AttestationDataProvider
+			client.AttestationPoolProvider
+			client.AttestationsSubmitter
+			client.AttesterDutiesProvider
+			client.BeaconAttesterDomainProvider
+			client.BeaconBlockHeadersProvider
+			client.BeaconBlockProposalProvider
+			client.BeaconBlockSubmitter
+			client.BeaconCommitteeSubscriptionsSubmitter
+			client.BeaconCommitteesProvider
+			client.BeaconProposerDomainProvider
+			client.BeaconStateProvider
+			client.BlockIDResolver
+			client.ChainSpecProvider
+			client.Closer
+			client.DepositContractProvider
+			client.DepositDomainProvider
+			client.DomainProvider
+			client.EpochFromStateIDProvider
+			client.EventsProvider
+			client.FarFutureEpochProvider
+			client.FinalityProvider
+			client.ForkProvider
+			client.ForkScheduleProvider
+			client.GenesisProvider
+			client.GenesisTimeProvider
+			client.GenesisValidatorsRootProvider
+			client.NodeSyncingProvider
+			client.NodeVersionProvider
+			client.ProposerDutiesProvider
+			client.RANDAODomainProvider
+			client.SelectionProofDomainProvider
+			client.SignedBeaconBlockProvider
+			client.SlotDurationProvider
+			client.SlotFromStateIDProvider
+			client.SlotsPerEpochProvider
+			client.SpecProvider
+			client.StateIDResolver
+			client.TargetAggregatorsPerCommitteeProvider
+			client.ValidatorBalancesProvider
+			client.ValidatorsProvider
+			client.VoluntaryExitDomainProvider
+			client.VoluntaryExitSubmitter
+		}{w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w, w}
+	},
+}
+
+// AggregateAndProofDomain implements client.AggregateAndProofDomainProvider.
+func (w *wrapper) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "AggregateAndProofDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AggregateAndProofDomainProvider)
+		if !isService {
+			call.Err = notSupported("AggregateAndProofDomain")
+			return
+		}
+		res0, err := service.AggregateAndProofDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// AggregateAttestation implements client.AggregateAttestationProvider.
+func (w *wrapper) AggregateAttestation(ctx context.Context, slot spec.Slot, attestationDataRoot spec.Root) (*spec.Attestation, error) {
+	call := &Call{
+		Method: "AggregateAttestation",
+		Args:   []interface{}{slot, attestationDataRoot},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AggregateAttestationProvider)
+		if !isService {
+			call.Err = notSupported("AggregateAttestation")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Slot)
+		arg1, _ := call.Args[1].(spec.Root)
+		res0, err := service.AggregateAttestation(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.Attestation)
+	return res0, call.Err
+}
+
+// SubmitAggregateAttestations implements client.AggregateAttestationsSubmitter.
+func (w *wrapper) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*spec.SignedAggregateAndProof) error {
+	call := &Call{
+		Method: "SubmitAggregateAttestations",
+		Args:   []interface{}{aggregateAndProofs},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AggregateAttestationsSubmitter)
+		if !isService {
+			call.Err = notSupported("SubmitAggregateAttestations")
+			return
+		}
+		arg0, _ := call.Args[0].([]*spec.SignedAggregateAndProof)
+		err := service.SubmitAggregateAttestations(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// AttestationData implements client.AttestationDataProvider.
+func (w *wrapper) AttestationData(ctx context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error) {
+	call := &Call{
+		Method: "AttestationData",
+		Args:   []interface{}{slot, committeeIndex},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AttestationDataProvider)
+		if !isService {
+			call.Err = notSupported("AttestationData")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Slot)
+		arg1, _ := call.Args[1].(spec.CommitteeIndex)
+		res0, err := service.AttestationData(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.AttestationData)
+	return res0, call.Err
+}
+
+// AttestationPool implements client.AttestationPoolProvider.
+func (w *wrapper) AttestationPool(ctx context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
+	call := &Call{
+		Method: "AttestationPool",
+		Args:   []interface{}{slot},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AttestationPoolProvider)
+		if !isService {
+			call.Err = notSupported("AttestationPool")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Slot)
+		res0, err := service.AttestationPool(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*spec.Attestation)
+	return res0, call.Err
+}
+
+// SubmitAttestations implements client.AttestationsSubmitter.
+func (w *wrapper) SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error {
+	call := &Call{
+		Method: "SubmitAttestations",
+		Args:   []interface{}{attestations},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AttestationsSubmitter)
+		if !isService {
+			call.Err = notSupported("SubmitAttestations")
+			return
+		}
+		arg0, _ := call.Args[0].([]*spec.Attestation)
+		err := service.SubmitAttestations(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// AttesterDuties implements client.AttesterDutiesProvider.
+func (w *wrapper) AttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	call := &Call{
+		Method: "AttesterDuties",
+		Args:   []interface{}{epoch, validatorIndices},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.AttesterDutiesProvider)
+		if !isService {
+			call.Err = notSupported("AttesterDuties")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Epoch)
+		arg1, _ := call.Args[1].([]spec.ValidatorIndex)
+		res0, err := service.AttesterDuties(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*api.AttesterDuty)
+	return res0, call.Err
+}
+
+// BeaconAttesterDomain implements client.BeaconAttesterDomainProvider.
+func (w *wrapper) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "BeaconAttesterDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconAttesterDomainProvider)
+		if !isService {
+			call.Err = notSupported("BeaconAttesterDomain")
+			return
+		}
+		res0, err := service.BeaconAttesterDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// BeaconBlockHeader implements client.BeaconBlockHeadersProvider.
+func (w *wrapper) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
+	call := &Call{
+		Method: "BeaconBlockHeader",
+		Args:   []interface{}{blockID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconBlockHeadersProvider)
+		if !isService {
+			call.Err = notSupported("BeaconBlockHeader")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.BeaconBlockHeader(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.BeaconBlockHeader)
+	return res0, call.Err
+}
+
+// BeaconBlockProposal implements client.BeaconBlockProposalProvider.
+func (w *wrapper) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte) (*spec.BeaconBlock, error) {
+	call := &Call{
+		Method: "BeaconBlockProposal",
+		Args:   []interface{}{slot, randaoReveal, graffiti},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconBlockProposalProvider)
+		if !isService {
+			call.Err = notSupported("BeaconBlockProposal")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Slot)
+		arg1, _ := call.Args[1].(spec.BLSSignature)
+		arg2, _ := call.Args[2].([]byte)
+		res0, err := service.BeaconBlockProposal(ctx, arg0, arg1, arg2)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.BeaconBlock)
+	return res0, call.Err
+}
+
+// BeaconBlockRootBySlot implements client.BeaconBlockRootProvider.
+func (w *wrapper) BeaconBlockRootBySlot(ctx context.Context, slot uint64) ([]byte, error) {
+	call := &Call{
+		Method: "BeaconBlockRootBySlot",
+		Args:   []interface{}{slot},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconBlockRootProvider)
+		if !isService {
+			call.Err = notSupported("BeaconBlockRootBySlot")
+			return
+		}
+		arg0, _ := call.Args[0].(uint64)
+		res0, err := service.BeaconBlockRootBySlot(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]byte)
+	return res0, call.Err
+}
+
+// SubmitBeaconBlock implements client.BeaconBlockSubmitter.
+func (w *wrapper) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) error {
+	call := &Call{
+		Method: "SubmitBeaconBlock",
+		Args:   []interface{}{block},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconBlockSubmitter)
+		if !isService {
+			call.Err = notSupported("SubmitBeaconBlock")
+			return
+		}
+		arg0, _ := call.Args[0].(*spec.SignedBeaconBlock)
+		err := service.SubmitBeaconBlock(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// AddOnBeaconChainHeadUpdatedHandler implements client.BeaconChainHeadUpdatedSource.
+func (w *wrapper) AddOnBeaconChainHeadUpdatedHandler(ctx context.Context, handler client.BeaconChainHeadUpdatedHandler) error {
+	call := &Call{
+		Method: "AddOnBeaconChainHeadUpdatedHandler",
+		Args:   []interface{}{handler},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconChainHeadUpdatedSource)
+		if !isService {
+			call.Err = notSupported("AddOnBeaconChainHeadUpdatedHandler")
+			return
+		}
+		arg0, _ := call.Args[0].(client.BeaconChainHeadUpdatedHandler)
+		err := service.AddOnBeaconChainHeadUpdatedHandler(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// SubmitBeaconCommitteeSubscriptions implements client.BeaconCommitteeSubscriptionsSubmitter.
+func (w *wrapper) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
+	call := &Call{
+		Method: "SubmitBeaconCommitteeSubscriptions",
+		Args:   []interface{}{subscriptions},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconCommitteeSubscriptionsSubmitter)
+		if !isService {
+			call.Err = notSupported("SubmitBeaconCommitteeSubscriptions")
+			return
+		}
+		arg0, _ := call.Args[0].([]*api.BeaconCommitteeSubscription)
+		err := service.SubmitBeaconCommitteeSubscriptions(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// BeaconCommittees implements client.BeaconCommitteesProvider.
+func (w *wrapper) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	call := &Call{
+		Method: "BeaconCommittees",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconCommitteesProvider)
+		if !isService {
+			call.Err = notSupported("BeaconCommittees")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.BeaconCommittees(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*api.BeaconCommittee)
+	return res0, call.Err
+}
+
+// BeaconProposerDomain implements client.BeaconProposerDomainProvider.
+func (w *wrapper) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "BeaconProposerDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconProposerDomainProvider)
+		if !isService {
+			call.Err = notSupported("BeaconProposerDomain")
+			return
+		}
+		res0, err := service.BeaconProposerDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// BeaconState implements client.BeaconStateProvider.
+func (w *wrapper) BeaconState(ctx context.Context, stateID string) (*spec.BeaconState, error) {
+	call := &Call{
+		Method: "BeaconState",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BeaconStateProvider)
+		if !isService {
+			call.Err = notSupported("BeaconState")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.BeaconState(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.BeaconState)
+	return res0, call.Err
+}
+
+// ResolveBlockID implements client.BlockIDResolver.
+func (w *wrapper) ResolveBlockID(ctx context.Context, blockID api.BlockID) (*api.ResolvedBlockID, error) {
+	call := &Call{
+		Method: "ResolveBlockID",
+		Args:   []interface{}{blockID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.BlockIDResolver)
+		if !isService {
+			call.Err = notSupported("ResolveBlockID")
+			return
+		}
+		arg0, _ := call.Args[0].(api.BlockID)
+		res0, err := service.ResolveBlockID(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.ResolvedBlockID)
+	return res0, call.Err
+}
+
+// ChainSpec implements client.ChainSpecProvider.
+func (w *wrapper) ChainSpec(ctx context.Context) (*api.ChainSpec, error) {
+	call := &Call{
+		Method: "ChainSpec",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ChainSpecProvider)
+		if !isService {
+			call.Err = notSupported("ChainSpec")
+			return
+		}
+		res0, err := service.ChainSpec(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.ChainSpec)
+	return res0, call.Err
+}
+
+// Close implements client.Closer.
+func (w *wrapper) Close(ctx context.Context) error {
+	call := &Call{
+		Method: "Close",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.Closer)
+		if !isService {
+			call.Err = notSupported("Close")
+			return
+		}
+		err := service.Close(ctx)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// Wait implements client.Closer.
+func (w *wrapper) Wait(ctx context.Context) error {
+	call := &Call{
+		Method: "Wait",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.Closer)
+		if !isService {
+			call.Err = notSupported("Wait")
+			return
+		}
+		err := service.Wait(ctx)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// DepositContractAddress implements client.DepositContractProvider.
+func (w *wrapper) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	call := &Call{
+		Method: "DepositContractAddress",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.DepositContractProvider)
+		if !isService {
+			call.Err = notSupported("DepositContractAddress")
+			return
+		}
+		res0, err := service.DepositContractAddress(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]byte)
+	return res0, call.Err
+}
+
+// DepositContractChainID implements client.DepositContractProvider.
+func (w *wrapper) DepositContractChainID(ctx context.Context) (uint64, error) {
+	call := &Call{
+		Method: "DepositContractChainID",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.DepositContractProvider)
+		if !isService {
+			call.Err = notSupported("DepositContractChainID")
+			return
+		}
+		res0, err := service.DepositContractChainID(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(uint64)
+	return res0, call.Err
+}
+
+// DepositContractNetworkID implements client.DepositContractProvider.
+func (w *wrapper) DepositContractNetworkID(ctx context.Context) (uint64, error) {
+	call := &Call{
+		Method: "DepositContractNetworkID",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.DepositContractProvider)
+		if !isService {
+			call.Err = notSupported("DepositContractNetworkID")
+			return
+		}
+		res0, err := service.DepositContractNetworkID(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(uint64)
+	return res0, call.Err
+}
+
+// DepositDomain implements client.DepositDomainProvider.
+func (w *wrapper) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "DepositDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.DepositDomainProvider)
+		if !isService {
+			call.Err = notSupported("DepositDomain")
+			return
+		}
+		res0, err := service.DepositDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// Domain implements client.DomainProvider.
+func (w *wrapper) Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
+	call := &Call{
+		Method: "Domain",
+		Args:   []interface{}{domainType, epoch},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.DomainProvider)
+		if !isService {
+			call.Err = notSupported("Domain")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.DomainType)
+		arg1, _ := call.Args[1].(spec.Epoch)
+		res0, err := service.Domain(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.Domain)
+	return res0, call.Err
+}
+
+// EpochFromStateID implements client.EpochFromStateIDProvider.
+func (w *wrapper) EpochFromStateID(ctx context.Context, stateID string) (spec.Epoch, error) {
+	call := &Call{
+		Method: "EpochFromStateID",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.EpochFromStateIDProvider)
+		if !isService {
+			call.Err = notSupported("EpochFromStateID")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.EpochFromStateID(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.Epoch)
+	return res0, call.Err
+}
+
+// Events implements client.EventsProvider.
+func (w *wrapper) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	call := &Call{
+		Method: "Events",
+		Args:   []interface{}{topics, handler},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.EventsProvider)
+		if !isService {
+			call.Err = notSupported("Events")
+			return
+		}
+		arg0, _ := call.Args[0].([]string)
+		arg1, _ := call.Args[1].(client.EventHandlerFunc)
+		err := service.Events(ctx, arg0, arg1)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
+
+// FarFutureEpoch implements client.FarFutureEpochProvider.
+func (w *wrapper) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	call := &Call{
+		Method: "FarFutureEpoch",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.FarFutureEpochProvider)
+		if !isService {
+			call.Err = notSupported("FarFutureEpoch")
+			return
+		}
+		res0, err := service.FarFutureEpoch(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.Epoch)
+	return res0, call.Err
+}
+
+// Finality implements client.FinalityProvider.
+func (w *wrapper) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
+	call := &Call{
+		Method: "Finality",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.FinalityProvider)
+		if !isService {
+			call.Err = notSupported("Finality")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.Finality(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.Finality)
+	return res0, call.Err
+}
+
+// Fork implements client.ForkProvider.
+func (w *wrapper) Fork(ctx context.Context, stateID string) (*spec.Fork, error) {
+	call := &Call{
+		Method: "Fork",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ForkProvider)
+		if !isService {
+			call.Err = notSupported("Fork")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.Fork(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.Fork)
+	return res0, call.Err
+}
+
+// ForkSchedule implements client.ForkScheduleProvider.
+func (w *wrapper) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
+	call := &Call{
+		Method: "ForkSchedule",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ForkScheduleProvider)
+		if !isService {
+			call.Err = notSupported("ForkSchedule")
+			return
+		}
+		res0, err := service.ForkSchedule(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*spec.Fork)
+	return res0, call.Err
+}
+
+// Genesis implements client.GenesisProvider.
+func (w *wrapper) Genesis(ctx context.Context) (*api.Genesis, error) {
+	call := &Call{
+		Method: "Genesis",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.GenesisProvider)
+		if !isService {
+			call.Err = notSupported("Genesis")
+			return
+		}
+		res0, err := service.Genesis(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.Genesis)
+	return res0, call.Err
+}
+
+// GenesisTime implements client.GenesisTimeProvider.
+func (w *wrapper) GenesisTime(ctx context.Context) (time.Time, error) {
+	call := &Call{
+		Method: "GenesisTime",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.GenesisTimeProvider)
+		if !isService {
+			call.Err = notSupported("GenesisTime")
+			return
+		}
+		res0, err := service.GenesisTime(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(time.Time)
+	return res0, call.Err
+}
+
+// GenesisValidatorsRoot implements client.GenesisValidatorsRootProvider.
+func (w *wrapper) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	call := &Call{
+		Method: "GenesisValidatorsRoot",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.GenesisValidatorsRootProvider)
+		if !isService {
+			call.Err = notSupported("GenesisValidatorsRoot")
+			return
+		}
+		res0, err := service.GenesisValidatorsRoot(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]byte)
+	return res0, call.Err
+}
+
+// NodeFamily implements client.NodeFamilyProvider.
+func (w *wrapper) NodeFamily(ctx context.Context) (client.Family, error) {
+	call := &Call{
+		Method: "NodeFamily",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.NodeFamilyProvider)
+		if !isService {
+			call.Err = notSupported("NodeFamily")
+			return
+		}
+		res0, err := service.NodeFamily(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(client.Family)
+	return res0, call.Err
+}
+
+// NodeSyncing implements client.NodeSyncingProvider.
+func (w *wrapper) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	call := &Call{
+		Method: "NodeSyncing",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.NodeSyncingProvider)
+		if !isService {
+			call.Err = notSupported("NodeSyncing")
+			return
+		}
+		res0, err := service.NodeSyncing(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.SyncState)
+	return res0, call.Err
+}
+
+// NodeVersion implements client.NodeVersionProvider.
+func (w *wrapper) NodeVersion(ctx context.Context) (string, error) {
+	call := &Call{
+		Method: "NodeVersion",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.NodeVersionProvider)
+		if !isService {
+			call.Err = notSupported("NodeVersion")
+			return
+		}
+		res0, err := service.NodeVersion(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(string)
+	return res0, call.Err
+}
+
+// ProposerDuties implements client.ProposerDutiesProvider.
+func (w *wrapper) ProposerDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
+	call := &Call{
+		Method: "ProposerDuties",
+		Args:   []interface{}{epoch, validatorIndices},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ProposerDutiesProvider)
+		if !isService {
+			call.Err = notSupported("ProposerDuties")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Epoch)
+		arg1, _ := call.Args[1].([]spec.ValidatorIndex)
+		res0, err := service.ProposerDuties(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*api.ProposerDuty)
+	return res0, call.Err
+}
+
+// PrysmAggregateAttestation implements client.PrysmAggregateAttestationProvider.
+func (w *wrapper) PrysmAggregateAttestation(ctx context.Context, attestation *spec.Attestation, validatorPubKey spec.BLSPubKey, slotSignature spec.BLSSignature) (*spec.Attestation, error) {
+	call := &Call{
+		Method: "PrysmAggregateAttestation",
+		Args:   []interface{}{attestation, validatorPubKey, slotSignature},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.PrysmAggregateAttestationProvider)
+		if !isService {
+			call.Err = notSupported("PrysmAggregateAttestation")
+			return
+		}
+		arg0, _ := call.Args[0].(*spec.Attestation)
+		arg1, _ := call.Args[1].(spec.BLSPubKey)
+		arg2, _ := call.Args[2].(spec.BLSSignature)
+		res0, err := service.PrysmAggregateAttestation(ctx, arg0, arg1, arg2)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.Attestation)
+	return res0, call.Err
+}
+
+// PrysmAttesterDuties implements client.PrysmAttesterDutiesProvider.
+func (w *wrapper) PrysmAttesterDuties(ctx context.Context, epoch spec.Epoch, validatorPubKeys []spec.BLSPubKey) ([]*api.AttesterDuty, error) {
+	call := &Call{
+		Method: "PrysmAttesterDuties",
+		Args:   []interface{}{epoch, validatorPubKeys},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.PrysmAttesterDutiesProvider)
+		if !isService {
+			call.Err = notSupported("PrysmAttesterDuties")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Epoch)
+		arg1, _ := call.Args[1].([]spec.BLSPubKey)
+		res0, err := service.PrysmAttesterDuties(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*api.AttesterDuty)
+	return res0, call.Err
+}
+
+// PrysmProposerDuties implements client.PrysmProposerDutiesProvider.
+func (w *wrapper) PrysmProposerDuties(ctx context.Context, epoch spec.Epoch, validatorPubKeys []spec.BLSPubKey) ([]*api.ProposerDuty, error) {
+	call := &Call{
+		Method: "PrysmProposerDuties",
+		Args:   []interface{}{epoch, validatorPubKeys},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.PrysmProposerDutiesProvider)
+		if !isService {
+			call.Err = notSupported("PrysmProposerDuties")
+			return
+		}
+		arg0, _ := call.Args[0].(spec.Epoch)
+		arg1, _ := call.Args[1].([]spec.BLSPubKey)
+		res0, err := service.PrysmProposerDuties(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).([]*api.ProposerDuty)
+	return res0, call.Err
+}
+
+// PrysmValidatorBalances implements client.PrysmValidatorBalancesProvider.
+func (w *wrapper) PrysmValidatorBalances(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	call := &Call{
+		Method: "PrysmValidatorBalances",
+		Args:   []interface{}{stateID, validatorPubKeys},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.PrysmValidatorBalancesProvider)
+		if !isService {
+			call.Err = notSupported("PrysmValidatorBalances")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.BLSPubKey)
+		res0, err := service.PrysmValidatorBalances(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]spec.Gwei)
+	return res0, call.Err
+}
+
+// RANDAODomain implements client.RANDAODomainProvider.
+func (w *wrapper) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "RANDAODomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.RANDAODomainProvider)
+		if !isService {
+			call.Err = notSupported("RANDAODomain")
+			return
+		}
+		res0, err := service.RANDAODomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// SelectionProofDomain implements client.SelectionProofDomainProvider.
+func (w *wrapper) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "SelectionProofDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SelectionProofDomainProvider)
+		if !isService {
+			call.Err = notSupported("SelectionProofDomain")
+			return
+		}
+		res0, err := service.SelectionProofDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// SignedBeaconBlock implements client.SignedBeaconBlockProvider.
+func (w *wrapper) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, error) {
+	call := &Call{
+		Method: "SignedBeaconBlock",
+		Args:   []interface{}{blockID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SignedBeaconBlockProvider)
+		if !isService {
+			call.Err = notSupported("SignedBeaconBlock")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.SignedBeaconBlock(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*spec.SignedBeaconBlock)
+	return res0, call.Err
+}
+
+// SlotDuration implements client.SlotDurationProvider.
+func (w *wrapper) SlotDuration(ctx context.Context) (time.Duration, error) {
+	call := &Call{
+		Method: "SlotDuration",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SlotDurationProvider)
+		if !isService {
+			call.Err = notSupported("SlotDuration")
+			return
+		}
+		res0, err := service.SlotDuration(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(time.Duration)
+	return res0, call.Err
+}
+
+// SlotFromStateID implements client.SlotFromStateIDProvider.
+func (w *wrapper) SlotFromStateID(ctx context.Context, stateID string) (spec.Slot, error) {
+	call := &Call{
+		Method: "SlotFromStateID",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SlotFromStateIDProvider)
+		if !isService {
+			call.Err = notSupported("SlotFromStateID")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		res0, err := service.SlotFromStateID(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.Slot)
+	return res0, call.Err
+}
+
+// SlotsPerEpoch implements client.SlotsPerEpochProvider.
+func (w *wrapper) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	call := &Call{
+		Method: "SlotsPerEpoch",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SlotsPerEpochProvider)
+		if !isService {
+			call.Err = notSupported("SlotsPerEpoch")
+			return
+		}
+		res0, err := service.SlotsPerEpoch(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(uint64)
+	return res0, call.Err
+}
+
+// Spec implements client.SpecProvider.
+func (w *wrapper) Spec(ctx context.Context) (map[string]interface{}, error) {
+	call := &Call{
+		Method: "Spec",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SpecProvider)
+		if !isService {
+			call.Err = notSupported("Spec")
+			return
+		}
+		res0, err := service.Spec(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[string]interface{})
+	return res0, call.Err
+}
+
+// ResolveStateID implements client.StateIDResolver.
+func (w *wrapper) ResolveStateID(ctx context.Context, stateID api.StateID) (*api.ResolvedStateID, error) {
+	call := &Call{
+		Method: "ResolveStateID",
+		Args:   []interface{}{stateID},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.StateIDResolver)
+		if !isService {
+			call.Err = notSupported("ResolveStateID")
+			return
+		}
+		arg0, _ := call.Args[0].(api.StateID)
+		res0, err := service.ResolveStateID(ctx, arg0)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.ResolvedStateID)
+	return res0, call.Err
+}
+
+// SyncState implements client.SyncStateProvider.
+func (w *wrapper) SyncState(ctx context.Context) (*api.SyncState, error) {
+	call := &Call{
+		Method: "SyncState",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.SyncStateProvider)
+		if !isService {
+			call.Err = notSupported("SyncState")
+			return
+		}
+		res0, err := service.SyncState(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(*api.SyncState)
+	return res0, call.Err
+}
+
+// TargetAggregatorsPerCommittee implements client.TargetAggregatorsPerCommitteeProvider.
+func (w *wrapper) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	call := &Call{
+		Method: "TargetAggregatorsPerCommittee",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.TargetAggregatorsPerCommitteeProvider)
+		if !isService {
+			call.Err = notSupported("TargetAggregatorsPerCommittee")
+			return
+		}
+		res0, err := service.TargetAggregatorsPerCommittee(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(uint64)
+	return res0, call.Err
+}
+
+// ValidatorBalances implements client.ValidatorBalancesProvider.
+func (w *wrapper) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	call := &Call{
+		Method: "ValidatorBalances",
+		Args:   []interface{}{stateID, validatorIndices},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorBalancesProvider)
+		if !isService {
+			call.Err = notSupported("ValidatorBalances")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.ValidatorIndex)
+		res0, err := service.ValidatorBalances(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]spec.Gwei)
+	return res0, call.Err
+}
+
+// Index implements client.ValidatorIndexProvider.
+func (w *wrapper) Index(ctx context.Context) (spec.ValidatorIndex, error) {
+	call := &Call{
+		Method: "Index",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorIndexProvider)
+		if !isService {
+			call.Err = notSupported("Index")
+			return
+		}
+		res0, err := service.Index(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.ValidatorIndex)
+	return res0, call.Err
+}
+
+// PubKey implements client.ValidatorPubKeyProvider.
+func (w *wrapper) PubKey(ctx context.Context) (spec.BLSPubKey, error) {
+	call := &Call{
+		Method: "PubKey",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorPubKeyProvider)
+		if !isService {
+			call.Err = notSupported("PubKey")
+			return
+		}
+		res0, err := service.PubKey(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.BLSPubKey)
+	return res0, call.Err
+}
+
+// Validators implements client.ValidatorsProvider.
+func (w *wrapper) Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	call := &Call{
+		Method: "Validators",
+		Args:   []interface{}{stateID, validatorIndices},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorsProvider)
+		if !isService {
+			call.Err = notSupported("Validators")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.ValidatorIndex)
+		res0, err := service.Validators(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]*api.Validator)
+	return res0, call.Err
+}
+
+// ValidatorsByPubKey implements client.ValidatorsProvider.
+func (w *wrapper) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	call := &Call{
+		Method: "ValidatorsByPubKey",
+		Args:   []interface{}{stateID, validatorPubKeys},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorsProvider)
+		if !isService {
+			call.Err = notSupported("ValidatorsByPubKey")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.BLSPubKey)
+		res0, err := service.ValidatorsByPubKey(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]*api.Validator)
+	return res0, call.Err
+}
+
+// ValidatorsWithoutBalance implements client.ValidatorsWithoutBalanceProvider.
+func (w *wrapper) ValidatorsWithoutBalance(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	call := &Call{
+		Method: "ValidatorsWithoutBalance",
+		Args:   []interface{}{stateID, validatorIndices},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorsWithoutBalanceProvider)
+		if !isService {
+			call.Err = notSupported("ValidatorsWithoutBalance")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.ValidatorIndex)
+		res0, err := service.ValidatorsWithoutBalance(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]*api.Validator)
+	return res0, call.Err
+}
+
+// ValidatorsWithoutBalanceByPubKey implements client.ValidatorsWithoutBalanceProvider.
+func (w *wrapper) ValidatorsWithoutBalanceByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	call := &Call{
+		Method: "ValidatorsWithoutBalanceByPubKey",
+		Args:   []interface{}{stateID, validatorPubKeys},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.ValidatorsWithoutBalanceProvider)
+		if !isService {
+			call.Err = notSupported("ValidatorsWithoutBalanceByPubKey")
+			return
+		}
+		arg0, _ := call.Args[0].(string)
+		arg1, _ := call.Args[1].([]spec.BLSPubKey)
+		res0, err := service.ValidatorsWithoutBalanceByPubKey(ctx, arg0, arg1)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(map[spec.ValidatorIndex]*api.Validator)
+	return res0, call.Err
+}
+
+// VoluntaryExitDomain implements client.VoluntaryExitDomainProvider.
+func (w *wrapper) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	call := &Call{
+		Method: "VoluntaryExitDomain",
+		Args:   []interface{}{},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.VoluntaryExitDomainProvider)
+		if !isService {
+			call.Err = notSupported("VoluntaryExitDomain")
+			return
+		}
+		res0, err := service.VoluntaryExitDomain(ctx)
+		call.Results = []interface{}{res0}
+		call.Err = err
+	})
+	res0, _ := call.result(0).(spec.DomainType)
+	return res0, call.Err
+}
+
+// SubmitVoluntaryExit implements client.VoluntaryExitSubmitter.
+func (w *wrapper) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	call := &Call{
+		Method: "SubmitVoluntaryExit",
+		Args:   []interface{}{voluntaryExit},
+	}
+	w.invoke(ctx, call, func(ctx context.Context, call *Call) {
+		service, isService := w.service.(client.VoluntaryExitSubmitter)
+		if !isService {
+			call.Err = notSupported("SubmitVoluntaryExit")
+			return
+		}
+		arg0, _ := call.Args[0].(*spec.SignedVoluntaryExit)
+		err := service.SubmitVoluntaryExit(ctx, arg0)
+		call.Results = []interface{}{}
+		call.Err = err
+	})
+	return call.Err
+}
@@ -0,0 +1,107 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics defines the monitor through which clients report their activity, for example to
+// expose it as Prometheus metrics with the metrics/prometheus package.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// Protocols reported by clients.
+const (
+	// ProtocolHTTP is reported by clients that use HTTP.
+	ProtocolHTTP = "http"
+	// ProtocolGRPC is reported by clients that use gRPC.
+	ProtocolGRPC = "grpc"
+)
+
+// Error classes reported for requests.
+const (
+	// ErrorClassNone is reported for requests that succeed.
+	ErrorClassNone = ""
+	// ErrorClassTimeout is reported for requests that time out.
+	ErrorClassTimeout = "timeout"
+	// ErrorClassCanceled is reported for requests that are canceled.
+	ErrorClassCanceled = "canceled"
+	// ErrorClassNotFound is reported for requests for data that is not available.
+	ErrorClassNotFound = "not_found"
+	// ErrorClassNotSupported is reported for requests that the node does not support.
+	ErrorClassNotSupported = "not_supported"
+	// ErrorClassSyncing is reported for requests that the node cannot service because it is syncing.
+	ErrorClassSyncing = "syncing"
+	// ErrorClassBadRequest is reported for requests that the node rejects as invalid.
+	ErrorClassBadRequest = "bad_request"
+	// ErrorClassClient is reported for other requests that the node rejects.
+	ErrorClassClient = "client"
+	// ErrorClassServer is reported for requests that fail within the node.
+	ErrorClassServer = "server"
+	// ErrorClassConnection is reported for requests that fail without a response from the node.
+	ErrorClassConnection = "connection"
+)
+
+// Monitor is notified of the activity of a client.
+// Implementations must be safe for concurrent use.
+type Monitor interface {
+	// RequestCompleted is called when a request to a node completes.
+	// The endpoint is the method and path of an HTTP request with identifiers replaced by placeholders, for example
+	// "GET /eth/v1/beacon/states/{id}/fork", or the full method of a gRPC call.  The status is the HTTP status code or the gRPC status code, and is blank if there was no response.
+	RequestCompleted(address string, protocol string, endpoint string, status string, errorClass string, duration time.Duration)
+	// StreamConnected is called when an event stream connects to or disconnects from a node.
+	StreamConnected(address string, protocol string, connected bool)
+	// EventReceived is called when an event is received from a node.
+	EventReceived(address string, protocol string, topic string)
+	// CacheAccessed is called when a client looks up a value that it caches.
+	CacheAccessed(address string, cache string, hit bool)
+}
+
+// ErrorClass provides the class of an error returned by a request, for reporting to a monitor.
+func ErrorClass(err error) string {
+	if err == nil {
+		return ErrorClassNone
+	}
+
+	var apiErr *client.APIError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, client.ErrTimeout):
+		return ErrorClassTimeout
+	case errors.Is(err, client.ErrNotFound):
+		return ErrorClassNotFound
+	case errors.Is(err, client.ErrNotSupported):
+		return ErrorClassNotSupported
+	case errors.Is(err, client.ErrSyncing):
+		return ErrorClassSyncing
+	case errors.Is(err, client.ErrBadRequest):
+		return ErrorClassBadRequest
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode == 499:
+			// Client closed request.
+			return ErrorClassCanceled
+		case apiErr.StatusCode >= http.StatusInternalServerError:
+			return ErrorClassServer
+		default:
+			return ErrorClassClient
+		}
+	default:
+		return ErrorClassConnection
+	}
+}
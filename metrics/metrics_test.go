@@ -0,0 +1,105 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		class string
+	}{
+		{
+			name:  "Nil",
+			class: metrics.ErrorClassNone,
+		},
+		{
+			name:  "Canceled",
+			err:   fmt.Errorf("failed: %w", context.Canceled),
+			class: metrics.ErrorClassCanceled,
+		},
+		{
+			name:  "DeadlineExceeded",
+			err:   context.DeadlineExceeded,
+			class: metrics.ErrorClassTimeout,
+		},
+		{
+			name:  "Timeout",
+			err:   fmt.Errorf("failed: %w", client.ErrTimeout),
+			class: metrics.ErrorClassTimeout,
+		},
+		{
+			name:  "GatewayTimeout",
+			err:   &client.APIError{StatusCode: http.StatusGatewayTimeout},
+			class: metrics.ErrorClassTimeout,
+		},
+		{
+			name:  "NotFound",
+			err:   &client.APIError{StatusCode: http.StatusNotFound},
+			class: metrics.ErrorClassNotFound,
+		},
+		{
+			name:  "NotSupported",
+			err:   fmt.Errorf("failed: %w", client.ErrNotSupported),
+			class: metrics.ErrorClassNotSupported,
+		},
+		{
+			name:  "Syncing",
+			err:   &client.APIError{StatusCode: http.StatusServiceUnavailable},
+			class: metrics.ErrorClassSyncing,
+		},
+		{
+			name:  "BadRequest",
+			err:   &client.APIError{StatusCode: http.StatusBadRequest},
+			class: metrics.ErrorClassBadRequest,
+		},
+		{
+			name:  "ClientClosedRequest",
+			err:   &client.APIError{StatusCode: 499},
+			class: metrics.ErrorClassCanceled,
+		},
+		{
+			name:  "Client",
+			err:   &client.APIError{StatusCode: http.StatusForbidden},
+			class: metrics.ErrorClassClient,
+		},
+		{
+			name:  "Server",
+			err:   fmt.Errorf("failed: %w", &client.APIError{StatusCode: http.StatusInternalServerError}),
+			class: metrics.ErrorClassServer,
+		},
+		{
+			name:  "Connection",
+			err:   errors.New("connection refused"),
+			class: metrics.ErrorClassConnection,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.class, metrics.ErrorClass(test.err))
+		})
+	}
+}
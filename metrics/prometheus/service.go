@@ -0,0 +1,164 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a monitor that exposes client activity as Prometheus metrics.
+package prometheus
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Service is a monitor that exposes client activity as Prometheus metrics.
+//
+// Cache hit ratios can be obtained from the cache_accesses_total metric, for example with
+// sum(rate(eth2client_cache_accesses_total{result="hit"}[5m])) by (cache) / sum(rate(eth2client_cache_accesses_total[5m])) by (cache).
+type Service struct {
+	requests         *prometheus.CounterVec
+	requestDurations *prometheus.HistogramVec
+	requestErrors    *prometheus.CounterVec
+	streamConnected  *prometheus.GaugeVec
+	events           *prometheus.CounterVec
+	cacheAccesses    *prometheus.CounterVec
+}
+
+// Ensure that the service is a monitor.
+var _ metrics.Monitor = (*Service)(nil)
+
+// New creates a new Prometheus monitor.
+// Metrics already registered by another monitor with the same registerer and namespace are shared.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	s := &Service{}
+
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: parameters.namespace,
+		Name:      "requests_total",
+		Help:      "The number of requests made to beacon nodes.",
+	}, []string{"address", "protocol", "endpoint", "status"})
+	collector, err := register(parameters.registerer, requests)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register requests_total")
+	}
+	s.requests = collector.(*prometheus.CounterVec)
+
+	requestDurations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: parameters.namespace,
+		Name:      "request_duration_seconds",
+		Help:      "The time taken by requests made to beacon nodes.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}, []string{"address", "protocol", "endpoint"})
+	collector, err = register(parameters.registerer, requestDurations)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register request_duration_seconds")
+	}
+	s.requestDurations = collector.(*prometheus.HistogramVec)
+
+	requestErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: parameters.namespace,
+		Name:      "request_errors_total",
+		Help:      "The number of requests made to beacon nodes that failed, by class of error.",
+	}, []string{"address", "protocol", "endpoint", "class"})
+	collector, err = register(parameters.registerer, requestErrors)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register request_errors_total")
+	}
+	s.requestErrors = collector.(*prometheus.CounterVec)
+
+	streamConnected := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: parameters.namespace,
+		Name:      "stream_connected",
+		Help:      "1 if the event stream from a beacon node is connected, otherwise 0.",
+	}, []string{"address", "protocol"})
+	collector, err = register(parameters.registerer, streamConnected)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register stream_connected")
+	}
+	s.streamConnected = collector.(*prometheus.GaugeVec)
+
+	events := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: parameters.namespace,
+		Name:      "events_total",
+		Help:      "The number of events received from beacon nodes.",
+	}, []string{"address", "protocol", "topic"})
+	collector, err = register(parameters.registerer, events)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register events_total")
+	}
+	s.events = collector.(*prometheus.CounterVec)
+
+	cacheAccesses := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: parameters.namespace,
+		Name:      "cache_accesses_total",
+		Help:      "The number of lookups of cached values, by result.",
+	}, []string{"address", "cache", "result"})
+	collector, err = register(parameters.registerer, cacheAccesses)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register cache_accesses_total")
+	}
+	s.cacheAccesses = collector.(*prometheus.CounterVec)
+
+	return s, nil
+}
+
+// register registers a collector, returning the existing collector if it is already registered.
+func register(registerer prometheus.Registerer, collector prometheus.Collector) (prometheus.Collector, error) {
+	if err := registerer.Register(collector); err != nil {
+		var alreadyRegisteredErr prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegisteredErr) {
+			return alreadyRegisteredErr.ExistingCollector, nil
+		}
+		return nil, err
+	}
+	return collector, nil
+}
+
+// RequestCompleted is called when a request to a node completes.
+func (s *Service) RequestCompleted(address string, protocol string, endpoint string, status string, errorClass string, duration time.Duration) {
+	s.requests.WithLabelValues(address, protocol, endpoint, status).Inc()
+	s.requestDurations.WithLabelValues(address, protocol, endpoint).Observe(duration.Seconds())
+	if errorClass != metrics.ErrorClassNone {
+		s.requestErrors.WithLabelValues(address, protocol, endpoint, errorClass).Inc()
+	}
+}
+
+// StreamConnected is called when an event stream connects to or disconnects from a node.
+func (s *Service) StreamConnected(address string, protocol string, connected bool) {
+	if connected {
+		s.streamConnected.WithLabelValues(address, protocol).Set(1)
+	} else {
+		s.streamConnected.WithLabelValues(address, protocol).Set(0)
+	}
+}
+
+// EventReceived is called when an event is received from a node.
+func (s *Service) EventReceived(address string, protocol string, topic string) {
+	s.events.WithLabelValues(address, protocol, topic).Inc()
+}
+
+// CacheAccessed is called when a client looks up a value that it caches.
+func (s *Service) CacheAccessed(address string, cache string, hit bool) {
+	if hit {
+		s.cacheAccesses.WithLabelValues(address, cache, "hit").Inc()
+	} else {
+		s.cacheAccesses.WithLabelValues(address, cache, "miss").Inc()
+	}
+}
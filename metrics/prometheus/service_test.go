@@ -0,0 +1,131 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/metrics/prometheus"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []prometheus.Parameter
+		err    string
+	}{
+		{
+			name: "NamespaceMissing",
+			params: []prometheus.Parameter{
+				prometheus.WithNamespace(""),
+				prometheus.WithRegisterer(prom.NewRegistry()),
+			},
+			err: "problem with parameters: no namespace specified",
+		},
+		{
+			name: "RegistererMissing",
+			params: []prometheus.Parameter{
+				prometheus.WithRegisterer(nil),
+			},
+			err: "problem with parameters: no registerer specified",
+		},
+		{
+			name: "Good",
+			params: []prometheus.Parameter{
+				prometheus.WithRegisterer(prom.NewRegistry()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := prometheus.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	registry := prom.NewRegistry()
+	s, err := prometheus.New(ctx, prometheus.WithRegisterer(registry))
+	require.NoError(t, err)
+
+	s.RequestCompleted("node:5052", metrics.ProtocolHTTP, "GET /eth/v1/beacon/genesis", "200", metrics.ErrorClassNone, 10*time.Millisecond)
+	s.RequestCompleted("node:5052", metrics.ProtocolHTTP, "GET /eth/v1/beacon/genesis", "503", metrics.ErrorClassSyncing, 20*time.Millisecond)
+	s.StreamConnected("node:5052", metrics.ProtocolHTTP, true)
+	s.EventReceived("node:5052", metrics.ProtocolHTTP, "head")
+	s.EventReceived("node:5052", metrics.ProtocolHTTP, "head")
+	s.CacheAccessed("node:5052", "genesis", false)
+	s.CacheAccessed("node:5052", "genesis", true)
+	s.CacheAccessed("node:5052", "genesis", true)
+
+	// A second monitor with the same registerer shares the metrics.
+	s2, err := prometheus.New(ctx, prometheus.WithRegisterer(registry))
+	require.NoError(t, err)
+	s2.StreamConnected("node:5052", metrics.ProtocolHTTP, false)
+
+	expected := `
+# HELP eth2client_cache_accesses_total The number of lookups of cached values, by result.
+# TYPE eth2client_cache_accesses_total counter
+eth2client_cache_accesses_total{address="node:5052",cache="genesis",result="hit"} 2
+eth2client_cache_accesses_total{address="node:5052",cache="genesis",result="miss"} 1
+# HELP eth2client_events_total The number of events received from beacon nodes.
+# TYPE eth2client_events_total counter
+eth2client_events_total{address="node:5052",protocol="http",topic="head"} 2
+# HELP eth2client_request_errors_total The number of requests made to beacon nodes that failed, by class of error.
+# TYPE eth2client_request_errors_total counter
+eth2client_request_errors_total{address="node:5052",class="syncing",endpoint="GET /eth/v1/beacon/genesis",protocol="http"} 1
+# HELP eth2client_requests_total The number of requests made to beacon nodes.
+# TYPE eth2client_requests_total counter
+eth2client_requests_total{address="node:5052",endpoint="GET /eth/v1/beacon/genesis",protocol="http",status="200"} 1
+eth2client_requests_total{address="node:5052",endpoint="GET /eth/v1/beacon/genesis",protocol="http",status="503"} 1
+# HELP eth2client_stream_connected 1 if the event stream from a beacon node is connected, otherwise 0.
+# TYPE eth2client_stream_connected gauge
+eth2client_stream_connected{address="node:5052",protocol="http"} 0
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"eth2client_cache_accesses_total",
+		"eth2client_events_total",
+		"eth2client_request_errors_total",
+		"eth2client_requests_total",
+		"eth2client_stream_connected",
+	))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	found := false
+	for _, family := range families {
+		if family.GetName() == "eth2client_request_duration_seconds" {
+			found = true
+			require.Len(t, family.GetMetric(), 1)
+			require.Equal(t, uint64(2), family.GetMetric()[0].GetHistogram().GetSampleCount())
+		}
+	}
+	require.True(t, found)
+}
@@ -0,0 +1,56 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// AggregateAttestation fetches the aggregate attestation given an attestation.
+// The aggregate combines the aggregation bits of the submitted attestations with matching data;
+// the signature is that of the first matching attestation, as signatures are not aggregated.
+func (s *Service) AggregateAttestation(ctx context.Context, slot spec.Slot, attestationDataRoot spec.Root) (*spec.Attestation, error) {
+	var aggregate *spec.Attestation
+	for _, attestation := range s.attestationsAtSlot(slot) {
+		dataRoot, err := attestation.Data.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate attestation data root")
+		}
+		if dataRoot != attestationDataRoot {
+			continue
+		}
+		if aggregate == nil {
+			aggregate = &spec.Attestation{
+				AggregationBits: bitfield.NewBitlist(attestation.AggregationBits.Len()),
+				Data:            attestation.Data,
+				Signature:       attestation.Signature,
+			}
+		}
+		for i := uint64(0); i < attestation.AggregationBits.Len() && i < aggregate.AggregationBits.Len(); i++ {
+			if attestation.AggregationBits.BitAt(i) {
+				aggregate.AggregationBits.SetBitAt(i, true)
+			}
+		}
+	}
+	if aggregate == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "no matching attestations")
+	}
+
+	return aggregate, nil
+}
@@ -0,0 +1,44 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttestationData obtains attestation data for a slot.
+// The data votes for the head of the chain at the slot, and uses the chain's justified checkpoint as its source.
+func (s *Service) AttestationData(ctx context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	if slot > s.headSlot()+1 {
+		return nil, errors.New("slot too far in the future")
+	}
+	headSlot := slot
+	if headSlot > s.headSlot() {
+		headSlot = s.headSlot()
+	}
+
+	return &spec.AttestationData{
+		Slot:            slot,
+		Index:           committeeIndex,
+		BeaconBlockRoot: s.blockRoots[headSlot],
+		Source:          s.finalityAt(headSlot).Justified,
+		Target:          s.checkpoint(s.epochAtSlot(slot)),
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationData(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+
+	data, err := service.AttestationData(ctx, 20, 1)
+	require.NoError(t, err)
+	head, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, head.Root, data.BeaconBlockRoot)
+	require.Equal(t, spec.CommitteeIndex(1), data.Index)
+	require.Equal(t, spec.Epoch(2), data.Target.Epoch)
+	target, err := service.BeaconBlockHeader(ctx, "16")
+	require.NoError(t, err)
+	require.Equal(t, target.Root, data.Target.Root)
+	finality, err := service.Finality(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, finality.Justified, data.Source)
+
+	// Past slots vote for the block at the slot.
+	data, err = service.AttestationData(ctx, 10, 0)
+	require.NoError(t, err)
+	past, err := service.BeaconBlockHeader(ctx, "10")
+	require.NoError(t, err)
+	require.Equal(t, past.Root, data.BeaconBlockRoot)
+
+	_, err = service.AttestationData(ctx, 22, 0)
+	require.EqualError(t, err, "slot too far in the future")
+}
+
+func TestAttestations(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	require.NoError(t, service.Advance(ctx))
+
+	data, err := service.AttestationData(ctx, 1, 0)
+	require.NoError(t, err)
+	attestations := make([]*spec.Attestation, 3)
+	for i := range attestations {
+		aggregationBits := bitfield.NewBitlist(8)
+		aggregationBits.SetBitAt(uint64(i), true)
+		attestations[i] = &spec.Attestation{
+			AggregationBits: aggregationBits,
+			Data:            data,
+		}
+	}
+	require.NoError(t, service.SubmitAttestations(ctx, attestations))
+	require.Equal(t, attestations, service.SubmittedAttestations())
+
+	pool, err := service.AttestationPool(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, pool, 3)
+	pool, err = service.AttestationPool(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, pool, 0)
+
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	aggregate, err := service.AggregateAttestation(ctx, 1, dataRoot)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), aggregate.AggregationBits.Count())
+	_, err = service.AggregateAttestation(ctx, 1, spec.Root{})
+	require.EqualError(t, err, "no matching attestations: not found")
+
+	// Attestations are included in the next block.
+	require.NoError(t, service.Advance(ctx))
+	block, err := service.SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	require.Len(t, block.Message.Body.Attestations, 3)
+}
@@ -0,0 +1,26 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// AttestationPool obtains the attestation pool for a given slot.
+// The pool contains the attestations and aggregates submitted for the slot.
+func (s *Service) AttestationPool(ctx context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
+	return s.attestationsAtSlot(slot), nil
+}
@@ -0,0 +1,73 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// AttesterDuties obtains attester duties.
+// If validatorIndices is empty then duties are returned for all validators.
+func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	if epoch > s.epochAtSlot(s.headSlot())+1 {
+		return nil, errors.New("epoch too far in the future")
+	}
+	wanted := s.validatorIndexMap(validatorIndices)
+
+	committees, err := s.committees(epoch)
+	if err != nil {
+		return nil, err
+	}
+	committeesAtSlot := uint64(len(committees)) / s.slotsPerEpoch
+
+	duties := make([]*api.AttesterDuty, 0)
+	for _, committee := range committees {
+		for i, validatorIndex := range committee.Validators {
+			if !wanted(validatorIndex) {
+				continue
+			}
+			duties = append(duties, &api.AttesterDuty{
+				PubKey:                  s.state.Validators[validatorIndex].PublicKey,
+				Slot:                    committee.Slot,
+				ValidatorIndex:          validatorIndex,
+				CommitteeIndex:          committee.Index,
+				CommitteeLength:         uint64(len(committee.Validators)),
+				CommitteesAtSlot:        committeesAtSlot,
+				ValidatorCommitteeIndex: uint64(i),
+			})
+		}
+	}
+
+	return duties, nil
+}
+
+// validatorIndexMap provides a function to check if a validator index is in the given list.
+// An empty list contains all validators.
+func (s *Service) validatorIndexMap(validatorIndices []spec.ValidatorIndex) func(spec.ValidatorIndex) bool {
+	if len(validatorIndices) == 0 {
+		return func(spec.ValidatorIndex) bool { return true }
+	}
+	indices := make(map[spec.ValidatorIndex]bool, len(validatorIndices))
+	for _, index := range validatorIndices {
+		indices[index] = true
+	}
+	return func(index spec.ValidatorIndex) bool { return indices[index] }
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttesterDuties(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true), mock.WithValidators(100))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		epoch   spec.Epoch
+		indices []spec.ValidatorIndex
+		duties  int
+		err     string
+	}{
+		{
+			name:   "All",
+			duties: 100,
+		},
+		{
+			name:    "Some",
+			indices: []spec.ValidatorIndex{1, 2, 99},
+			duties:  3,
+		},
+		{
+			name:    "Unknown",
+			indices: []spec.ValidatorIndex{100},
+			duties:  0,
+		},
+		{
+			name:   "NextEpoch",
+			epoch:  1,
+			duties: 100,
+		},
+		{
+			name:  "FarFuture",
+			epoch: 2,
+			err:   "epoch too far in the future",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duties, err := service.AttesterDuties(ctx, test.epoch, test.indices)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, duties, test.duties)
+
+			committees, err := service.BeaconCommittees(ctx, "head")
+			require.NoError(t, err)
+			for _, duty := range duties {
+				require.Equal(t, test.epoch, spec.Epoch(uint64(duty.Slot)/8))
+				if test.epoch != 0 {
+					continue
+				}
+				// Duties match the committees.
+				found := false
+				for _, committee := range committees {
+					if committee.Slot == duty.Slot && committee.Index == duty.CommitteeIndex {
+						require.Equal(t, duty.ValidatorIndex, committee.Validators[duty.ValidatorCommitteeIndex])
+						require.Equal(t, uint64(len(committee.Validators)), duty.CommitteeLength)
+						found = true
+					}
+				}
+				require.True(t, found)
+			}
+		})
+	}
+}
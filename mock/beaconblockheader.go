@@ -0,0 +1,53 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconBlockHeader provides the block header of a given block ID.
+func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForBlockID(blockID)
+	if err != nil {
+		return nil, err
+	}
+	block := s.blocks[slot]
+	bodyRoot, err := block.Message.Body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate body root")
+	}
+
+	return &api.BeaconBlockHeader{
+		Root:      s.blockRoots[slot],
+		Canonical: true,
+		Header: &spec.SignedBeaconBlockHeader{
+			Message: &spec.BeaconBlockHeader{
+				Slot:          block.Message.Slot,
+				ProposerIndex: block.Message.ProposerIndex,
+				ParentRoot:    block.Message.ParentRoot,
+				StateRoot:     block.Message.StateRoot,
+				BodyRoot:      bodyRoot,
+			},
+			Signature: block.Signature,
+		},
+	}, nil
+}
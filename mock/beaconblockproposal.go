@@ -17,89 +17,28 @@ import (
 	"context"
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
-	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/pkg/errors"
 )
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
+// The block builds on the head of the chain, and includes the attestations submitted for the previous slot.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte) (*spec.BeaconBlock, error) {
-	// Graffiti should be 32 bytes.
-	fixedGraffiti := make([]byte, 32)
-	copy(fixedGraffiti, graffiti)
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
 
-	// Build a beacon block.
-
-	// Create a few attestations.
-	attestations := make([]*spec.Attestation, 4)
-	for i := uint64(0); i < 4; i++ {
-		aggregationBits := bitfield.NewBitlist(128)
-		aggregationBits.SetBitAt(i, true)
-		attestations[i] = &spec.Attestation{
-			AggregationBits: aggregationBits,
-			Data: &spec.AttestationData{
-				Slot:  slot - 1,
-				Index: spec.CommitteeIndex(i),
-				BeaconBlockRoot: spec.Root([32]byte{
-					0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
-					0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
-				}),
-				Source: &spec.Checkpoint{
-					Epoch: 0,
-					Root: spec.Root([32]byte{
-						0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f,
-						0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f,
-					}),
-				},
-				Target: &spec.Checkpoint{
-					Epoch: 1,
-					Root: spec.Root([32]byte{
-						0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
-						0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
-					}),
-				},
-			},
-			Signature: spec.BLSSignature([96]byte{
-				0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
-				0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
-				0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f,
-				0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f,
-				0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
-				0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
-			}),
-		}
+	if slot <= s.headSlot() {
+		return nil, errors.New("slot not after head of chain")
 	}
-
-	block := &spec.BeaconBlock{
-		Slot:          slot,
-		ProposerIndex: 1,
-		ParentRoot: spec.Root([32]byte{
-			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
-			0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
-		}),
-		StateRoot: spec.Root([32]byte{
-			0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f,
-			0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3a, 0x3b, 0x3c, 0x3d, 0x3e, 0x3f,
-		}),
-		Body: &spec.BeaconBlockBody{
-			RANDAOReveal: randaoReveal,
-			ETH1Data: &spec.ETH1Data{
-				DepositRoot: spec.Root([32]byte{
-					0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
-					0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
-				}),
-				DepositCount: 16384,
-				BlockHash: []byte{
-					0x60, 0x61, 0x62, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x69, 0x6a, 0x6b, 0x6c, 0x6d, 0x6e, 0x6f,
-					0x70, 0x71, 0x72, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79, 0x7a, 0x7b, 0x7c, 0x7d, 0x7e, 0x7f,
-				},
-			},
-			Graffiti:          fixedGraffiti,
-			ProposerSlashings: []*spec.ProposerSlashing{},
-			AttesterSlashings: []*spec.AttesterSlashing{},
-			Attestations:      attestations,
-			Deposits:          []*spec.Deposit{},
-			VoluntaryExits:    []*spec.SignedVoluntaryExit{},
-		},
+	proposerIndex, err := s.proposerIndex(slot)
+	if err != nil {
+		return nil, err
 	}
 
-	return block, nil
+	return &spec.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    s.blockRoots[s.headSlot()],
+		StateRoot:     stateRoot(slot),
+		Body:          emptyBody(randaoReveal, graffiti, s.attestationsForBlock(slot)),
+	}, nil
 }
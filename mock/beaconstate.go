@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// BeaconState fetches a beacon state given a state ID.
+// The state is built from the simulated chain, so its hash tree root is not the
+// state root recorded in the chain's blocks.
+func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.BeaconState, error) {
+	slotsPerHistoricalRoot, err := s.specUint64(ctx, "SLOTS_PER_HISTORICAL_ROOT")
+	if err != nil {
+		return nil, err
+	}
+	epochsPerSlashingsVector, err := s.specUint64(ctx, "EPOCHS_PER_SLASHINGS_VECTOR")
+	if err != nil {
+		return nil, err
+	}
+
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID)
+	if err != nil {
+		return nil, err
+	}
+	block := s.blocks[slot].Message
+	bodyRoot, err := block.Body.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate block body root")
+	}
+
+	blockRoots := make([][]byte, slotsPerHistoricalRoot)
+	stateRoots := make([][]byte, slotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+		stateRoots[i] = make([]byte, 32)
+	}
+	for i := spec.Slot(0); i < slot && uint64(slot-i) <= slotsPerHistoricalRoot; i++ {
+		index := uint64(i) % slotsPerHistoricalRoot
+		copy(blockRoots[index], s.blockRoots[i][:])
+		root := s.blocks[i].Message.StateRoot
+		copy(stateRoots[index], root[:])
+	}
+
+	finality := s.finalityAt(slot)
+	// The mock justifies each epoch in the next, so the two epochs before the current one
+	// are justified; bit 0 is the current epoch.
+	justificationBits := bitfield.Bitvector4{0x00}
+	if s.epochAtSlot(slot) > 1 {
+		justificationBits = bitfield.Bitvector4{0x06}
+	}
+
+	state := *s.state
+	state.Slot = uint64(slot)
+	state.LatestBlockHeader = &spec.BeaconBlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		BodyRoot:      bodyRoot,
+	}
+	state.BlockRoots = blockRoots
+	state.StateRoots = stateRoots
+	state.HistoricalRoots = [][]byte{}
+	state.ETH1Data = block.Body.ETH1Data
+	state.ETH1DataVotes = []*spec.ETH1Data{}
+	state.Slashings = make([]uint64, epochsPerSlashingsVector)
+	state.PreviousEpochAttestations = []*spec.PendingAttestation{}
+	state.CurrentEpochAttestations = []*spec.PendingAttestation{}
+	state.JustificationBits = justificationBits
+	state.PreviousJustifiedCheckpoint = finality.PreviousJustified
+	state.CurrentJustifiedCheckpoint = finality.Justified
+	state.FinalizedCheckpoint = finality.Finalized
+
+	return &state, nil
+}
@@ -0,0 +1,59 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconState(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	for i := 0; i < 25; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+
+	_, err = service.BeaconState(ctx, "26")
+	require.EqualError(t, err, "state 26 not available: not found")
+
+	state, err := service.BeaconState(ctx, "20")
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), state.Slot)
+	require.Equal(t, spec.Slot(20), state.LatestBlockHeader.Slot)
+	require.Len(t, state.Validators, 64)
+
+	// Roots are recorded for the slots before the state.
+	block, err := service.SignedBeaconBlock(ctx, "19")
+	require.NoError(t, err)
+	root, err := block.Message.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root[:], state.BlockRoots[19])
+	require.Equal(t, block.Message.StateRoot[:], state.StateRoots[19])
+	require.Equal(t, make([]byte, 32), state.BlockRoots[20])
+
+	finality, err := service.Finality(ctx, "20")
+	require.NoError(t, err)
+	require.Equal(t, finality.Finalized, state.FinalizedCheckpoint)
+	require.Equal(t, finality.Justified, state.CurrentJustifiedCheckpoint)
+
+	// The state can be encoded.
+	_, err = state.MarshalJSON()
+	require.NoError(t, err)
+}
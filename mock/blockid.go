@@ -0,0 +1,42 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ResolveBlockID resolves a block ID to the slot, epoch and root of the block.
+func (s *Service) ResolveBlockID(ctx context.Context, blockID api.BlockID) (*api.ResolvedBlockID, error) {
+	if !blockID.IsValid() {
+		return nil, errors.New("no block ID specified")
+	}
+
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForBlockID(blockID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResolvedBlockID{
+		Slot:  slot,
+		Epoch: s.epochAtSlot(slot),
+		Root:  s.blockRoots[slot],
+	}, nil
+}
@@ -0,0 +1,418 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// maxEffectiveBalance is the effective balance of all validators in the simulated chain.
+const maxEffectiveBalance = spec.Gwei(32000000000)
+
+// mockHash provides a deterministic hash for the given label and value.
+func mockHash(label string, value uint64) [32]byte {
+	data := make([]byte, len(label)+8)
+	copy(data, label)
+	binary.LittleEndian.PutUint64(data[len(label):], value)
+	return sha256.Sum256(data)
+}
+
+// initChain creates the genesis state and block of the simulated chain.
+func (s *Service) initChain(ctx context.Context, validators uint64) error {
+	epochsPerHistoricalVector, err := s.specUint64(ctx, "EPOCHS_PER_HISTORICAL_VECTOR")
+	if err != nil {
+		return err
+	}
+	genesisTime, err := s.static.GenesisTime(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis time")
+	}
+	fork, err := s.forkAtEpoch(ctx, 0)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain genesis fork")
+	}
+
+	state := &spec.BeaconState{
+		GenesisTime:           uint64(genesisTime.Unix()),
+		GenesisValidatorsRoot: genesisValidatorsRoot[:],
+		Fork:                  fork,
+		Validators:            make([]*spec.Validator, validators),
+		Balances:              make([]uint64, validators),
+		RANDAOMixes:           make([][]byte, epochsPerHistoricalVector),
+	}
+	for i := uint64(0); i < validators; i++ {
+		state.Validators[i] = s.genesisValidator(i)
+		state.Balances[i] = uint64(maxEffectiveBalance)
+	}
+	for i := range state.RANDAOMixes {
+		mix := mockHash("randao", uint64(i))
+		state.RANDAOMixes[i] = mix[:]
+	}
+	s.state = state
+
+	genesisBlock := &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:      0,
+			StateRoot: stateRoot(0),
+			Body:      emptyBody(spec.BLSSignature{}, nil, nil),
+		},
+	}
+	root, err := genesisBlock.Message.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate genesis block root")
+	}
+	s.blocks = []*spec.SignedBeaconBlock{genesisBlock}
+	s.blockRoots = []spec.Root{root}
+
+	return nil
+}
+
+// genesisValidator creates the validator with the given index.
+func (s *Service) genesisValidator(index uint64) *spec.Validator {
+	var pubKey spec.BLSPubKey
+	first := mockHash("pubkey", index)
+	second := mockHash("pubkey2", index)
+	copy(pubKey[:], first[:])
+	copy(pubKey[32:], second[:])
+	withdrawalCredentials := mockHash("withdrawal", index)
+	withdrawalCredentials[0] = 0x00
+
+	return &spec.Validator{
+		PublicKey:                  pubKey,
+		WithdrawalCredentials:      withdrawalCredentials[:],
+		EffectiveBalance:           maxEffectiveBalance,
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            0,
+		ExitEpoch:                  s.farFutureEpoch,
+		WithdrawableEpoch:          s.farFutureEpoch,
+	}
+}
+
+// specUint64 obtains an integer value from the spec.
+func (s *Service) specUint64(ctx context.Context, key string) (uint64, error) {
+	values, err := s.static.Spec(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain spec")
+	}
+	return api.SpecUint64(values, key)
+}
+
+// stateRoot provides the state root of the simulated chain at the given slot.
+func stateRoot(slot spec.Slot) spec.Root {
+	return spec.Root(mockHash("state", uint64(slot)))
+}
+
+// emptyBody provides a block body with the given values.
+func emptyBody(randaoReveal spec.BLSSignature, graffiti []byte, attestations []*spec.Attestation) *spec.BeaconBlockBody {
+	fixedGraffiti := make([]byte, 32)
+	copy(fixedGraffiti, graffiti)
+	if attestations == nil {
+		attestations = []*spec.Attestation{}
+	}
+	depositRoot := mockHash("deposit", 0)
+	blockHash := mockHash("eth1", 0)
+
+	return &spec.BeaconBlockBody{
+		RANDAOReveal: randaoReveal,
+		ETH1Data: &spec.ETH1Data{
+			DepositRoot:  spec.Root(depositRoot),
+			DepositCount: 0,
+			BlockHash:    blockHash[:],
+		},
+		Graffiti:          fixedGraffiti,
+		ProposerSlashings: []*spec.ProposerSlashing{},
+		AttesterSlashings: []*spec.AttesterSlashing{},
+		Attestations:      attestations,
+		Deposits:          []*spec.Deposit{},
+		VoluntaryExits:    []*spec.SignedVoluntaryExit{},
+	}
+}
+
+// Advance advances the simulated chain by a single slot, adding a block to the chain.
+// If a block has been submitted for the slot it is used, otherwise a block is created.
+func (s *Service) Advance(ctx context.Context) error {
+	s.chainMu.RLock()
+	slot := spec.Slot(s.state.Slot) + 1
+	s.chainMu.RUnlock()
+
+	return s.advanceTo(ctx, slot)
+}
+
+// advanceTo advances the simulated chain to the given slot.
+func (s *Service) advanceTo(ctx context.Context, slot spec.Slot) error {
+	for {
+		events, done, err := s.advance(ctx, slot)
+		if err != nil {
+			return err
+		}
+		s.sendEvents(events)
+		if done {
+			return nil
+		}
+	}
+}
+
+// advance adds the next block to the chain if its head is before the given slot,
+// returning the events generated and true if the chain has reached the slot.
+func (s *Service) advance(ctx context.Context, target spec.Slot) ([]*api.Event, bool, error) {
+	s.chainMu.Lock()
+	defer s.chainMu.Unlock()
+
+	headSlot := spec.Slot(s.state.Slot)
+	if headSlot >= target {
+		return nil, true, nil
+	}
+	slot := headSlot + 1
+
+	block, err := s.blockForSlot(ctx, slot)
+	if err != nil {
+		return nil, false, err
+	}
+	root, err := block.Message.HashTreeRoot()
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to calculate block root")
+	}
+	delete(s.pendingBlocks, slot)
+	s.blocks = append(s.blocks, block)
+	s.blockRoots = append(s.blockRoots, root)
+	s.state.Slot = uint64(slot)
+	s.log.Trace().Uint64("slot", uint64(slot)).Str("root", fmt.Sprintf("%#x", root)).Msg("Advanced chain")
+
+	epochTransition := uint64(slot)%s.slotsPerEpoch == 0
+	events := []*api.Event{
+		{
+			Topic: "block",
+			Data: &api.BlockEvent{
+				Slot:  slot,
+				Block: root,
+			},
+		},
+		{
+			Topic: "head",
+			Data: &api.HeadEvent{
+				Slot:            slot,
+				Block:           root,
+				State:           block.Message.StateRoot,
+				EpochTransition: epochTransition,
+			},
+		},
+	}
+	if epochTransition {
+		finalized := s.finality().Finalized
+		events = append(events, &api.Event{
+			Topic: "finalized_checkpoint",
+			Data: &api.FinalizedCheckpointEvent{
+				Block: finalized.Root,
+				State: s.blocks[s.epochStartSlot(finalized.Epoch)].Message.StateRoot,
+				Epoch: finalized.Epoch,
+			},
+		})
+	}
+
+	return events, slot >= target, nil
+}
+
+// blockForSlot provides the block to add to the chain at the given slot.
+// This should be called with the chain lock held.
+func (s *Service) blockForSlot(ctx context.Context, slot spec.Slot) (*spec.SignedBeaconBlock, error) {
+	parentRoot := s.blockRoots[len(s.blockRoots)-1]
+	if block, exists := s.pendingBlocks[slot]; exists && block.Message.ParentRoot == parentRoot {
+		return block, nil
+	}
+
+	proposerIndex, err := s.proposerIndex(slot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec.SignedBeaconBlock{
+		Message: &spec.BeaconBlock{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			ParentRoot:    parentRoot,
+			StateRoot:     stateRoot(slot),
+			Body:          emptyBody(spec.BLSSignature{}, []byte("mock"), s.attestationsForBlock(slot)),
+		},
+	}, nil
+}
+
+// attestationsForBlock provides the submitted attestations to include in a block at the given slot.
+func (s *Service) attestationsForBlock(slot spec.Slot) []*spec.Attestation {
+	if slot == 0 {
+		return nil
+	}
+	attestations := s.attestationsAtSlot(slot - 1)
+	if len(attestations) > 128 {
+		attestations = attestations[:128]
+	}
+	return attestations
+}
+
+// stateAtEpoch provides a copy of the chain state at the start of the given epoch,
+// for the calculation of committees and proposers.
+// This should be called with the chain lock held.
+func (s *Service) stateAtEpoch(epoch spec.Epoch) *spec.BeaconState {
+	state := *s.state
+	state.Slot = uint64(s.epochStartSlot(epoch))
+	return &state
+}
+
+// proposerIndex provides the proposer for the given slot.
+// This should be called with the chain lock held.
+func (s *Service) proposerIndex(slot spec.Slot) (spec.ValidatorIndex, error) {
+	proposerIndex, err := s.helpers.BeaconProposerIndexAtSlot(s.stateAtEpoch(s.epochAtSlot(slot)), slot)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain proposer")
+	}
+	return proposerIndex, nil
+}
+
+// committees provides the committees for the given epoch.
+// This should be called with the chain lock held.
+func (s *Service) committees(epoch spec.Epoch) ([]*api.BeaconCommittee, error) {
+	committees, err := s.helpers.BeaconCommittees(s.stateAtEpoch(epoch), epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain committees")
+	}
+	return committees, nil
+}
+
+// epochAtSlot provides the epoch of the given slot.
+func (s *Service) epochAtSlot(slot spec.Slot) spec.Epoch {
+	return spec.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// epochStartSlot provides the first slot of the given epoch.
+func (s *Service) epochStartSlot(epoch spec.Epoch) spec.Slot {
+	return spec.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// headSlot provides the slot of the head of the chain.
+// This should be called with the chain lock held.
+func (s *Service) headSlot() spec.Slot {
+	return spec.Slot(s.state.Slot)
+}
+
+// checkpoint provides the checkpoint for the given epoch, as seen from the head of the chain.
+// This should be called with the chain lock held.
+func (s *Service) checkpoint(epoch spec.Epoch) *spec.Checkpoint {
+	slot := s.epochStartSlot(epoch)
+	if slot > s.headSlot() {
+		slot = s.headSlot()
+	}
+	return &spec.Checkpoint{
+		Epoch: epoch,
+		Root:  s.blockRoots[slot],
+	}
+}
+
+// finality provides the finality of the chain at its head.
+// The simulated chain justifies the previous epoch and finalizes the epoch before that.
+// This should be called with the chain lock held.
+func (s *Service) finality() *api.Finality {
+	return s.finalityAt(s.headSlot())
+}
+
+// finalityAt provides the finality of the chain at the given slot.
+// This should be called with the chain lock held.
+func (s *Service) finalityAt(slot spec.Slot) *api.Finality {
+	epoch := s.epochAtSlot(slot)
+	justified := spec.Epoch(0)
+	if epoch > 0 {
+		justified = epoch - 1
+	}
+	previousJustified := spec.Epoch(0)
+	if epoch > 1 {
+		previousJustified = epoch - 2
+	}
+	return &api.Finality{
+		Finalized:         s.checkpoint(previousJustified),
+		Justified:         s.checkpoint(justified),
+		PreviousJustified: s.checkpoint(previousJustified),
+	}
+}
+
+// slotForStateID provides the slot of the given state ID.
+// This should be called with the chain lock held.
+func (s *Service) slotForStateID(stateID string) (spec.Slot, error) {
+	id, err := api.ParseStateID(stateID)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid state ID")
+	}
+
+	switch {
+	case id.IsHead():
+		return s.headSlot(), nil
+	case id.IsGenesis():
+		return 0, nil
+	case id.IsFinalized():
+		return s.epochStartSlot(s.finality().Finalized.Epoch), nil
+	case id.IsJustified():
+		return s.epochStartSlot(s.finality().Justified.Epoch), nil
+	}
+	if slot, isSlot := id.Slot(); isSlot {
+		if slot > s.headSlot() {
+			return 0, errors.Wrapf(client.ErrNotFound, "state %s not available", stateID)
+		}
+		return slot, nil
+	}
+	root, _ := id.Root()
+	for slot := range s.blocks {
+		if s.blocks[slot].Message.StateRoot == root {
+			return spec.Slot(slot), nil
+		}
+	}
+	return 0, errors.Wrapf(client.ErrNotFound, "state %s not available", stateID)
+}
+
+// slotForBlockID provides the slot of the given block ID.
+// This should be called with the chain lock held.
+func (s *Service) slotForBlockID(blockID string) (spec.Slot, error) {
+	id, err := api.ParseBlockID(blockID)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid block ID")
+	}
+
+	switch {
+	case id.IsHead():
+		return s.headSlot(), nil
+	case id.IsGenesis():
+		return 0, nil
+	case id.IsFinalized():
+		return s.epochStartSlot(s.finality().Finalized.Epoch), nil
+	case id.IsJustified():
+		return s.epochStartSlot(s.finality().Justified.Epoch), nil
+	}
+	if slot, isSlot := id.Slot(); isSlot {
+		if slot > s.headSlot() {
+			return 0, errors.Wrapf(client.ErrNotFound, "block %s not available", blockID)
+		}
+		return slot, nil
+	}
+	root, _ := id.Root()
+	for slot := range s.blockRoots {
+		if s.blockRoots[slot] == root {
+			return spec.Slot(slot), nil
+		}
+	}
+	return 0, errors.Wrapf(client.ErrNotFound, "block %s not available", blockID)
+}
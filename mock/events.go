@@ -0,0 +1,87 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// eventHandler is a handler for events with the given topics.
+type eventHandler struct {
+	ctx     context.Context
+	topics  map[string]bool
+	handler client.EventHandlerFunc
+}
+
+// Events feeds requested events with the given topics to the supplied handler.
+// Events are sent to the handler as the simulated chain advances and when items are submitted,
+// until the context is done.
+func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	if len(topics) == 0 {
+		return errors.New("no topics supplied")
+	}
+	if handler == nil {
+		return errors.New("no handler supplied")
+	}
+
+	// Ensure we support the requested topic(s).
+	topicMap := make(map[string]bool, len(topics))
+	for i := range topics {
+		if _, exists := api.SupportedEventTopics[topics[i]]; !exists {
+			return fmt.Errorf("unsupported event topic %s", topics[i])
+		}
+		topicMap[topics[i]] = true
+	}
+
+	s.eventHandlersMu.Lock()
+	s.eventHandlers = append(s.eventHandlers, &eventHandler{
+		ctx:     ctx,
+		topics:  topicMap,
+		handler: handler,
+	})
+	s.eventHandlersMu.Unlock()
+
+	return nil
+}
+
+// sendEvents sends events to the handlers that want them.
+// Handlers are called synchronously, so events are seen in the order that they happen.
+func (s *Service) sendEvents(events []*api.Event) {
+	if len(events) == 0 {
+		return
+	}
+
+	s.eventHandlersMu.Lock()
+	handlers := make([]*eventHandler, 0, len(s.eventHandlers))
+	for _, handler := range s.eventHandlers {
+		if handler.ctx.Err() == nil {
+			handlers = append(handlers, handler)
+		}
+	}
+	s.eventHandlers = handlers
+	s.eventHandlersMu.Unlock()
+
+	for _, event := range events {
+		for _, handler := range handlers {
+			if handler.topics[event.Topic] {
+				handler.handler(event)
+			}
+		}
+	}
+}
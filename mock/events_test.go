@@ -0,0 +1,56 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	require.EqualError(t, service.Events(ctx, nil, func(*api.Event) {}), "no topics supplied")
+	require.EqualError(t, service.Events(ctx, []string{"bad"}, func(*api.Event) {}), "unsupported event topic bad")
+
+	var mu sync.Mutex
+	topics := make(map[string]int)
+	handlerCtx, handlerCancel := context.WithCancel(ctx)
+	require.NoError(t, service.Events(handlerCtx, []string{"head", "finalized_checkpoint"}, func(event *api.Event) {
+		mu.Lock()
+		topics[event.Topic]++
+		mu.Unlock()
+	}))
+
+	for i := 0; i < 16; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+	mu.Lock()
+	require.Equal(t, map[string]int{"head": 16, "finalized_checkpoint": 2}, topics)
+	mu.Unlock()
+
+	// No further events once the handler's context is done.
+	handlerCancel()
+	require.NoError(t, service.Advance(ctx))
+	mu.Lock()
+	require.Equal(t, 16, topics["head"])
+	mu.Unlock()
+}
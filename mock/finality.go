@@ -0,0 +1,33 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// Finality provides the finality given a state ID.
+func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.finalityAt(slot), nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// Fork fetches fork information for the given state.
+func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error) {
+	s.chainMu.RLock()
+	slot, err := s.slotForStateID(stateID)
+	s.chainMu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.forkAtEpoch(ctx, s.epochAtSlot(slot))
+}
+
+// forkAtEpoch provides the fork in force at the given epoch.
+func (s *Service) forkAtEpoch(ctx context.Context, epoch spec.Epoch) (*spec.Fork, error) {
+	forkSchedule, err := s.static.ForkSchedule(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fork schedule")
+	}
+	if len(forkSchedule) == 0 {
+		return nil, errors.New("no forks in schedule")
+	}
+
+	fork := forkSchedule[0]
+	for i := range forkSchedule {
+		if forkSchedule[i].Epoch <= epoch {
+			fork = forkSchedule[i]
+		}
+	}
+	return fork, nil
+}
@@ -17,19 +17,9 @@ import (
 	"context"
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
-	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // Genesis provides the genesis information of the chain.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
-	return &api.Genesis{
-		GenesisTime: s.genesisTime,
-		GenesisValidatorsRoot: spec.Root([32]byte{
-			0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
-			0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
-		}),
-		GenesisForkVersion: spec.Version([4]byte{
-			0x01, 0x02, 0x03, 0x04,
-		}),
-	}, nil
+	return s.static.Genesis(ctx)
 }
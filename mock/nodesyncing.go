@@ -21,8 +21,13 @@ import (
 
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Service) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	s.chainMu.RLock()
+	headSlot := s.headSlot()
+	s.chainMu.RUnlock()
+
 	return &api.SyncState{
-		HeadSlot:     s.HeadSlot,
+		HeadSlot:     headSlot,
 		SyncDistance: s.SyncDistance,
+		IsSyncing:    s.SyncDistance > 0,
 	}, nil
 }
@@ -0,0 +1,54 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeVersion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		params   []mock.Parameter
+		expected string
+	}{
+		{
+			name:     "Default",
+			expected: "mock",
+		},
+		{
+			name: "Set",
+			params: []mock.Parameter{
+				mock.WithNodeVersion("Lighthouse/v1.5.0"),
+			},
+			expected: "Lighthouse/v1.5.0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := mock.New(ctx, append(test.params, mock.WithManualAdvance(true))...)
+			require.NoError(t, err)
+			version, err := service.NodeVersion(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, version)
+		})
+	}
+}
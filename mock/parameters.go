@@ -16,12 +16,21 @@ package mock
 import (
 	"time"
 
+	"github.com/attestantio/go-eth2-client/chaintime"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
 )
 
 type parameters struct {
-	logLevel zerolog.Level
-	timeout  time.Duration
+	logLevel      zerolog.Level
+	logger        zerolog.Logger
+	timeout       time.Duration
+	genesisTime   time.Time
+	validators    uint64
+	manualAdvance bool
+	nodeVersion   string
+	clock         chaintime.Clock
 }
 
 // Parameter is the interface for service parameters.
@@ -42,6 +51,15 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
 // WithTimeout sets the maximum duration for all requests to the endpoint.
 func WithTimeout(timeout time.Duration) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -49,11 +67,53 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithGenesisTime sets the genesis time of the simulated chain.
+// If the genesis time is in the past the chain starts at the current slot.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithValidators sets the number of validators in the simulated chain.
+func WithValidators(validators uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validators = validators
+	})
+}
+
+// WithManualAdvance stops the simulated chain advancing with the wall clock,
+// so that it only advances when Advance() is called.
+// When following the wall clock the head of the chain is the last slot to have ended.
+func WithManualAdvance(manualAdvance bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.manualAdvance = manualAdvance
+	})
+}
+
+// WithNodeVersion sets the version string reported by the node.
+func WithNodeVersion(nodeVersion string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.nodeVersion = nodeVersion
+	})
+}
+
+// WithClock sets the clock that the chain follows when it advances with the wall clock.
+// If not supplied the system clock is used.
+func WithClock(clock chaintime.Clock) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.clock = clock
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		timeout:  2 * time.Second,
+		logLevel:    zerolog.GlobalLevel(),
+		logger:      zerologger.Logger,
+		timeout:     2 * time.Second,
+		validators:  64,
+		nodeVersion: "mock",
 	}
 	for _, p := range params {
 		if params != nil {
@@ -61,5 +121,19 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		}
 	}
 
+	if parameters.validators == 0 {
+		return nil, errors.New("no validators specified")
+	}
+	if parameters.nodeVersion == "" {
+		return nil, errors.New("no node version specified")
+	}
+	if parameters.genesisTime.IsZero() {
+		if parameters.clock != nil {
+			parameters.genesisTime = parameters.clock.Now()
+		} else {
+			parameters.genesisTime = time.Now()
+		}
+	}
+
 	return &parameters, nil
 }
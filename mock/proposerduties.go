@@ -0,0 +1,57 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ProposerDuties obtains proposer duties for the given epoch.
+// If validatorIndices is empty then duties are returned for all validators.
+func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	if epoch > s.epochAtSlot(s.headSlot())+1 {
+		return nil, errors.New("epoch too far in the future")
+	}
+	wanted := s.validatorIndexMap(validatorIndices)
+
+	duties := make([]*api.ProposerDuty, 0)
+	startSlot := s.epochStartSlot(epoch)
+	for slot := startSlot; slot < startSlot+spec.Slot(s.slotsPerEpoch); slot++ {
+		if slot == 0 {
+			// There is no proposer for the genesis block.
+			continue
+		}
+		validatorIndex, err := s.proposerIndex(slot)
+		if err != nil {
+			return nil, err
+		}
+		if !wanted(validatorIndex) {
+			continue
+		}
+		duties = append(duties, &api.ProposerDuty{
+			PubKey:         s.state.Validators[validatorIndex].PublicKey,
+			Slot:           slot,
+			ValidatorIndex: validatorIndex,
+		})
+	}
+
+	return duties, nil
+}
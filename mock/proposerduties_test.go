@@ -0,0 +1,57 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposerDuties(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	// Genesis has no proposer.
+	duties, err := service.ProposerDuties(ctx, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, duties, 7)
+
+	duties, err = service.ProposerDuties(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Len(t, duties, 8)
+
+	// Blocks are proposed by the validators with the duties.
+	for i := 0; i < 16; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+	for _, duty := range duties {
+		block, err := service.SignedBeaconBlock(ctx, fmt.Sprintf("%d", duty.Slot))
+		require.NoError(t, err)
+		require.Equal(t, duty.ValidatorIndex, block.Message.ProposerIndex)
+	}
+
+	// Filter by validator.
+	filtered, err := service.ProposerDuties(ctx, 1, []spec.ValidatorIndex{duties[0].ValidatorIndex})
+	require.NoError(t, err)
+	require.NotEmpty(t, filtered)
+	for _, duty := range filtered {
+		require.Equal(t, duties[0].ValidatorIndex, duty.ValidatorIndex)
+	}
+}
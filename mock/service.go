@@ -15,40 +15,74 @@ package mock
 
 import (
 	"context"
+	"sync"
 	"time"
 
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/chaintime"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/attestantio/go-eth2-client/static"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
-	zerologger "github.com/rs/zerolog/log"
 )
 
-// Service is a mock Ethereum 2 client service, providing data locally.
+// genesisValidatorsRoot is the genesis validators root of the simulated chain.
+var genesisValidatorsRoot = spec.Root([32]byte{
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f,
+	0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+})
+
+// Service is a mock Ethereum 2 client service, providing data locally from a simulated chain.
+// The chain uses the minimal preset, has a block in every slot and finalizes every epoch.
 type Service struct {
-	timeout time.Duration
+	log zerolog.Logger
+
+	// Hold the initialising context for background goroutines; it is cancelled when the service is closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Background goroutines, waited for by Wait().
+	wg sync.WaitGroup
 
-	genesisTime time.Time
+	timeout time.Duration
 
-	// Various information from the node that does not change during the
-	// lifetime of a beacon node.
-	// genesis         *api.Genesis
-	// spec            map[string]interface{}
-	// depositContract *api.DepositContract
-	// forkSchedule    []*spec.Fork
-	nodeVersion string
+	// static provides the chain configuration.
+	static *static.Service
+	// helpers provides committees and proposers.
+	helpers *helpers.Service
+	// chainTime provides the wall clock for the chain, if it advances with the wall clock.
+	chainTime *chaintime.Service
+
+	nodeVersion    string
+	slotsPerEpoch  uint64
+	farFutureEpoch spec.Epoch
+
+	// The simulated chain.
+	chainMu sync.RWMutex
+	// state is the state of the chain, with its slot the head slot.
+	state *spec.BeaconState
+	// blocks are the blocks of the chain, indexed by slot.
+	blocks     []*spec.SignedBeaconBlock
+	blockRoots []spec.Root
+	// pendingBlocks are blocks submitted for slots beyond the head.
+	pendingBlocks map[spec.Slot]*spec.SignedBeaconBlock
+
+	// Submissions, recorded for assertions.
+	submittedMu                           sync.RWMutex
+	submittedAttestations                 []*spec.Attestation
+	submittedAggregateAttestations        []*spec.SignedAggregateAndProof
+	submittedBeaconBlocks                 []*spec.SignedBeaconBlock
+	submittedBeaconCommitteeSubscriptions []*api.BeaconCommitteeSubscription
+	submittedVoluntaryExits               []*spec.SignedVoluntaryExit
 
 	// Event handlers.
-	// beaconChainHeadUpdatedMutex    sync.RWMutex
-	// beaconChainHeadUpdatedHandlers []client.BeaconChainHeadUpdatedHandler
+	eventHandlersMu sync.RWMutex
+	eventHandlers   []*eventHandler
 
 	// Values that can be altered if required.
-	HeadSlot     spec.Slot
 	SyncDistance spec.Slot
 }
 
-// log is a service-wide logger.
-var log zerolog.Logger
-
 // New creates a new Ethereum 2 client service, mocking connections
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	parameters, err := parseAndCheckParameters(params...)
@@ -57,52 +91,114 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "standardv1").Logger()
+	log := parameters.logger.With().Str("service", "client").Str("impl", "mock").Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
+	staticService, err := static.New(ctx,
+		static.WithLogger(parameters.logger),
+		static.WithLogLevel(parameters.logLevel),
+		static.WithNetwork("minimal"),
+		static.WithGenesisTime(parameters.genesisTime),
+		static.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create static service")
+	}
+	helpersService, err := helpers.New(ctx,
+		helpers.WithLogger(parameters.logger),
+		helpers.WithLogLevel(parameters.logLevel),
+		helpers.WithSpecProvider(staticService),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create helpers service")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		genesisTime: time.Now(),
-		timeout:     parameters.timeout,
-		nodeVersion: "mock",
+		log:           log,
+		ctx:           ctx,
+		cancel:        cancel,
+		timeout:       parameters.timeout,
+		static:        staticService,
+		helpers:       helpersService,
+		nodeVersion:   parameters.nodeVersion,
+		pendingBlocks: make(map[spec.Slot]*spec.SignedBeaconBlock),
+		SyncDistance:  0,
+	}
+	if s.slotsPerEpoch, err = staticService.SlotsPerEpoch(ctx); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	if s.farFutureEpoch, err = staticService.FarFutureEpoch(ctx); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to obtain far future epoch")
+	}
 
-		HeadSlot:     12345,
-		SyncDistance: 0,
+	if err := s.initChain(ctx, parameters.validators); err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to create chain")
 	}
 
-	// Fetch static values to confirm the connection is good.
-	if err := s.fetchStaticValues(ctx); err != nil {
-		return nil, errors.Wrap(err, "failed to confirm node connection")
+	if !parameters.manualAdvance {
+		chainTimeParams := []chaintime.Parameter{
+			chaintime.WithLogger(parameters.logger),
+			chaintime.WithLogLevel(parameters.logLevel),
+			chaintime.WithGenesisTimeProvider(staticService),
+			chaintime.WithSlotDurationProvider(staticService),
+			chaintime.WithSlotsPerEpochProvider(staticService),
+		}
+		if parameters.clock != nil {
+			chainTimeParams = append(chainTimeParams, chaintime.WithClock(parameters.clock))
+		}
+		s.chainTime, err = chaintime.New(ctx, chainTimeParams...)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "failed to create chain time service")
+		}
+		// Catch up with the wall clock, then follow it.
+		if err := s.advanceTo(ctx, completedSlot(s.chainTime.CurrentSlot())); err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "failed to advance chain")
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.followClock(ctx)
+		}()
 	}
 
-	// Close the service on context done.
-	go func(s *Service) {
+	// Close the service when it is closed explicitly or on context done.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
 		<-ctx.Done()
-		log.Trace().Msg("Context done; closing connection")
+		log.Trace().Msg("Context done; closing service")
 		s.close()
-	}(s)
+	}()
 
 	return s, nil
 }
 
-// fetchStaticValues fetches values that never change.
-// This caches the values, avoiding future API calls.
-func (s *Service) fetchStaticValues(ctx context.Context) error {
-	if _, err := s.Genesis(ctx); err != nil {
-		return errors.Wrap(err, "failed to fetch genesis")
+// followClock advances the chain at the start of each slot.
+// The block for a slot is added when the slot ends, so that during a slot blocks can be
+// proposed and submitted for it.
+func (s *Service) followClock(ctx context.Context) {
+	for slot := range s.chainTime.SlotStartTicker(ctx) {
+		if err := s.advanceTo(ctx, completedSlot(slot)); err != nil {
+			s.log.Error().Uint64("slot", uint64(slot)).Err(err).Msg("Failed to advance chain")
+		}
+	}
+}
+
+// completedSlot provides the last slot to have ended before the given slot.
+// The genesis slot is treated as complete, as its block is part of the initial chain.
+func completedSlot(slot spec.Slot) spec.Slot {
+	if slot == 0 {
+		return 0
 	}
-	//	if _, err := s.Spec(ctx); err != nil {
-	//		return errors.Wrap(err, "failed to fetch spec")
-	//	}
-	//	if _, err := s.DepositContract(ctx); err != nil {
-	//		return errors.Wrap(err, "failed to fetch deposit contract")
-	//	}
-	//	if _, err := s.ForkSchedule(ctx); err != nil {
-	//		return errors.Wrap(err, "failed to fetch fork schedule")
-	//	}
-
-	return nil
+	return slot - 1
 }
 
 // Name provides the name of the service.
@@ -115,6 +211,32 @@ func (s *Service) Address() string {
 	return "mock:mock"
 }
 
+// Close closes the service, stopping the chain following the wall clock and dropping event handlers,
+// then waits for its background goroutines to exit.  It returns an error if the context is done first.
+func (s *Service) Close(ctx context.Context) error {
+	s.cancel()
+	return s.Wait(ctx)
+}
+
+// Wait waits for the service's background goroutines to exit, which happens once the service is closed or the
+// context used to create it is done.  It returns an error if the context is done first.
+func (s *Service) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "background goroutines did not exit")
+	}
+}
+
 // close closes the service, freeing up resources.
 func (s *Service) close() {
+	s.eventHandlersMu.Lock()
+	s.eventHandlers = nil
+	s.eventHandlersMu.Unlock()
 }
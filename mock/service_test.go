@@ -0,0 +1,230 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+	genesisTime := time.Unix(1600000000, 0)
+
+	tests := []struct {
+		name     string
+		params   []mock.Parameter
+		err      string
+		headSlot uint64
+	}{
+		{
+			name: "ValidatorsZero",
+			params: []mock.Parameter{
+				mock.WithValidators(0),
+			},
+			err: "problem with parameters: no validators specified",
+		},
+		{
+			name: "NodeVersionMissing",
+			params: []mock.Parameter{
+				mock.WithNodeVersion(""),
+			},
+			err: "problem with parameters: no node version specified",
+		},
+		{
+			name: "Good",
+			params: []mock.Parameter{
+				mock.WithLogLevel(zerolog.Disabled),
+			},
+		},
+		{
+			name: "GenesisInPast",
+			params: []mock.Parameter{
+				mock.WithLogLevel(zerolog.Disabled),
+				mock.WithGenesisTime(genesisTime),
+				mock.WithClock(newTestClock(genesisTime.Add(61 * time.Second))),
+			},
+			// Slot 10 is in progress, so the head is the last slot to have ended.
+			headSlot: 9,
+		},
+		{
+			name: "GenesisInPastManual",
+			params: []mock.Parameter{
+				mock.WithLogLevel(zerolog.Disabled),
+				mock.WithGenesisTime(genesisTime),
+				mock.WithClock(newTestClock(genesisTime.Add(61 * time.Second))),
+				mock.WithManualAdvance(true),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := mock.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			syncState, err := service.NodeSyncing(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.headSlot, uint64(syncState.HeadSlot))
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	service, err := mock.New(context.Background(), mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	require.Implements(t, (*client.AggregateAttestationProvider)(nil), service)
+	require.Implements(t, (*client.AggregateAttestationsSubmitter)(nil), service)
+	require.Implements(t, (*client.AttestationDataProvider)(nil), service)
+	require.Implements(t, (*client.AttestationPoolProvider)(nil), service)
+	require.Implements(t, (*client.AttestationsSubmitter)(nil), service)
+	require.Implements(t, (*client.AttesterDutiesProvider)(nil), service)
+	require.Implements(t, (*client.BeaconBlockHeadersProvider)(nil), service)
+	require.Implements(t, (*client.BeaconBlockProposalProvider)(nil), service)
+	require.Implements(t, (*client.BeaconBlockSubmitter)(nil), service)
+	require.Implements(t, (*client.BeaconCommitteeSubscriptionsSubmitter)(nil), service)
+	require.Implements(t, (*client.BeaconCommitteesProvider)(nil), service)
+	require.Implements(t, (*client.BeaconStateProvider)(nil), service)
+	require.Implements(t, (*client.BlockIDResolver)(nil), service)
+	require.Implements(t, (*client.ChainSpecProvider)(nil), service)
+	require.Implements(t, (*client.Closer)(nil), service)
+	require.Implements(t, (*client.DepositContractProvider)(nil), service)
+	require.Implements(t, (*client.DomainProvider)(nil), service)
+	require.Implements(t, (*client.EventsProvider)(nil), service)
+	require.Implements(t, (*client.FinalityProvider)(nil), service)
+	require.Implements(t, (*client.ForkProvider)(nil), service)
+	require.Implements(t, (*client.ForkScheduleProvider)(nil), service)
+	require.Implements(t, (*client.GenesisProvider)(nil), service)
+	require.Implements(t, (*client.GenesisTimeProvider)(nil), service)
+	require.Implements(t, (*client.NodeSyncingProvider)(nil), service)
+	require.Implements(t, (*client.NodeVersionProvider)(nil), service)
+	require.Implements(t, (*client.ProposerDutiesProvider)(nil), service)
+	require.Implements(t, (*client.SignedBeaconBlockProvider)(nil), service)
+	require.Implements(t, (*client.SlotDurationProvider)(nil), service)
+	require.Implements(t, (*client.SlotsPerEpochProvider)(nil), service)
+	require.Implements(t, (*client.SpecProvider)(nil), service)
+	require.Implements(t, (*client.StateIDResolver)(nil), service)
+	require.Implements(t, (*client.ValidatorBalancesProvider)(nil), service)
+	require.Implements(t, (*client.ValidatorsProvider)(nil), service)
+	require.Implements(t, (*client.VoluntaryExitSubmitter)(nil), service)
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	// The service follows the clock, so has a background goroutine running.
+	clock := newTestClock(time.Unix(1600000000, 0))
+	service, err := mock.New(ctx, mock.WithClock(clock))
+	require.NoError(t, err)
+	clock.waitForWaiter(t)
+
+	events := 0
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(*api.Event) { events++ }))
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, service.Close(closeCtx))
+	require.NoError(t, service.Wait(closeCtx))
+
+	// Event handlers are dropped once the service is closed.
+	require.NoError(t, service.Advance(ctx))
+	require.Equal(t, 0, events)
+}
+
+func TestAdvance(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	genesis, err := service.BeaconBlockHeader(ctx, "genesis")
+	require.NoError(t, err)
+	for i := 1; i <= 20; i++ {
+		require.NoError(t, service.Advance(ctx))
+		header, err := service.BeaconBlockHeader(ctx, "head")
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), uint64(header.Header.Message.Slot))
+		parent, err := service.BeaconBlockHeader(ctx, fmt.Sprintf("%#x", header.Header.Message.ParentRoot))
+		require.NoError(t, err)
+		require.Equal(t, uint64(i-1), uint64(parent.Header.Message.Slot))
+	}
+
+	// Same parameters provide the same chain.
+	service2, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	genesis2, err := service2.BeaconBlockHeader(ctx, "genesis")
+	require.NoError(t, err)
+	require.Equal(t, genesis.Root, genesis2.Root)
+	for i := 1; i <= 20; i++ {
+		require.NoError(t, service2.Advance(ctx))
+	}
+	head, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	head2, err := service2.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, head.Root, head2.Root)
+}
+
+// lockedBuffer is a buffer that can be written to by multiple goroutines.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestLogger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	defer zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	// The logger is passed on to the services that the mock creates.
+	var output lockedBuffer
+	service, err := mock.New(ctx,
+		mock.WithLogger(zerolog.New(&output).With().Str("node", "mock1").Logger()),
+		mock.WithLogLevel(zerolog.TraceLevel),
+	)
+	require.NoError(t, err)
+	_, err = service.AttesterDuties(ctx, 0, []spec.ValidatorIndex{0})
+	require.NoError(t, err)
+
+	require.Contains(t, output.String(), `"node":"mock1","service":"chaintime"`)
+	require.Contains(t, output.String(), `"node":"mock1","service":"helpers"`)
+}
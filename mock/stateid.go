@@ -0,0 +1,61 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SlotFromStateID parses the state ID and returns the relevant slot.
+func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (spec.Slot, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	return s.slotForStateID(stateID)
+}
+
+// EpochFromStateID parses the state ID and returns the relevant epoch.
+func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (spec.Epoch, error) {
+	slot, err := s.SlotFromStateID(ctx, stateID)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.epochAtSlot(slot), nil
+}
+
+// ResolveStateID resolves a state ID to the slot, epoch and root of the state.
+func (s *Service) ResolveStateID(ctx context.Context, stateID api.StateID) (*api.ResolvedStateID, error) {
+	if !stateID.IsValid() {
+		return nil, errors.New("no state ID specified")
+	}
+
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ResolvedStateID{
+		Slot:  slot,
+		Epoch: s.epochAtSlot(slot),
+		Root:  s.blocks[slot].Message.StateRoot,
+	}, nil
+}
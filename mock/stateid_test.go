@@ -0,0 +1,99 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveStateID(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	for i := 0; i < 25; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+	block, err := service.SignedBeaconBlock(ctx, "10")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		stateID api.StateID
+		slot    spec.Slot
+		err     string
+	}{
+		{
+			name:    "Head",
+			stateID: api.StateIDHead(),
+			slot:    25,
+		},
+		{
+			name:    "Genesis",
+			stateID: api.StateIDGenesis(),
+			slot:    0,
+		},
+		{
+			name:    "Justified",
+			stateID: api.StateIDJustified(),
+			slot:    16,
+		},
+		{
+			name:    "Finalized",
+			stateID: api.StateIDFinalized(),
+			slot:    8,
+		},
+		{
+			name:    "Slot",
+			stateID: api.StateIDSlot(12),
+			slot:    12,
+		},
+		{
+			name:    "Root",
+			stateID: api.StateIDRoot(block.Message.StateRoot),
+			slot:    10,
+		},
+		{
+			name:    "Future",
+			stateID: api.StateIDSlot(26),
+			err:     "state 26 not available: not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved, err := service.ResolveStateID(ctx, test.stateID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.slot, resolved.Slot)
+			require.Equal(t, spec.Epoch(uint64(test.slot)/8), resolved.Epoch)
+			block, err := service.SignedBeaconBlock(ctx, fmt.Sprintf("%d", test.slot))
+			require.NoError(t, err)
+			require.Equal(t, block.Message.StateRoot, resolved.Root)
+
+			resolvedBlock, err := service.ResolveBlockID(ctx, api.BlockIDSlot(test.slot))
+			require.NoError(t, err)
+			require.Equal(t, test.slot, resolvedBlock.Slot)
+		})
+	}
+}
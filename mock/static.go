@@ -0,0 +1,129 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Values that do not change for the simulated chain are provided by the static service.
+
+// GenesisTime provides the genesis time of the chain.
+func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	return s.static.GenesisTime(ctx)
+}
+
+// GenesisValidatorsRoot provides the genesis validators root of the chain.
+func (s *Service) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	return s.static.GenesisValidatorsRoot(ctx)
+}
+
+// Spec provides the spec information of the chain.
+func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
+	return s.static.Spec(ctx)
+}
+
+// ChainSpec provides the typed spec information of the chain.
+func (s *Service) ChainSpec(ctx context.Context) (*api.ChainSpec, error) {
+	return s.static.ChainSpec(ctx)
+}
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
+	return s.static.ForkSchedule(ctx)
+}
+
+// DepositContract provides details of the execution deposit contract for the chain.
+func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, error) {
+	return s.static.DepositContract(ctx)
+}
+
+// DepositContractAddress provides the address of the deposit contract.
+func (s *Service) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	return s.static.DepositContractAddress(ctx)
+}
+
+// DepositContractChainID provides the chain ID of the deposit contract.
+func (s *Service) DepositContractChainID(ctx context.Context) (uint64, error) {
+	return s.static.DepositContractChainID(ctx)
+}
+
+// DepositContractNetworkID provides the network ID of the deposit contract.
+func (s *Service) DepositContractNetworkID(ctx context.Context) (uint64, error) {
+	return s.static.DepositContractNetworkID(ctx)
+}
+
+// SlotDuration provides the duration of a slot of the chain.
+func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	return s.static.SlotDuration(ctx)
+}
+
+// SlotsPerEpoch provides the number of slots per epoch of the chain.
+func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	return s.static.SlotsPerEpoch(ctx)
+}
+
+// FarFutureEpoch provides the far future epoch of the chain.
+func (s *Service) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	return s.static.FarFutureEpoch(ctx)
+}
+
+// TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
+func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	return s.static.TargetAggregatorsPerCommittee(ctx)
+}
+
+// Domain provides a domain for a given domain type at a given epoch.
+func (s *Service) Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
+	return s.static.Domain(ctx, domainType, epoch)
+}
+
+// BeaconAttesterDomain provides the beacon attester domain.
+func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.BeaconAttesterDomain(ctx)
+}
+
+// BeaconProposerDomain provides the beacon proposer domain.
+func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.BeaconProposerDomain(ctx)
+}
+
+// RANDAODomain provides the RANDAO domain.
+func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.RANDAODomain(ctx)
+}
+
+// DepositDomain provides the deposit domain.
+func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.DepositDomain(ctx)
+}
+
+// VoluntaryExitDomain provides the voluntary exit domain.
+func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.VoluntaryExitDomain(ctx)
+}
+
+// SelectionProofDomain provides the selection proof domain.
+func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.SelectionProofDomain(ctx)
+}
+
+// AggregateAndProofDomain provides the aggregate and proof domain.
+func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	return s.static.AggregateAndProofDomain(ctx)
+}
@@ -0,0 +1,43 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitAggregateAttestations submits aggregate attestations.
+// The aggregates are recorded and added to the attestation pool.
+func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*spec.SignedAggregateAndProof) error {
+	if len(aggregateAndProofs) == 0 {
+		return errors.New("no aggregate and proofs supplied")
+	}
+	for i := range aggregateAndProofs {
+		if aggregateAndProofs[i] == nil ||
+			aggregateAndProofs[i].Message == nil ||
+			aggregateAndProofs[i].Message.Aggregate == nil ||
+			aggregateAndProofs[i].Message.Aggregate.Data == nil {
+			return errors.New("aggregate and proof missing aggregate")
+		}
+	}
+
+	s.submittedMu.Lock()
+	s.submittedAggregateAttestations = append(s.submittedAggregateAttestations, aggregateAndProofs...)
+	s.submittedMu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitAttestations submits attestations.
+// The attestations are recorded, added to the attestation pool and sent as events.
+func (s *Service) SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error {
+	if len(attestations) == 0 {
+		return errors.New("no attestations supplied")
+	}
+	for i := range attestations {
+		if attestations[i] == nil || attestations[i].Data == nil {
+			return errors.New("attestation missing data")
+		}
+	}
+
+	s.submittedMu.Lock()
+	s.submittedAttestations = append(s.submittedAttestations, attestations...)
+	s.submittedMu.Unlock()
+
+	events := make([]*api.Event, len(attestations))
+	for i := range attestations {
+		events[i] = &api.Event{
+			Topic: "attestation",
+			Data:  attestations[i],
+		}
+	}
+	s.sendEvents(events)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitBeaconBlock submits a beacon block.
+// The block is recorded and, if it is for a slot after the head of the chain,
+// it becomes the block for that slot when the chain advances.
+func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) error {
+	if block == nil || block.Message == nil || block.Message.Body == nil {
+		return errors.New("no block supplied")
+	}
+
+	s.submittedMu.Lock()
+	s.submittedBeaconBlocks = append(s.submittedBeaconBlocks, block)
+	s.submittedMu.Unlock()
+
+	s.chainMu.Lock()
+	if block.Message.Slot > s.headSlot() {
+		s.pendingBlocks[block.Message.Slot] = block
+	}
+	s.chainMu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	_, err = service.BeaconBlockProposal(ctx, 0, spec.BLSSignature{}, nil)
+	require.EqualError(t, err, "slot not after head of chain")
+
+	proposal, err := service.BeaconBlockProposal(ctx, 1, spec.BLSSignature{0x01}, []byte("test graffiti"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("test graffiti"), proposal.Body.Graffiti[:13])
+	block := &spec.SignedBeaconBlock{
+		Message:   proposal,
+		Signature: spec.BLSSignature{0x02},
+	}
+	require.NoError(t, service.SubmitBeaconBlock(ctx, block))
+	require.Equal(t, []*spec.SignedBeaconBlock{block}, service.SubmittedBeaconBlocks())
+
+	// The submitted block becomes the head when the chain advances.
+	require.NoError(t, service.Advance(ctx))
+	head, err := service.SignedBeaconBlock(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, block, head)
+}
+
+func TestSubmitBeaconBlockWallClock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Slots are 6 seconds; the chain is half way through slot 10.
+	genesisTime := time.Unix(1600000000, 0)
+	clock := newTestClock(genesisTime.Add(63 * time.Second))
+	service, err := mock.New(ctx,
+		mock.WithGenesisTime(genesisTime),
+		mock.WithClock(clock),
+	)
+	require.NoError(t, err)
+	syncState, err := service.NodeSyncing(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(9), syncState.HeadSlot)
+
+	proposal, err := service.BeaconBlockProposal(ctx, 10, spec.BLSSignature{0x01}, []byte("test graffiti"))
+	require.NoError(t, err)
+	block := &spec.SignedBeaconBlock{
+		Message:   proposal,
+		Signature: spec.BLSSignature{0x02},
+	}
+	require.NoError(t, service.SubmitBeaconBlock(ctx, block))
+
+	// The submitted block becomes the head when the slot ends.
+	clock.waitForWaiter(t)
+	clock.Advance(3 * time.Second)
+	require.Eventually(t, func() bool {
+		syncState, err := service.NodeSyncing(ctx)
+		require.NoError(t, err)
+		return syncState.HeadSlot == 10
+	}, time.Second, time.Millisecond)
+	head, err := service.SignedBeaconBlock(ctx, "10")
+	require.NoError(t, err)
+	require.Equal(t, block, head)
+}
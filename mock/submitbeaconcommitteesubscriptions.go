@@ -0,0 +1,35 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+// The subscriptions are recorded.
+func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
+	if len(subscriptions) == 0 {
+		return errors.New("no subscriptions supplied")
+	}
+
+	s.submittedMu.Lock()
+	s.submittedBeaconCommitteeSubscriptions = append(s.submittedBeaconCommitteeSubscriptions, subscriptions...)
+	s.submittedMu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// SubmittedAttestations provides the attestations submitted to the service, in order of submission.
+func (s *Service) SubmittedAttestations() []*spec.Attestation {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*spec.Attestation, len(s.submittedAttestations))
+	copy(res, s.submittedAttestations)
+	return res
+}
+
+// SubmittedAggregateAttestations provides the aggregate attestations submitted to the service, in order of submission.
+func (s *Service) SubmittedAggregateAttestations() []*spec.SignedAggregateAndProof {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*spec.SignedAggregateAndProof, len(s.submittedAggregateAttestations))
+	copy(res, s.submittedAggregateAttestations)
+	return res
+}
+
+// SubmittedBeaconBlocks provides the beacon blocks submitted to the service, in order of submission.
+func (s *Service) SubmittedBeaconBlocks() []*spec.SignedBeaconBlock {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*spec.SignedBeaconBlock, len(s.submittedBeaconBlocks))
+	copy(res, s.submittedBeaconBlocks)
+	return res
+}
+
+// SubmittedBeaconCommitteeSubscriptions provides the beacon committee subscriptions submitted to the service,
+// in order of submission.
+func (s *Service) SubmittedBeaconCommitteeSubscriptions() []*api.BeaconCommitteeSubscription {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*api.BeaconCommitteeSubscription, len(s.submittedBeaconCommitteeSubscriptions))
+	copy(res, s.submittedBeaconCommitteeSubscriptions)
+	return res
+}
+
+// SubmittedVoluntaryExits provides the voluntary exits submitted to the service, in order of submission.
+func (s *Service) SubmittedVoluntaryExits() []*spec.SignedVoluntaryExit {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*spec.SignedVoluntaryExit, len(s.submittedVoluntaryExits))
+	copy(res, s.submittedVoluntaryExits)
+	return res
+}
+
+// attestationsAtSlot provides the submitted attestations for the given slot.
+func (s *Service) attestationsAtSlot(slot spec.Slot) []*spec.Attestation {
+	s.submittedMu.RLock()
+	defer s.submittedMu.RUnlock()
+
+	res := make([]*spec.Attestation, 0)
+	for _, attestation := range s.submittedAttestations {
+		if attestation.Data.Slot == slot {
+			res = append(res, attestation)
+		}
+	}
+	for _, aggregate := range s.submittedAggregateAttestations {
+		if aggregate.Message.Aggregate.Data.Slot == slot {
+			res = append(res, aggregate.Message.Aggregate)
+		}
+	}
+	return res
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// SubmitVoluntaryExit submits a voluntary exit.
+// The exit is recorded and sent as an event, but does not change the state of the validator.
+// Exits for validators that are not in the chain are rejected with client.ErrBadRequest.
+func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	if voluntaryExit == nil || voluntaryExit.Message == nil {
+		return errors.New("no voluntary exit supplied")
+	}
+
+	s.chainMu.RLock()
+	validators := len(s.state.Validators)
+	s.chainMu.RUnlock()
+	if int(voluntaryExit.Message.ValidatorIndex) >= validators {
+		return errors.Wrapf(client.ErrBadRequest, "unknown validator %d", voluntaryExit.Message.ValidatorIndex)
+	}
+
+	s.submittedMu.Lock()
+	s.submittedVoluntaryExits = append(s.submittedVoluntaryExits, voluntaryExit)
+	s.submittedMu.Unlock()
+
+	s.sendEvents([]*api.Event{
+		{
+			Topic: "voluntary_exit",
+			Data:  voluntaryExit,
+		},
+	})
+
+	return nil
+}
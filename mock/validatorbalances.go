@@ -0,0 +1,42 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ValidatorBalances provides the validator balances for a given state.
+// If validatorIndices is empty then balances for all validators are returned.
+func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID)
+	if err != nil {
+		return nil, err
+	}
+	wanted := s.validatorIndexMap(validatorIndices)
+
+	res := make(map[spec.ValidatorIndex]spec.Gwei)
+	for i := range s.state.Validators {
+		index := spec.ValidatorIndex(i)
+		if wanted(index) {
+			res[index] = balanceAt(s.epochAtSlot(slot))
+		}
+	}
+	return res, nil
+}
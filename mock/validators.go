@@ -0,0 +1,86 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Validators provides the validators, with their balance and status, for a given state.
+// If validatorIndices is empty then all validators are returned.
+func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID)
+	if err != nil {
+		return nil, err
+	}
+	wanted := s.validatorIndexMap(validatorIndices)
+
+	res := make(map[spec.ValidatorIndex]*api.Validator)
+	for i := range s.state.Validators {
+		index := spec.ValidatorIndex(i)
+		if wanted(index) {
+			res[index] = s.validatorAt(index, slot)
+		}
+	}
+	return res, nil
+}
+
+// ValidatorsByPubKey provides the validators, with their balance and status, for a given state.
+// If validatorPubKeys is empty then all validators are returned.
+func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	s.chainMu.RLock()
+	defer s.chainMu.RUnlock()
+
+	slot, err := s.slotForStateID(stateID)
+	if err != nil {
+		return nil, err
+	}
+	pubKeys := make(map[spec.BLSPubKey]bool, len(validatorPubKeys))
+	for _, pubKey := range validatorPubKeys {
+		pubKeys[pubKey] = true
+	}
+
+	res := make(map[spec.ValidatorIndex]*api.Validator)
+	for i, validator := range s.state.Validators {
+		if len(pubKeys) == 0 || pubKeys[validator.PublicKey] {
+			res[spec.ValidatorIndex(i)] = s.validatorAt(spec.ValidatorIndex(i), slot)
+		}
+	}
+	return res, nil
+}
+
+// validatorAt provides the validator with the given index at the given slot.
+// This should be called with the chain lock held.
+func (s *Service) validatorAt(index spec.ValidatorIndex, slot spec.Slot) *api.Validator {
+	validator := s.state.Validators[index]
+	epoch := s.epochAtSlot(slot)
+	return &api.Validator{
+		Index:     index,
+		Balance:   balanceAt(epoch),
+		Status:    api.ValidatorToState(validator, epoch, s.farFutureEpoch),
+		Validator: validator,
+	}
+}
+
+// balanceAt provides the balance of all validators at the given epoch.
+// Balances start at the maximum effective balance and increase by 1000 Gwei each epoch.
+func balanceAt(epoch spec.Epoch) spec.Gwei {
+	return maxEffectiveBalance + spec.Gwei(uint64(epoch)*1000)
+}
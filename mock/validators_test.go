@@ -0,0 +1,54 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock_test
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	ctx := context.Background()
+	service, err := mock.New(ctx, mock.WithManualAdvance(true), mock.WithValidators(16))
+	require.NoError(t, err)
+	for i := 0; i < 8; i++ {
+		require.NoError(t, service.Advance(ctx))
+	}
+
+	validators, err := service.Validators(ctx, "head", nil)
+	require.NoError(t, err)
+	require.Len(t, validators, 16)
+	for index, validator := range validators {
+		require.Equal(t, index, validator.Index)
+		require.Equal(t, api.ValidatorStateActiveOngoing, validator.Status)
+		require.Equal(t, spec.Gwei(32000001000), validator.Balance)
+	}
+
+	byPubKey, err := service.ValidatorsByPubKey(ctx, "head", []spec.BLSPubKey{validators[3].Validator.PublicKey})
+	require.NoError(t, err)
+	require.Len(t, byPubKey, 1)
+	require.Equal(t, validators[3], byPubKey[3])
+
+	balances, err := service.ValidatorBalances(ctx, "genesis", []spec.ValidatorIndex{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, map[spec.ValidatorIndex]spec.Gwei{1: 32000000000, 2: 32000000000}, balances)
+
+	_, err = service.Validators(ctx, "100", nil)
+	require.EqualError(t, err, "state 100 not available: not found")
+}
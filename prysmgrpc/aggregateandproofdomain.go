@@ -25,9 +25,13 @@ import (
 
 // AggregateAndProofDomain provides the aggregate and proof domain of the chain.
 func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "AggregateAndProofDomain")
+	defer span.End()
+
+	s.monitorCache("aggregate_and_proof_domain", s.aggregateAndProofDomain != nil)
 	if s.aggregateAndProofDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching aggregate and proof domain")
+		s.log.Trace().Msg("Fetching aggregate and proof domain")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
@@ -48,6 +52,6 @@ func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType,
 		s.aggregateAndProofDomain = &domainType
 	}
 
-	log.Trace().Str("domain", fmt.Sprintf("%#x", s.aggregateAndProofDomain)).Msg("Returning aggregate and proof domain")
+	s.log.Trace().Str("domain", fmt.Sprintf("%#x", s.aggregateAndProofDomain)).Msg("Returning aggregate and proof domain")
 	return *s.aggregateAndProofDomain, nil
 }
@@ -24,8 +24,11 @@ import (
 
 // PrysmAggregateAttestation fetches the aggregate attestation given an attestation.
 func (s *Service) PrysmAggregateAttestation(ctx context.Context, attestation *spec.Attestation, validatorPubKey spec.BLSPubKey, slotSignature spec.BLSSignature) (*spec.Attestation, error) {
+	ctx, span := s.startSpan(ctx, "PrysmAggregateAttestation")
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling SubmitAggregateSelectionProof()")
+	s.log.Trace().Msg("Calling SubmitAggregateSelectionProof()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	resp, err := conn.SubmitAggregateSelectionProof(opCtx, &ethpb.AggregateSelectionRequest{
 		Slot:           uint64(attestation.Data.Slot),
@@ -63,10 +66,10 @@ func (s *Service) PrysmAggregateAttestation(ctx context.Context, attestation *sp
 		return nil, errors.New("aggregate attestation data returned for incorrect committee index")
 	}
 
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		jsonData, err := json.Marshal(aggregateAttestation)
 		if err == nil {
-			log.Trace().Str("data", string(jsonData)).Msg("Returning aggregate attestation")
+			s.log.Trace().Str("data", string(jsonData)).Msg("Returning aggregate attestation")
 		}
 	}
 	return aggregateAttestation, nil
@@ -20,12 +20,19 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // AttestationData obtains attestation data for a slot.
 func (s *Service) AttestationData(ctx context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error) {
+	ctx, span := s.startSpan(ctx, "AttestationData",
+		attribute.Int64("slot", int64(slot)),
+		attribute.Int64("committee_index", int64(committeeIndex)),
+	)
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling GetAttestationData()")
+	s.log.Trace().Msg("Calling GetAttestationData()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	resp, err := conn.GetAttestationData(opCtx, &ethpb.AttestationDataRequest{
 		Slot:           uint64(slot),
@@ -58,10 +65,10 @@ func (s *Service) AttestationData(ctx context.Context, slot spec.Slot, committee
 	copy(attestationData.Source.Root[:], resp.Source.Root)
 	copy(attestationData.Target.Root[:], resp.Target.Root)
 
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		jsonData, err := json.Marshal(attestationData)
 		if err == nil {
-			log.Trace().Str("attestation_data", string(jsonData)).Msg("Attestation data")
+			s.log.Trace().Str("attestation_data", string(jsonData)).Msg("Attestation data")
 		}
 	}
 
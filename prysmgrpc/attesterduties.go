@@ -21,10 +21,17 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // AttesterDuties obtains attester duties.
 func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, indices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	ctx, span := s.startSpan(ctx, "AttesterDuties",
+		attribute.Int64("epoch", int64(epoch)),
+		attribute.Int("validators", len(indices)),
+	)
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 
 	validatorPubKeys, err := s.indicesToPubKeys(ctx, indices)
@@ -40,10 +47,10 @@ func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, indices
 		Epoch:      uint64(epoch),
 		PublicKeys: pubKeys,
 	}
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		jsonData, err := json.Marshal(req)
 		if err == nil {
-			log.Trace().Str("req", string(jsonData)).Msg("Calling GetDuties()")
+			s.log.Trace().Str("req", string(jsonData)).Msg("Calling GetDuties()")
 		}
 	}
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -74,10 +81,10 @@ func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, indices
 		})
 	}
 
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		jsonData, err := json.Marshal(duties)
 		if err == nil {
-			log.Trace().Str("data", string(jsonData)).Msg("Returning attester duties")
+			s.log.Trace().Str("data", string(jsonData)).Msg("Returning attester duties")
 		}
 	}
 	return duties, nil
@@ -25,9 +25,13 @@ import (
 
 // BeaconAttesterDomain provides the beacon attester domain of the chain.
 func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "BeaconAttesterDomain")
+	defer span.End()
+
+	s.monitorCache("beacon_attester_domain", s.beaconAttesterDomain != nil)
 	if s.beaconAttesterDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching beacon attester domain")
+		s.log.Trace().Msg("Fetching beacon attester domain")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
@@ -47,6 +51,6 @@ func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, er
 		copy(domainType[:], tmp)
 		s.beaconAttesterDomain = &domainType
 	}
-	log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning beacon attester domain")
+	s.log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning beacon attester domain")
 	return *s.beaconAttesterDomain, nil
 }
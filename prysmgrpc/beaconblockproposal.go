@@ -20,10 +20,14 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte) (*spec.BeaconBlock, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockProposal", attribute.Int64("slot", int64(slot)))
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 
 	// Graffiti should be 32 bytes.
@@ -36,10 +40,10 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randa
 		Graffiti:     fixedGraffiti,
 	}
 
-	if e := log.Trace(); e.Enabled() {
+	if e := s.log.Trace(); e.Enabled() {
 		jsonData, err := json.Marshal(req)
 		if err == nil {
-			log.Trace().Str("data", string(jsonData)).Msg("Calling GetBlock()")
+			s.log.Trace().Str("data", string(jsonData)).Msg("Calling GetBlock()")
 		}
 	}
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -18,10 +18,14 @@ import (
 
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // BeaconBlockRootBySlot fetches a block's root given its slot.
 func (s *Service) BeaconBlockRootBySlot(ctx context.Context, slot uint64) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockRootBySlot", attribute.Int64("slot", int64(slot)))
+	defer span.End()
+
 	conn := ethpb.NewBeaconChainClient(s.conn)
 
 	req := &ethpb.ListBlocksRequest{}
@@ -26,15 +26,24 @@ import (
 
 // AddOnBeaconChainHeadUpdatedHandler adds a handler provided with beacon chain head updates.
 func (s *Service) AddOnBeaconChainHeadUpdatedHandler(ctx context.Context, handler client.BeaconChainHeadUpdatedHandler) error {
+	ctx, span := s.startSpan(ctx, "AddOnBeaconChainHeadUpdatedHandler")
+	defer span.End()
+
 	if handler == nil {
 		return errors.New("no handler supplied")
 	}
 	s.beaconChainHeadUpdatedMutex.Lock()
 	if s.beaconChainHeadUpdatedHandlers == nil {
-		log.Trace().Msg("Adding first handler; starting stream")
+		s.log.Trace().Msg("Adding first handler; starting stream")
+		// The stream counts as disconnected until it connects.
+		s.disconnectedStreamsChanged(1)
+		if !s.goBackground(func() { s.streamBeaconChainHead(ctx) }) {
+			s.disconnectedStreamsChanged(-1)
+			s.beaconChainHeadUpdatedMutex.Unlock()
+			return client.ErrClosed
+		}
 		s.beaconChainHeadUpdatedHandlers = make([]client.BeaconChainHeadUpdatedHandler, 1, 16)
 		s.beaconChainHeadUpdatedHandlers[0] = handler
-		go s.streamBeaconChainHead(ctx)
 	} else {
 		s.beaconChainHeadUpdatedHandlers = append(s.beaconChainHeadUpdatedHandlers, handler)
 	}
@@ -45,15 +54,20 @@ func (s *Service) AddOnBeaconChainHeadUpdatedHandler(ctx context.Context, handle
 // streamBeaconChainHead streams beacon chain head to feed beacon chain head update events.
 func (s *Service) streamBeaconChainHead(ctx context.Context) {
 	conn := ethpb.NewBeaconChainClient(s.conn)
-	log.Trace().Msg("Calling StreamChainHead()")
+	s.log.Trace().Msg("Calling StreamChainHead()")
 	stream, err := conn.StreamChainHead(s.ctx, &types.Empty{})
 	if err != nil {
-		log.Warn().Err(err).Msg("failed to open chain head stream")
+		s.log.Warn().Err(err).Msg("failed to open chain head stream")
 		return
 	}
+	s.monitorStream(true)
+	s.disconnectedStreamsChanged(-1)
 	defer func() {
+		// The stream is not restarted, so the connection remains degraded.
+		s.monitorStream(false)
+		s.disconnectedStreamsChanged(1)
 		if err := stream.CloseSend(); err != nil {
-			log.Warn().Err(err).Msg("failed to close chain head stream")
+			s.log.Warn().Err(err).Msg("failed to close chain head stream")
 		}
 	}()
 	lastEpoch := uint64(0)
@@ -65,28 +79,31 @@ func (s *Service) streamBeaconChainHead(ctx context.Context) {
 		}
 		if err != nil {
 			// Unnatural error.
-			log.Warn().Err(err).Msg("received error from blocks stream")
+			s.log.Warn().Err(err).Msg("received error from blocks stream")
 			return
 		}
 		if beaconChainHead != nil {
-			log.Trace().Uint64("slot", beaconChainHead.HeadSlot).Msg("Received beacon chain head")
+			s.log.Trace().Uint64("slot", beaconChainHead.HeadSlot).Msg("Received beacon chain head")
+			s.monitorEvent("head")
 
 			// Need the state root for this slot.
 			signedBeaconBlock, err := s.SignedBeaconBlock(ctx, fmt.Sprintf("%d", beaconChainHead.HeadSlot))
 			if err != nil {
-				log.Warn().Err(err).Msg("failed to obtain block for slot")
+				s.log.Warn().Err(err).Msg("failed to obtain block for slot")
 				return
 			}
 			if signedBeaconBlock == nil {
-				log.Warn().Err(err).Msg("obtained nil block for slot")
+				s.log.Warn().Err(err).Msg("obtained nil block for slot")
 				return
 			}
 
+			epochTransition := beaconChainHead.HeadEpoch != lastEpoch
 			s.beaconChainHeadUpdatedMutex.RLock()
 			for i := range s.beaconChainHeadUpdatedHandlers {
-				go func(handler client.BeaconChainHeadUpdatedHandler) {
-					handler.OnBeaconChainHeadUpdated(s.ctx, beaconChainHead.HeadSlot, beaconChainHead.HeadBlockRoot, signedBeaconBlock.Message.StateRoot[:], beaconChainHead.HeadEpoch != lastEpoch)
-				}(s.beaconChainHeadUpdatedHandlers[i])
+				handler := s.beaconChainHeadUpdatedHandlers[i]
+				s.goBackground(func() {
+					handler.OnBeaconChainHeadUpdated(s.ctx, beaconChainHead.HeadSlot, beaconChainHead.HeadBlockRoot, signedBeaconBlock.Message.StateRoot[:], epochTransition)
+				})
 			}
 			lastEpoch = beaconChainHead.HeadEpoch
 			s.beaconChainHeadUpdatedMutex.RUnlock()
@@ -25,9 +25,13 @@ import (
 
 // BeaconProposerDomain provides the beacon proposer domain of the chain.
 func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "BeaconProposerDomain")
+	defer span.End()
+
+	s.monitorCache("beacon_proposer_domain", s.beaconProposerDomain != nil)
 	if s.beaconProposerDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching beacon proposer domain")
+		s.log.Trace().Msg("Fetching beacon proposer domain")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
@@ -48,6 +52,6 @@ func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, er
 		s.beaconProposerDomain = &domainType
 	}
 
-	log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning beacon proposer domain")
+	s.log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning beacon proposer domain")
 	return *s.beaconProposerDomain, nil
 }
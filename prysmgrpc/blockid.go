@@ -0,0 +1,104 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ResolveBlockID resolves a block ID to the slot, epoch and root of the block.
+func (s *Service) ResolveBlockID(ctx context.Context, blockID api.BlockID) (*api.ResolvedBlockID, error) {
+	ctx, span := s.startSpan(ctx, "ResolveBlockID", attribute.String("block_id", blockID.String()))
+	defer span.End()
+
+	if !blockID.IsValid() {
+		return nil, errors.New("no block ID specified")
+	}
+
+	var req *ethpb.ListBlocksRequest
+	if slot, isSlot := blockID.Slot(); isSlot {
+		req = slotRequest(uint64(slot))
+	} else if root, isRoot := blockID.Root(); isRoot {
+		req = rootRequest(root[:])
+	} else if blockID.IsGenesis() {
+		req = slotRequest(0)
+	} else {
+		chainHead, err := s.beaconHead(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain chain head")
+		}
+		switch {
+		case blockID.IsHead():
+			req = rootRequest(chainHead.HeadBlockRoot)
+		case blockID.IsJustified():
+			req = rootRequest(chainHead.JustifiedBlockRoot)
+		default:
+			req = rootRequest(chainHead.FinalizedBlockRoot)
+		}
+	}
+
+	container, err := s.blockContainer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if container == nil {
+		return nil, errors.Wrapf(client.ErrNotFound, "block %s not available", blockID)
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	res := &api.ResolvedBlockID{
+		Slot:  spec.Slot(container.Block.Block.Slot),
+		Epoch: spec.Epoch(container.Block.Block.Slot / slotsPerEpoch),
+	}
+	copy(res.Root[:], container.BlockRoot)
+	return res, nil
+}
+
+// slotRequest returns a block request for the given slot.
+func slotRequest(slot uint64) *ethpb.ListBlocksRequest {
+	if slot == 0 {
+		return &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Genesis{Genesis: true}}
+	}
+	return &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: slot}}
+}
+
+// rootRequest returns a block request for the given block root.
+func rootRequest(root []byte) *ethpb.ListBlocksRequest {
+	return &ethpb.ListBlocksRequest{QueryFilter: &ethpb.ListBlocksRequest_Root{Root: root}}
+}
+
+// blockContainer obtains the first block matching the request, or nil if there are none.
+func (s *Service) blockContainer(ctx context.Context, req *ethpb.ListBlocksRequest) (*ethpb.BeaconBlockContainer, error) {
+	conn := ethpb.NewBeaconChainClient(s.conn)
+	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	resp, err := conn.ListBlocks(opCtx, req)
+	cancel()
+	if err != nil {
+		return nil, errors.Wrap(err, "call to ListBlocks() failed")
+	}
+	if len(resp.BlockContainers) == 0 {
+		return nil, nil
+	}
+	return resp.BlockContainers[0], nil
+}
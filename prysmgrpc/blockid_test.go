@@ -0,0 +1,79 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	prysmgrpc "github.com/attestantio/go-eth2-client/prysmgrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveBlockID(t *testing.T) {
+	tests := []struct {
+		name    string
+		blockID api.BlockID
+		err     string
+	}{
+		{
+			name: "Invalid",
+			err:  "no block ID specified",
+		},
+		{
+			name:    "Genesis",
+			blockID: api.BlockIDGenesis(),
+		},
+		{
+			name:    "Head",
+			blockID: api.BlockIDHead(),
+		},
+		{
+			name:    "Justified",
+			blockID: api.BlockIDJustified(),
+		},
+		{
+			name:    "Finalized",
+			blockID: api.BlockIDFinalized(),
+		},
+	}
+
+	service, err := prysmgrpc.New(context.Background(),
+		prysmgrpc.WithTimeout(timeout),
+		prysmgrpc.WithAddress(os.Getenv("PRYSMGRPC_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.ResolveBlockID(context.Background(), test.blockID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			// Resolving by the root or slot should provide the same result.
+			byRoot, err := service.ResolveBlockID(context.Background(), api.BlockIDRoot(res.Root))
+			require.NoError(t, err)
+			require.Equal(t, res, byRoot)
+			bySlot, err := service.ResolveBlockID(context.Background(), api.BlockIDSlot(res.Slot))
+			require.NoError(t, err)
+			require.Equal(t, res, bySlot)
+		})
+	}
+}
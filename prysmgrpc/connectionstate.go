@@ -0,0 +1,97 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	client "github.com/attestantio/go-eth2-client"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ConnectionState provides the state of the connection to the beacon node.
+// The connection is degraded if the beacon chain head stream is not connected.
+func (s *Service) ConnectionState() client.ConnectionState {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	switch {
+	case s.ctx.Err() != nil:
+		return client.ConnectionStateDisconnected
+	case s.nodeState == client.ConnectionStateConnected && s.disconnectedStreams > 0:
+		return client.ConnectionStateDegraded
+	default:
+		return s.nodeState
+	}
+}
+
+// watchConnectivity updates the state of the connection from the state of the gRPC connection,
+// until the service is closed.
+func (s *Service) watchConnectivity() {
+	for {
+		state := s.conn.GetState()
+		s.log.Trace().Stringer("state", state).Msg("Connectivity state")
+		switch state {
+		case connectivity.Idle, connectivity.Ready:
+			s.nodeStateChanged(client.ConnectionStateConnected)
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			s.nodeStateChanged(client.ConnectionStateDisconnected)
+		default:
+			// Connecting says nothing new about the node, as the connection cycles through it when the node is down.
+		}
+		if !s.conn.WaitForStateChange(s.ctx, state) {
+			return
+		}
+	}
+}
+
+// nodeStateChanged updates the state of the connection to the node.
+func (s *Service) nodeStateChanged(state client.ConnectionState) {
+	s.stateMu.Lock()
+	s.nodeState = state
+	s.connectionStateChanged()
+	s.stateMu.Unlock()
+}
+
+// disconnectedStreamsChanged updates the state of the connection as streams connect and disconnect.
+func (s *Service) disconnectedStreamsChanged(delta int) {
+	s.stateMu.Lock()
+	s.disconnectedStreams += delta
+	s.connectionStateChanged()
+	s.stateMu.Unlock()
+}
+
+// connectionStateChanged signals that the state of the connection may have changed.  stateMu must be held.
+func (s *Service) connectionStateChanged() {
+	if s.connectionStateCh == nil || s.closed {
+		return
+	}
+	select {
+	case s.connectionStateCh <- struct{}{}:
+	default:
+		// A signal is already pending.
+	}
+}
+
+// notifyConnectionState calls the handler when the state of the connection changes, until the service is closed.
+// Changes that happen while the handler is running are coalesced, so the handler always sees the latest state.
+func (s *Service) notifyConnectionState(handler client.ConnectionStateHandlerFunc) {
+	notified := client.ConnectionStateDisconnected
+	for range s.connectionStateCh {
+		if state := s.ConnectionState(); state != notified {
+			handler(state)
+			notified = state
+		}
+	}
+	if notified != client.ConnectionStateDisconnected {
+		handler(client.ConnectionStateDisconnected)
+	}
+}
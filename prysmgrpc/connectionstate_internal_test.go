@@ -0,0 +1,83 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// nextState waits for the next connection state passed to a handler.
+func nextState(t *testing.T, states <-chan client.ConnectionState) client.ConnectionState {
+	select {
+	case state := <-states:
+		return state
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no connection state received")
+		return client.ConnectionStateDisconnected
+	}
+}
+
+func TestConnectionState(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		log:               zerolog.Nop(),
+		ctx:               ctx,
+		cancel:            cancel,
+		conn:              conn,
+		connectionStateCh: make(chan struct{}, 1),
+	}
+	states := make(chan client.ConnectionState, 16)
+	s.start(func(state client.ConnectionState) {
+		states <- state
+	})
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+
+	// A stream that is not connected degrades the connection.
+	s.disconnectedStreamsChanged(1)
+	require.Equal(t, client.ConnectionStateDegraded, nextState(t, states))
+	require.Equal(t, client.ConnectionStateDegraded, s.ConnectionState())
+	s.disconnectedStreamsChanged(-1)
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+
+	// Losing the node disconnects the connection.
+	server.Stop()
+	require.Equal(t, client.ConnectionStateDisconnected, nextState(t, states))
+
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer closeCancel()
+	require.NoError(t, s.Close(closeCtx))
+	require.Equal(t, client.ConnectionStateDisconnected, s.ConnectionState())
+	require.Len(t, states, 0)
+
+	// Background goroutines cannot be started once closed.
+	require.False(t, s.goBackground(func() {}))
+}
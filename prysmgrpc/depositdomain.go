@@ -25,9 +25,13 @@ import (
 
 // DepositDomain provides the deposit domain of the chain.
 func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "DepositDomain")
+	defer span.End()
+
+	s.monitorCache("deposit_domain", s.depositDomain != nil)
 	if s.depositDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching deposit domain")
+		s.log.Trace().Msg("Fetching deposit domain")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
@@ -48,6 +52,6 @@ func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
 		s.depositDomain = &domainType
 	}
 
-	log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning deposit domain")
+	s.log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning deposit domain")
 	return *s.depositDomain, nil
 }
@@ -20,12 +20,16 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Domain provides a domain for a given domain type at a given epoch.
 func (s *Service) Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
+	ctx, span := s.startSpan(ctx, "Domain", attribute.Int64("epoch", int64(epoch)))
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling DomainData()")
+	s.log.Trace().Msg("Calling DomainData()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	resp, err := conn.DomainData(opCtx, &ethpb.DomainRequest{
 		Epoch:  uint64(epoch),
@@ -38,7 +42,7 @@ func (s *Service) Domain(ctx context.Context, domainType spec.DomainType, epoch
 
 	var res spec.Domain
 	copy(res[:], resp.SignatureDomain)
-	log.Trace().
+	s.log.Trace().
 		Uint64("epoch", uint64(epoch)).
 		Str("domain", fmt.Sprintf("%#x", domainType)).
 		Str("signature_domain", fmt.Sprintf("%#x", res)).
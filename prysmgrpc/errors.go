@@ -0,0 +1,78 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcStatusCodes maps gRPC status codes to their HTTP equivalents.
+var grpcStatusCodes = map[codes.Code]int{
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// apiError converts an error from a gRPC call into an API error, allowing callers to
+// check for common conditions with the errors in the client package.
+func apiError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, isStatus := status.FromError(err)
+	if !isStatus {
+		return err
+	}
+	statusCode, exists := grpcStatusCodes[st.Code()]
+	if !exists {
+		return err
+	}
+	return &client.APIError{
+		Method:     "gRPC",
+		Endpoint:   method,
+		StatusCode: statusCode,
+		Code:       int(st.Code()),
+		Message:    st.Message(),
+	}
+}
+
+// unaryErrorInterceptor converts errors from unary gRPC calls into API errors.
+func unaryErrorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return apiError(method, invoker(ctx, method, req, reply, cc, opts...))
+}
+
+// streamErrorInterceptor converts errors from creating gRPC streams into API errors.
+func streamErrorInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	return stream, apiError(method, err)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		sentinel   error
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "NotStatus",
+			err:  errors.New("plain error"),
+		},
+		{
+			name:       "NotFound",
+			err:        status.Error(codes.NotFound, "block not found"),
+			statusCode: http.StatusNotFound,
+			sentinel:   client.ErrNotFound,
+		},
+		{
+			name:       "InvalidArgument",
+			err:        status.Error(codes.InvalidArgument, "bad slot"),
+			statusCode: http.StatusBadRequest,
+			sentinel:   client.ErrBadRequest,
+		},
+		{
+			name:       "Unavailable",
+			err:        status.Error(codes.Unavailable, "syncing"),
+			statusCode: http.StatusServiceUnavailable,
+			sentinel:   client.ErrSyncing,
+		},
+		{
+			name:       "DeadlineExceeded",
+			err:        status.Error(codes.DeadlineExceeded, "too slow"),
+			statusCode: http.StatusGatewayTimeout,
+			sentinel:   client.ErrTimeout,
+		},
+		{
+			name:       "Unimplemented",
+			err:        status.Error(codes.Unimplemented, "unknown method"),
+			statusCode: http.StatusNotImplemented,
+			sentinel:   client.ErrNotSupported,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := apiError("/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", test.err)
+			if test.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			var apiErr *client.APIError
+			if test.statusCode == 0 {
+				require.False(t, errors.As(err, &apiErr))
+				require.Equal(t, test.err, err)
+				return
+			}
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, "gRPC", apiErr.Method)
+			require.Equal(t, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", apiErr.Endpoint)
+			require.Equal(t, test.statusCode, apiErr.StatusCode)
+			require.Equal(t, int(status.Code(test.err)), apiErr.Code)
+			require.True(t, errors.Is(err, test.sentinel))
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type eventPackager struct {
@@ -40,6 +41,9 @@ func (e *eventPackager) OnBeaconChainHeadUpdated(ctx context.Context, slot uint6
 
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	ctx, span := s.startSpan(ctx, "Events", attribute.StringSlice("topics", topics))
+	defer span.End()
+
 	packager := &eventPackager{handler: handler}
 	return s.AddOnBeaconChainHeadUpdatedHandler(ctx, packager)
 }
@@ -25,6 +25,10 @@ import (
 
 // FarFutureEpoch provides the value of the far future epoch of the chain.
 func (s *Service) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	ctx, span := s.startSpan(ctx, "FarFutureEpoch")
+	defer span.End()
+
+	s.monitorCache("far_future_epoch", s.farFutureEpoch != nil)
 	if s.farFutureEpoch == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -20,11 +20,16 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Fork provides the fork at a given epoch.
 // Prysm does not provide a method to obtain the current fork version, so provide the genesis fork version.
 func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error) {
+	ctx, span := s.startSpan(ctx, "Fork", attribute.String("state_id", stateID))
+	defer span.End()
+
+	s.monitorCache("genesis_fork_version", s.genesisForkVersion != nil)
 	if s.genesisForkVersion == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -22,6 +22,9 @@ import (
 
 // Genesis provides the genesis information of the chain.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
+	ctx, span := s.startSpan(ctx, "Genesis")
+	defer span.End()
+
 	genesisTime, err := s.GenesisTime(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain genesis time")
@@ -24,6 +24,10 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	ctx, span := s.startSpan(ctx, "GenesisTime")
+	defer span.End()
+
+	s.monitorCache("genesis_time", s.genesisTime != nil)
 	if s.genesisTime == nil {
 		conn := ethpb.NewNodeClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -23,6 +23,10 @@ import (
 
 // GenesisValidatorsRoot provides the genesis validators root of the chain.
 func (s *Service) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "GenesisValidatorsRoot")
+	defer span.End()
+
+	s.monitorCache("genesis_validators_root", s.genesisValidatorsRoot != nil)
 	if s.genesisValidatorsRoot == nil {
 		conn := ethpb.NewNodeClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
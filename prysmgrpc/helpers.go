@@ -25,6 +25,9 @@ import (
 
 // CurrentEpoch is a helper that calculates the current epoch.
 func (s *Service) CurrentEpoch(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "CurrentEpoch")
+	defer span.End()
+
 	genesisTime, err := s.GenesisTime(ctx)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to obtain genesis time for current epoch")
@@ -41,7 +44,7 @@ func (s *Service) CurrentEpoch(ctx context.Context) (uint64, error) {
 	if genesisTime.After(time.Now()) {
 		currentEpoch = 0
 	} else {
-		currentEpoch = uint64(time.Since(genesisTime).Seconds()) / (uint64(slotDuration.Seconds()) * slotsPerEpoch)
+		currentEpoch = uint64(time.Since(genesisTime)/slotDuration) / slotsPerEpoch
 	}
 
 	return currentEpoch, nil
@@ -49,6 +52,9 @@ func (s *Service) CurrentEpoch(ctx context.Context) (uint64, error) {
 
 // CurrentSlot is a helper that calculates the current slot.
 func (s *Service) CurrentSlot(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "CurrentSlot")
+	defer span.End()
+
 	genesisTime, err := s.GenesisTime(ctx)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to obtain genesis time for current slot")
@@ -61,7 +67,7 @@ func (s *Service) CurrentSlot(ctx context.Context) (uint64, error) {
 	if genesisTime.After(time.Now()) {
 		currentSlot = 0
 	} else {
-		currentSlot = uint64(time.Since(genesisTime).Seconds()) / uint64(slotDuration.Seconds())
+		currentSlot = uint64(time.Since(genesisTime) / slotDuration)
 	}
 
 	return currentSlot, nil
@@ -105,6 +111,7 @@ func (s *Service) indicesToPubKeys(ctx context.Context, indices []spec.Validator
 		}
 	}
 
+	s.monitorCache("validator_index", len(unknownIndices) == 0)
 	if len(unknownIndices) == 0 {
 		// We know all of them.
 		return pubKeys, nil
@@ -0,0 +1,78 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// unaryMonitorInterceptor returns an interceptor that reports unary gRPC calls to the monitor.
+func unaryMonitorInterceptor(address string, monitor metrics.Monitor) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		started := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		monitorCall(address, monitor, method, err, started)
+		return err
+	}
+}
+
+// streamMonitorInterceptor returns an interceptor that reports the creation of gRPC streams to the monitor.
+func streamMonitorInterceptor(address string, monitor metrics.Monitor) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		started := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		monitorCall(address, monitor, method, err, started)
+		return stream, err
+	}
+}
+
+// monitorCall reports a completed gRPC call to the monitor.
+func monitorCall(address string, monitor metrics.Monitor, method string, err error, started time.Time) {
+	monitor.RequestCompleted(address,
+		metrics.ProtocolGRPC,
+		method,
+		status.Code(err).String(),
+		metrics.ErrorClass(apiError(method, err)),
+		time.Since(started),
+	)
+}
+
+// monitorStream reports the connection state of the beacon chain head stream to the monitor, if present.
+func (s *Service) monitorStream(connected bool) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.StreamConnected(s.address, metrics.ProtocolGRPC, connected)
+}
+
+// monitorEvent reports a received event to the monitor, if present.
+func (s *Service) monitorEvent(topic string) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.EventReceived(s.address, metrics.ProtocolGRPC, topic)
+}
+
+// monitorCache reports a lookup of a cached value to the monitor, if present.
+func (s *Service) monitorCache(cache string, hit bool) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.CacheAccessed(s.address, cache, hit)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testMonitor records the last request that it is notified of.
+type testMonitor struct {
+	endpoint   string
+	status     string
+	errorClass string
+	cacheHits  map[string][]bool
+}
+
+func (m *testMonitor) RequestCompleted(address string, protocol string, endpoint string, status string, errorClass string, duration time.Duration) {
+	m.endpoint = endpoint
+	m.status = status
+	m.errorClass = errorClass
+}
+
+func (m *testMonitor) StreamConnected(address string, protocol string, connected bool) {}
+
+func (m *testMonitor) EventReceived(address string, protocol string, topic string) {}
+
+func (m *testMonitor) CacheAccessed(address string, cache string, hit bool) {
+	if m.cacheHits == nil {
+		m.cacheHits = make(map[string][]bool)
+	}
+	m.cacheHits[cache] = append(m.cacheHits[cache], hit)
+}
+
+func TestUnaryMonitorInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		status     string
+		errorClass string
+	}{
+		{
+			name:       "Good",
+			status:     "OK",
+			errorClass: metrics.ErrorClassNone,
+		},
+		{
+			name:       "NotFound",
+			err:        status.Error(codes.NotFound, "block not found"),
+			status:     "NotFound",
+			errorClass: metrics.ErrorClassNotFound,
+		},
+		{
+			name:       "DeadlineExceeded",
+			err:        status.Error(codes.DeadlineExceeded, "too slow"),
+			status:     "DeadlineExceeded",
+			errorClass: metrics.ErrorClassTimeout,
+		},
+		{
+			name:       "Internal",
+			err:        status.Error(codes.Internal, "failed"),
+			status:     "Internal",
+			errorClass: metrics.ErrorClassServer,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			monitor := &testMonitor{}
+			interceptor := unaryMonitorInterceptor("localhost:4000", monitor)
+			err := interceptor(context.Background(), "/ethereum.eth.v1alpha1.Node/GetVersion", nil, nil, nil,
+				func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+					return test.err
+				},
+			)
+			require.Equal(t, test.err, err)
+			require.Equal(t, "/ethereum.eth.v1alpha1.Node/GetVersion", monitor.endpoint)
+			require.Equal(t, test.status, monitor.status)
+			require.Equal(t, test.errorClass, monitor.errorClass)
+		})
+	}
+}
+
+func TestMonitorCache(t *testing.T) {
+	ctx := context.Background()
+	monitor := &testMonitor{}
+	genesisTime := time.Unix(1606824023, 0)
+	s := &Service{
+		address:     "localhost:4000",
+		monitor:     monitor,
+		tracer:      trace.NewNoopTracerProvider().Tracer(tracerName),
+		spec:        map[string]interface{}{"SLOTS_PER_EPOCH": uint64(32)},
+		genesisTime: &genesisTime,
+	}
+
+	_, err := s.Spec(ctx)
+	require.NoError(t, err)
+	_, err = s.GenesisTime(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]bool{
+		"spec":         {true},
+		"genesis_time": {true},
+	}, monitor.cacheHits)
+}
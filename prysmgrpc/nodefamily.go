@@ -0,0 +1,29 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// NodeFamily provides the family of the beacon node software.
+// Only Prysm provides this gRPC API, so the family is always Prysm.
+func (s *Service) NodeFamily(ctx context.Context) (client.Family, error) {
+	ctx, span := s.startSpan(ctx, "NodeFamily")
+	defer span.End()
+
+	return client.FamilyPrysm, nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+
+	"github.com/attestantio/go-eth2-client/prysmgrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeFamily(t *testing.T) {
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "Good",
+		},
+	}
+
+	service, err := prysmgrpc.New(context.Background(),
+		prysmgrpc.WithAddress(os.Getenv("PRYSMGRPC_ADDRESS")),
+		prysmgrpc.WithTimeout(timeout),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			family, err := service.NodeFamily(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, client.FamilyPrysm, family)
+		})
+	}
+}
@@ -25,6 +25,9 @@ import (
 
 // NodeSyncing provides the state of the node's synchronization with the chain.
 func (s *Service) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	ctx, span := s.startSpan(ctx, "NodeSyncing")
+	defer span.End()
+
 	conn := ethpb.NewBeaconChainClient(s.conn)
 
 	// Work out expected head slot.
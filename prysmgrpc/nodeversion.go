@@ -23,6 +23,9 @@ import (
 
 // NodeVersion returns a free-text string with the node version.
 func (s *Service) NodeVersion(ctx context.Context) (string, error) {
+	ctx, span := s.startSpan(ctx, "NodeVersion")
+	defer span.End()
+
 	conn := ethpb.NewNodeClient(s.conn)
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	version, err := conn.GetVersion(opCtx, &types.Empty{})
@@ -16,14 +16,25 @@ package prysmgrpc
 import (
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/recorder"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type parameters struct {
-	logLevel zerolog.Level
-	address  string
-	timeout  time.Duration
+	logLevel               zerolog.Level
+	logger                 zerolog.Logger
+	address                string
+	timeout                time.Duration
+	recorder               *recorder.Recorder
+	monitor                metrics.Monitor
+	tracerProvider         trace.TracerProvider
+	connectionStateHandler client.ConnectionStateHandlerFunc
 }
 
 // Parameter is the interface for service parameters.
@@ -44,6 +55,15 @@ func WithLogLevel(logLevel zerolog.Level) Parameter {
 	})
 }
 
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
 // WithAddress provides the address for the endpoint.
 func WithAddress(address string) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -58,12 +78,43 @@ func WithTimeout(timeout time.Duration) Parameter {
 	})
 }
 
+// WithRecorder sets a recorder, to record interactions with the node or to replay them.
+func WithRecorder(recorder *recorder.Recorder) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.recorder = recorder
+	})
+}
+
+// WithMonitor sets a monitor to be notified of calls, event streams and cache lookups, for example to provide metrics.
+func WithMonitor(monitor metrics.Monitor) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithTracerProvider sets the provider of the tracer used to trace provider calls.
+// If not supplied the global tracer provider is used.
+func WithTracerProvider(tracerProvider trace.TracerProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.tracerProvider = tracerProvider
+	})
+}
+
+// WithConnectionStateHandler sets a handler to be called when the state of the connection to the beacon node changes.
+func WithConnectionStateHandler(handler client.ConnectionStateHandlerFunc) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.connectionStateHandler = handler
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		address:  "localhost:4000",
-		timeout:  2 * time.Minute,
+		logLevel:       zerolog.GlobalLevel(),
+		logger:         zerologger.Logger,
+		address:        "localhost:4000",
+		timeout:        2 * time.Minute,
+		tracerProvider: otel.GetTracerProvider(),
 	}
 	for _, p := range params {
 		if params != nil {
@@ -74,6 +125,9 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.address == "" {
 		return nil, errors.New("no address specified")
 	}
+	if parameters.tracerProvider == nil {
+		return nil, errors.New("no tracer provider specified")
+	}
 
 	return &parameters, nil
 }
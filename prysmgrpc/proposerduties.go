@@ -20,10 +20,17 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ProposerDuties obtains proposer duties.
 func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, indices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
+	ctx, span := s.startSpan(ctx, "ProposerDuties",
+		attribute.Int64("epoch", int64(epoch)),
+		attribute.Int("validators", len(indices)),
+	)
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 
 	pubKeys := make([][]byte, 0, len(indices))
@@ -33,7 +40,7 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, indices
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to obtain validators")
 		}
-		log.Trace().Int("validators", len(prysmValidators)).Msg("Obtained validators")
+		s.log.Trace().Int("validators", len(prysmValidators)).Msg("Obtained validators")
 
 		for _, prysmValidator := range prysmValidators {
 			pubKeys = append(pubKeys, prysmValidator.Validator.PublicKey[:])
@@ -53,7 +60,7 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, indices
 		Epoch:      uint64(epoch),
 		PublicKeys: pubKeys,
 	}
-	log.Trace().Msg("Calling GetDuties()")
+	s.log.Trace().Msg("Calling GetDuties()")
 
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	resp, err := conn.GetDuties(opCtx, req)
@@ -66,7 +73,7 @@ func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, indices
 	index := 0
 	for _, duty := range resp.CurrentEpochDuties {
 		for _, slot := range duty.ProposerSlots {
-			log.Trace().Uint64("slot", slot).Uint64("validator_index", duty.ValidatorIndex).Msg("Received proposer duty")
+			s.log.Trace().Uint64("slot", slot).Uint64("validator_index", duty.ValidatorIndex).Msg("Received proposer duty")
 			proposerDuties = append(proposerDuties, &api.ProposerDuty{
 				Slot:           spec.Slot(slot),
 				ValidatorIndex: spec.ValidatorIndex(duty.ValidatorIndex),
@@ -24,6 +24,10 @@ import (
 
 // RANDAODomain provides the randao domain of the chain.
 func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "RANDAODomain")
+	defer span.End()
+
+	s.monitorCache("randao_domain", s.randaoDomain != nil)
 	if s.randaoDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -25,9 +25,13 @@ import (
 
 // SelectionProofDomain provides the selection proof domain of the chain.
 func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "SelectionProofDomain")
+	defer span.End()
+
+	s.monitorCache("selection_proof_domain", s.selectionProofDomain != nil)
 	if s.selectionProofDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching selection proof domain")
+		s.log.Trace().Msg("Fetching selection proof domain")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
@@ -48,6 +52,6 @@ func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, er
 		s.selectionProofDomain = &domainType
 	}
 
-	log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning selection proof domain")
+	s.log.Trace().Str("domain", fmt.Sprintf("%#x", s.beaconAttesterDomain)).Msg("Returning selection proof domain")
 	return *s.selectionProofDomain, nil
 }
@@ -22,23 +22,31 @@ import (
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/metrics"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/rs/zerolog"
-	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 )
 
 // Service is an Ethereum 2 client service.
 type Service struct {
-	// Hold the initialising context to allow for streams to use it.
-	ctx context.Context
+	log zerolog.Logger
+
+	// Hold the initialising context to allow for streams to use it; it is cancelled when the service is closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Background goroutines, waited for by Wait().
+	wg sync.WaitGroup
 
 	// Client connection.
 	conn    *grpc.ClientConn
 	address string
 	timeout time.Duration
+	monitor metrics.Monitor
+	tracer  trace.Tracer
 
 	maxPageSize int32
 
@@ -67,10 +75,14 @@ type Service struct {
 	// We keep a mapping of index to public keys to avoid repeated lookups.
 	indexMap   map[spec.ValidatorIndex]spec.BLSPubKey
 	indexMapMu sync.RWMutex
-}
 
-// log is a service-wide logger.
-var log zerolog.Logger
+	// State of the connection to the node.
+	stateMu             sync.Mutex
+	closed              bool
+	nodeState           client.ConnectionState
+	disconnectedStreams int
+	connectionStateCh   chan struct{}
+}
 
 // New creates a new Ethereum 2 client service, connecting with Prysm GRPC.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
@@ -80,7 +92,7 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "prysmgrpc").Logger()
+	log := parameters.logger.With().Str("service", "client").Str("impl", "prysmgrpc").Str("address", parameters.address).Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
@@ -90,25 +102,58 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		// Maximum receive value 256 MB
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(256 * 1024 * 1024)),
 	}
+	// Convert gRPC status errors to API errors.
+	unaryInterceptors := []grpc.UnaryClientInterceptor{unaryErrorInterceptor}
+	streamInterceptors := []grpc.StreamClientInterceptor{streamErrorInterceptor}
+	// Trace calls, propagating the trace context to the node.
+	tracer := parameters.tracerProvider.Tracer(tracerName)
+	unaryInterceptors = append(unaryInterceptors, unaryTraceInterceptor(tracer))
+	streamInterceptors = append(streamInterceptors, streamTraceInterceptor(tracer))
+	if parameters.monitor != nil {
+		// The monitor is inside the error interceptor, so that it sees gRPC status codes.
+		unaryInterceptors = append(unaryInterceptors, unaryMonitorInterceptor(parameters.address, parameters.monitor))
+		streamInterceptors = append(streamInterceptors, streamMonitorInterceptor(parameters.address, parameters.monitor))
+	}
+	if parameters.recorder != nil {
+		// The recorder is innermost, so that replayed errors are converted as live errors are.
+		unaryInterceptors = append(unaryInterceptors, parameters.recorder.UnaryClientInterceptor())
+		streamInterceptors = append(streamInterceptors, parameters.recorder.StreamClientInterceptor())
+	}
+	grpcOpts = append(grpcOpts,
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
+		grpc.WithChainStreamInterceptor(streamInterceptors...),
+	)
 
-	dialCtx, cancel := context.WithTimeout(ctx, parameters.timeout)
-	defer cancel()
+	dialCtx, dialCancel := context.WithTimeout(ctx, parameters.timeout)
+	defer dialCancel()
 	conn, err := grpc.DialContext(dialCtx, parameters.address, grpcOpts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to dial connection")
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
+		log:         log,
 		ctx:         ctx,
+		cancel:      cancel,
 		conn:        conn,
 		address:     parameters.address,
 		timeout:     parameters.timeout,
+		monitor:     parameters.monitor,
+		tracer:      tracer,
 		maxPageSize: 250, // Prysm default.
 		indexMap:    make(map[spec.ValidatorIndex]spec.BLSPubKey),
 	}
+	if parameters.connectionStateHandler != nil {
+		s.connectionStateCh = make(chan struct{}, 1)
+	}
 
 	// Obtain the node version to confirm the connection is good.
 	if _, err := s.NodeVersion(ctx); err != nil {
+		cancel()
+		if err := conn.Close(); err != nil {
+			log.Warn().Err(err).Msg("Failed to close connection")
+		}
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
@@ -120,16 +165,37 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		log.Trace().Int32("max_page_size", maxPageSize).Msg("Set maximum page size")
 	}
 
-	// Close the service on context done.
-	go func(s *Service) {
-		<-ctx.Done()
-		log.Trace().Msg("Context done; closing connection")
-		s.close()
-	}(s)
+	s.start(parameters.connectionStateHandler)
 
 	return s, nil
 }
 
+// start starts the background goroutines of the service.
+func (s *Service) start(connectionStateHandler client.ConnectionStateHandlerFunc) {
+	// Close the service when it is closed explicitly or on context done.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		<-s.ctx.Done()
+		s.log.Trace().Msg("Context done; closing connection")
+		s.close()
+	}()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.watchConnectivity()
+	}()
+
+	if connectionStateHandler != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.notifyConnectionState(connectionStateHandler)
+		}()
+	}
+}
+
 // Name provides the name of the service.
 func (s *Service) Name() string {
 	return "Prysm (gRPC)"
@@ -140,10 +206,55 @@ func (s *Service) Address() string {
 	return s.address
 }
 
-// Close the service, freeing up resources.
+// Close closes the service, stopping event streams, cancelling in-flight requests and closing the connection,
+// then waits for its background goroutines to exit.  It returns an error if the context is done first.
+func (s *Service) Close(ctx context.Context) error {
+	s.cancel()
+	return s.Wait(ctx)
+}
+
+// Wait waits for the service's background goroutines to exit, which happens once the service is closed or the
+// context used to create it is done.  It returns an error if the context is done first.
+func (s *Service) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "background goroutines did not exit")
+	}
+}
+
+// goBackground runs a function in a background goroutine that is waited for by Wait().
+// It returns false without running the function if the service has been closed.
+func (s *Service) goBackground(f func()) bool {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		f()
+	}()
+	return true
+}
+
+// close closes the service, freeing up resources.
 func (s *Service) close() {
+	s.stateMu.Lock()
+	s.closed = true
+	if s.connectionStateCh != nil {
+		close(s.connectionStateCh)
+	}
+	s.stateMu.Unlock()
 	if err := s.conn.Close(); err != nil {
-		log.Warn().Err(err).Msg("Failed to close connection")
+		s.log.Warn().Err(err).Msg("Failed to close connection")
 	}
 }
 
@@ -22,10 +22,14 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, error) {
+	ctx, span := s.startSpan(ctx, "SignedBeaconBlock", attribute.String("block_id", blockID))
+	defer span.End()
+
 	conn := ethpb.NewBeaconChainClient(s.conn)
 	req := &ethpb.ListBlocksRequest{}
 
@@ -25,6 +25,10 @@ import (
 
 // SlotDuration provides the duration of a slot of the chain.
 func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	ctx, span := s.startSpan(ctx, "SlotDuration")
+	defer span.End()
+
+	s.monitorCache("slot_duration", s.slotDuration != nil)
 	if s.slotDuration == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
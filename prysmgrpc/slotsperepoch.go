@@ -24,6 +24,10 @@ import (
 
 // SlotsPerEpoch provides the number of slots per epoch of the chain.
 func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "SlotsPerEpoch")
+	defer span.End()
+
+	s.monitorCache("slots_per_epoch", s.slotsPerEpoch != nil)
 	if s.slotsPerEpoch == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -63,7 +63,7 @@ var specNameMapping = map[string]string{
 	"MinGenesisActiveValidatorCount":   "MIN_GENESIS_ACTIVE_VALIDATOR_COUNT",
 	"MinGenesisTime":                   "MIN_GENESIS_TIME",
 	"MinPerEpochChurnLimit":            "MIN_PER_EPOCH_CHURN_LIMIT",
-	"MinSeedLookahead":                 "MIN_SEED_LOOKEAHEAD",
+	"MinSeedLookahead":                 "MIN_SEED_LOOKAHEAD",
 	"MinSlashingPenaltyQuotient":       "MIN_SLASHING_PENALTY_QUOTIENT",
 	"MinValidatorWithdrawabilityDelay": "MIN_VALIDATOR_WITHDRAWABILITY_DELAY",
 	"ProportionalSlashingMultiplier":   "PROPORTIONAL_SLASHING_MULTIPLIER",
@@ -83,9 +83,13 @@ var specNameMapping = map[string]string{
 
 // Spec provides the spec information of the chain.
 func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
+	ctx, span := s.startSpan(ctx, "Spec")
+	defer span.End()
+
+	s.monitorCache("spec", s.spec != nil)
 	if s.spec == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
-		log.Trace().Msg("Fetching beacon chain spec")
+		s.log.Trace().Msg("Fetching beacon chain spec")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		config, err := conn.GetBeaconConfig(opCtx, &types.Empty{})
 		cancel()
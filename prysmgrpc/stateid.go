@@ -14,23 +14,23 @@
 package prysmgrpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // EpochFromStateID obtains the epoch given the state ID.
 func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (spec.Epoch, error) {
-	if stateID == "head" {
-		epoch, err := s.CurrentEpoch(ctx)
-		return spec.Epoch(epoch), err
-	}
+	ctx, span := s.startSpan(ctx, "EpochFromStateID", attribute.String("state_id", stateID))
+	defer span.End()
 
 	slot, err := s.SlotFromStateID(ctx, stateID)
 	if err != nil {
@@ -45,41 +45,101 @@ func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (spec.Ep
 
 // SlotFromStateID obtains the slot given the state ID.
 func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (spec.Slot, error) {
-	var slot spec.Slot
-	switch {
-	case stateID == "genesis":
-		slot = 0
-	case stateID == "justified":
-		chainHead, err := s.beaconHead(ctx)
+	ctx, span := s.startSpan(ctx, "SlotFromStateID", attribute.String("state_id", stateID))
+	defer span.End()
+
+	id, err := api.ParseStateID(stateID)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s", stateID))
+	}
+	slot, err := s.stateSlot(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	s.log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
+	return slot, nil
+}
+
+// ResolveStateID resolves a state ID to the slot, epoch and root of the state.
+// Prysm's gRPC API does not provide state roots directly, so they are obtained from
+// the block at the state's slot.  As such states at empty slots cannot be resolved,
+// and state roots can only be resolved for the blocks of the head and recent checkpoints;
+// client.ErrNotSupported is returned for other states.
+func (s *Service) ResolveStateID(ctx context.Context, stateID api.StateID) (*api.ResolvedStateID, error) {
+	ctx, span := s.startSpan(ctx, "ResolveStateID", attribute.String("state_id", stateID.String()))
+	defer span.End()
+
+	if !stateID.IsValid() {
+		return nil, errors.New("no state ID specified")
+	}
+
+	slot, err := s.stateSlot(ctx, stateID)
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	root, isRoot := stateID.Root()
+	if !isRoot {
+		container, err := s.blockContainer(ctx, slotRequest(uint64(slot)))
 		if err != nil {
-			return 0, errors.New("failed to obtain chain head")
+			return nil, err
 		}
-		return spec.Slot(chainHead.JustifiedSlot), nil
-	case stateID == "finalized":
-		chainHead, err := s.beaconHead(ctx)
-		if err != nil {
-			return 0, errors.New("failed to obtain chain head")
+		if container == nil || uint64(slot) != container.Block.Block.Slot {
+			return nil, errors.Wrapf(client.ErrNotSupported, "no block at slot %d to provide state root", slot)
 		}
+		copy(root[:], container.Block.Block.StateRoot)
+	}
+
+	return &api.ResolvedStateID{
+		Slot:  slot,
+		Epoch: spec.Epoch(uint64(slot) / slotsPerEpoch),
+		Root:  root,
+	}, nil
+}
+
+// stateSlot obtains the slot of the state with the given ID.
+func (s *Service) stateSlot(ctx context.Context, stateID api.StateID) (spec.Slot, error) {
+	if slot, isSlot := stateID.Slot(); isSlot {
+		return slot, nil
+	}
+	if stateID.IsGenesis() {
+		return 0, nil
+	}
+
+	chainHead, err := s.beaconHead(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain chain head")
+	}
+	switch {
+	case stateID.IsJustified():
+		return spec.Slot(chainHead.JustifiedSlot), nil
+	case stateID.IsFinalized():
 		return spec.Slot(chainHead.FinalizedSlot), nil
-	case stateID == "head":
-		chainHead, err := s.beaconHead(ctx)
-		if err != nil {
-			return 0, errors.New("failed to obtain chain head")
-		}
+	case stateID.IsHead():
 		return spec.Slot(chainHead.HeadSlot), nil
-	case strings.HasPrefix(stateID, "0x"):
-		// State root.
-		return 0, errors.New("not implemented")
 	default:
-		// Slot.
-		tmp, err := strconv.ParseUint(stateID, 10, 64)
-		if err != nil {
-			return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s as a slot", stateID))
+		// State root; look for it in the blocks known to the chain head.
+		root, _ := stateID.Root()
+		for _, blockRoot := range [][]byte{
+			chainHead.HeadBlockRoot,
+			chainHead.JustifiedBlockRoot,
+			chainHead.PreviousJustifiedBlockRoot,
+			chainHead.FinalizedBlockRoot,
+		} {
+			container, err := s.blockContainer(ctx, rootRequest(blockRoot))
+			if err != nil {
+				return 0, err
+			}
+			if container != nil && bytes.Equal(container.Block.Block.StateRoot, root[:]) {
+				return spec.Slot(container.Block.Block.Slot), nil
+			}
 		}
-		slot = spec.Slot(tmp)
+		return 0, errors.Wrapf(client.ErrNotSupported, "state root %#x is not that of the head or a recent checkpoint block", root)
 	}
-	log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
-	return slot, nil
 }
 
 func (s *Service) beaconHead(ctx context.Context) (*ethpb.ChainHead, error) {
@@ -19,10 +19,14 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*spec.SignedAggregateAndProof) error {
+	ctx, span := s.startSpan(ctx, "SubmitAggregateAttestations", attribute.Int("aggregate_and_proofs", len(aggregateAndProofs)))
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 	for _, aggregateAndProof := range aggregateAndProofs {
 		prysmAggregateAndProof := &ethpb.SignedAggregateAttestationAndProof{
@@ -50,7 +54,7 @@ func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndP
 			Signature: aggregateAndProof.Signature[:],
 		}
 
-		log.Trace().Msg("Calling ProposeSignedAggregateSelectionProof()")
+		s.log.Trace().Msg("Calling ProposeSignedAggregateSelectionProof()")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		_, err := conn.SubmitSignedAggregateSelectionProof(opCtx, &ethpb.SignedAggregateSubmitRequest{
 			SignedAggregateAndProof: prysmAggregateAndProof,
@@ -16,22 +16,36 @@ package prysmgrpc
 import (
 	"context"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitAttestations submits attestations.
 // Prysm does not provide the ability to submit attestations natively, so send individually.
+// If any attestations are rejected the error is a *client.BatchError listing them.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error {
+	ctx, span := s.startSpan(ctx, "SubmitAttestations", attribute.Int("attestations", len(attestations)))
+	defer span.End()
+
 	var anyErr error
+	failures := make([]*client.APIErrorFailure, 0)
 	for i := range attestations {
 		if err := s.submitAttestation(ctx, attestations[i]); err != nil {
 			anyErr = err
+			failures = append(failures, &client.APIErrorFailure{
+				Index:   i,
+				Message: err.Error(),
+			})
 		}
 	}
+	if len(failures) > 0 {
+		return client.NewBatchError(len(attestations), failures, anyErr)
+	}
 
-	return anyErr
+	return nil
 }
 
 // submitAttestation submits an attestation.
@@ -55,7 +69,7 @@ func (s *Service) submitAttestation(ctx context.Context, attestation *spec.Attes
 	}
 
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling ProposeAttestation()")
+	s.log.Trace().Msg("Calling ProposeAttestation()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	_, err := conn.ProposeAttestation(opCtx, prysmAttestation)
 	cancel()
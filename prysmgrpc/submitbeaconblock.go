@@ -23,6 +23,9 @@ import (
 
 // SubmitBeaconBlock submits a beacon block.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) error {
+	ctx, span := s.startSpan(ctx, "SubmitBeaconBlock")
+	defer span.End()
+
 	proposal := &ethpb.SignedBeaconBlock{
 		Block: &ethpb.BeaconBlock{
 			Slot:          uint64(block.Message.Slot),
@@ -155,7 +158,7 @@ func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaco
 	}
 
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling ProposeBlock()")
+	s.log.Trace().Msg("Calling ProposeBlock()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	_, err := conn.ProposeBlock(opCtx, proposal)
 	cancel()
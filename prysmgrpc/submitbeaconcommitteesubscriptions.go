@@ -19,10 +19,14 @@ import (
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
 func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
+	ctx, span := s.startSpan(ctx, "SubmitBeaconCommitteeSubscriptions", attribute.Int("subscriptions", len(subscriptions)))
+	defer span.End()
+
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
 	slots := make([]uint64, len(subscriptions))
 	committeeIds := make([]uint64, len(subscriptions))
@@ -33,7 +37,7 @@ func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscr
 		isAggregator[i] = subscription.IsAggregator
 	}
 
-	log.Trace().Msg("Calling SubscribeCommitteeSubnets()")
+	s.log.Trace().Msg("Calling SubscribeCommitteeSubnets()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	_, err := conn.SubscribeCommitteeSubnets(opCtx, &ethpb.CommitteeSubnetsSubscribeRequest{
 		Slots:        slots,
@@ -23,6 +23,9 @@ import (
 
 // SubmitVoluntaryExit submits a voluntary exit.
 func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	ctx, span := s.startSpan(ctx, "SubmitVoluntaryExit")
+	defer span.End()
+
 	exit := &ethpb.SignedVoluntaryExit{
 		Exit: &ethpb.VoluntaryExit{
 			ValidatorIndex: uint64(voluntaryExit.Message.ValidatorIndex),
@@ -32,7 +35,7 @@ func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.S
 	}
 
 	conn := ethpb.NewBeaconNodeValidatorClient(s.conn)
-	log.Trace().Msg("Calling ProposeExit()")
+	s.log.Trace().Msg("Calling ProposeExit()")
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 	_, err := conn.ProposeExit(opCtx, exit)
 	cancel()
@@ -24,6 +24,10 @@ import (
 
 // TargetAggregatorsPerCommittee provides the target number of aggregators for each attestation committee.
 func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "TargetAggregatorsPerCommittee")
+	defer span.End()
+
+	s.monitorCache("target_aggregators_per_committee", s.targetAggregatorsPerCommittee != nil)
 	if s.targetAggregatorsPerCommittee == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
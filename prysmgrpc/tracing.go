@@ -0,0 +1,112 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tracerName is the name of the tracer used by the service.
+const tracerName = "github.com/attestantio/go-eth2-client/prysmgrpc"
+
+// propagator propagates trace context to the node in W3C trace context metadata.
+var propagator = propagation.TraceContext{}
+
+// startSpan starts a span for a provider call.
+func (s *Service) startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// metadataCarrier allows trace context to be carried in gRPC metadata.
+type metadataCarrier metadata.MD
+
+// Get returns the value for a key.
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Set sets the value for a key.
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+// Keys lists the keys.
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// startCallSpan starts a span for a gRPC call, adding its trace context to the outgoing metadata.
+func startCallSpan(ctx context.Context, tracer trace.Tracer, method string) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("endpoint", method)),
+	)
+	md, exists := metadata.FromOutgoingContext(ctx)
+	if exists {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	propagator.Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// endCallSpan ends the span for a gRPC call, recording its status.
+func endCallSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// unaryTraceInterceptor returns an interceptor that traces unary gRPC calls.
+func unaryTraceInterceptor(tracer trace.Tracer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := startCallSpan(ctx, tracer, method)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if sizer, isSizer := reply.(interface{ Size() int }); isSizer && err == nil {
+			span.SetAttributes(attribute.Int("response_size", sizer.Size()))
+		}
+		endCallSpan(span, err)
+		return err
+	}
+}
+
+// streamTraceInterceptor returns an interceptor that traces the creation of gRPC streams.
+func streamTraceInterceptor(tracer trace.Tracer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startCallSpan(ctx, tracer, method)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		endCallSpan(span, err)
+		return stream, err
+	}
+}
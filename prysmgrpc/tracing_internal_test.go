@@ -0,0 +1,92 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prysmgrpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sizedReply is a reply with a size.
+type sizedReply struct{}
+
+func (r *sizedReply) Size() int {
+	return 123
+}
+
+func TestUnaryTraceInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode string
+	}{
+		{
+			name:       "Good",
+			statusCode: "OK",
+		},
+		{
+			name:       "NotFound",
+			err:        status.Error(codes.NotFound, "block not found"),
+			statusCode: "NotFound",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+			interceptor := unaryTraceInterceptor(tracerProvider.Tracer(tracerName))
+
+			var traceparent string
+			err := interceptor(context.Background(), "/ethereum.eth.v1alpha1.Node/GetVersion", nil, &sizedReply{}, nil,
+				func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+					md, exists := metadata.FromOutgoingContext(ctx)
+					require.True(t, exists)
+					traceparent = metadataCarrier(md).Get("traceparent")
+					return test.err
+				},
+			)
+			require.Equal(t, test.err, err)
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			require.Equal(t, "/ethereum.eth.v1alpha1.Node/GetVersion", span.Name)
+			require.Equal(t, fmt.Sprintf("00-%s-%s-01", span.SpanContext.TraceID(), span.SpanContext.SpanID()), traceparent)
+			attributes := make(map[attribute.Key]attribute.Value)
+			for _, kv := range span.Attributes {
+				attributes[kv.Key] = kv.Value
+			}
+			require.Equal(t, "/ethereum.eth.v1alpha1.Node/GetVersion", attributes["endpoint"].AsString())
+			require.Equal(t, test.statusCode, attributes["rpc.grpc.status_code"].AsString())
+			if test.err == nil {
+				require.Equal(t, int64(123), attributes["response_size"].AsInt64())
+				require.Equal(t, otelcodes.Unset, span.Status.Code)
+			} else {
+				require.Equal(t, otelcodes.Error, span.Status.Code)
+			}
+		})
+	}
+}
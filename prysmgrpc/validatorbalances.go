@@ -19,12 +19,19 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // PrysmValidatorBalances provides the validator balances for a given state.
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) PrysmValidatorBalances(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	ctx, span := s.startSpan(ctx, "PrysmValidatorBalances",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorPubKeys)),
+	)
+	defer span.End()
+
 	if len(validatorPubKeys) == 0 {
 		return s.validatorBalances(ctx, stateID)
 	}
@@ -46,10 +53,10 @@ func (s *Service) validatorBalances(ctx context.Context, stateID string) (map[sp
 		return nil, errors.Wrap(err, "failed to obtain epoch from state ID")
 	}
 	if epoch == 0 {
-		log.Trace().Msg("Fetching genesis validator balances")
+		s.log.Trace().Msg("Fetching genesis validator balances")
 		validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Genesis{Genesis: true}
 	} else {
-		log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validator balances")
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validator balances")
 		validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: uint64(epoch)}
 	}
 
@@ -57,7 +64,7 @@ func (s *Service) validatorBalances(ctx context.Context, stateID string) (map[sp
 
 	pageToken := ""
 	for i := int32(0); ; i += s.maxPageSize {
-		log.Trace().Msg("Calling ListValidators()")
+		s.log.Trace().Msg("Calling ListValidators()")
 		validatorBalancesReq.PageToken = pageToken
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		validatorBalancesResp, err := conn.ListValidatorBalances(opCtx, validatorBalancesReq)
@@ -98,10 +105,10 @@ func (s *Service) validatorBalancesByPubKeys(ctx context.Context, stateID string
 		return nil, errors.Wrap(err, "failed to obtain epoch from state ID")
 	}
 	if epoch == 0 {
-		log.Trace().Msg("Fetching genesis validator balances")
+		s.log.Trace().Msg("Fetching genesis validator balances")
 		validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Genesis{Genesis: true}
 	} else {
-		log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validator balances")
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validator balances")
 		validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: uint64(epoch)}
 	}
 
@@ -118,7 +125,7 @@ func (s *Service) validatorBalancesByPubKeys(ctx context.Context, stateID string
 		}
 		validatorBalancesReq.PublicKeys = pubKeys[i:lastIndex]
 
-		log.Trace().Msg("Calling ListValidatorBalances()")
+		s.log.Trace().Msg("Calling ListValidatorBalances()")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		validatorBalancesResp, err := conn.ListValidatorBalances(opCtx, validatorBalancesReq)
 		cancel()
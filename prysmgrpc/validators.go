@@ -21,6 +21,7 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Validators provides the validators, with their balance and status, for a given state.
@@ -28,6 +29,12 @@ import (
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators IDs are supplied no filter
 // will be applied.
 func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "Validators",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	pubKeys, err := s.indicesToPubKeys(ctx, validatorIndices)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert indices to public keys")
@@ -41,6 +48,12 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 // validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 // supplied no filter will be applied.
 func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorsByPubKey",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorPubKeys)),
+	)
+	defer span.End()
+
 	if len(validatorPubKeys) == 0 {
 		return s.validators(ctx, stateID, true)
 	}
@@ -53,6 +66,12 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) ValidatorsWithoutBalance(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorsWithoutBalance",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	pubKeys, err := s.indicesToPubKeys(ctx, validatorIndices)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert indices to public keys")
@@ -66,6 +85,12 @@ func (s *Service) ValidatorsWithoutBalance(ctx context.Context, stateID string,
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validators is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) ValidatorsWithoutBalanceByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorsWithoutBalanceByPubKey",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorPubKeys)),
+	)
+	defer span.End()
+
 	if len(validatorPubKeys) == 0 {
 		return s.validators(ctx, stateID, false)
 	}
@@ -88,10 +113,10 @@ func (s *Service) validators(ctx context.Context, stateID string, includeBalance
 
 	validatorsReq := &ethpb.ListValidatorsRequest{}
 	if epoch == 0 {
-		log.Trace().Msg("Fetching genesis validators")
+		s.log.Trace().Msg("Fetching genesis validators")
 		validatorsReq.QueryFilter = &ethpb.ListValidatorsRequest_Genesis{Genesis: true}
 	} else {
-		log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validators")
+		s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validators")
 		validatorsReq.QueryFilter = &ethpb.ListValidatorsRequest_Epoch{Epoch: uint64(epoch)}
 	}
 	farFutureEpoch, err := s.FarFutureEpoch(ctx)
@@ -102,7 +127,7 @@ func (s *Service) validators(ctx context.Context, stateID string, includeBalance
 	res := make(map[spec.ValidatorIndex]*api.Validator)
 	pageToken := ""
 	for i := int32(0); ; i += s.maxPageSize {
-		log.Trace().Msg("Calling ListValidators()")
+		s.log.Trace().Msg("Calling ListValidators()")
 		validatorsReq.PageToken = pageToken
 		validatorsReq.PageSize = s.maxPageSize
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
@@ -191,14 +216,14 @@ func (s *Service) validatorsByPubKeys(ctx context.Context, stateID string, valid
 		PageSize: s.maxPageSize,
 	}
 	if head {
-		log.Trace().Msg("Fetching head validators")
+		s.log.Trace().Msg("Fetching head validators")
 	} else {
 		if epoch == 0 {
-			log.Trace().Msg("Fetching genesis validators")
+			s.log.Trace().Msg("Fetching genesis validators")
 			validatorsReq.QueryFilter = &ethpb.ListValidatorsRequest_Genesis{Genesis: true}
 			validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Genesis{Genesis: true}
 		} else {
-			log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validators")
+			s.log.Trace().Uint64("epoch", uint64(epoch)).Msg("Fetching epoch validators")
 			validatorsReq.QueryFilter = &ethpb.ListValidatorsRequest_Epoch{Epoch: uint64(epoch)}
 			validatorBalancesReq.QueryFilter = &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: uint64(epoch)}
 		}
@@ -226,7 +251,7 @@ func (s *Service) validatorsByPubKeys(ctx context.Context, stateID string, valid
 		}
 
 		validatorsReq.PublicKeys = pubKeys[i:lastIndex]
-		log.Trace().Int("pubkeys", len(validatorsReq.PublicKeys)).Msg("Calling ListValidators()")
+		s.log.Trace().Int("pubkeys", len(validatorsReq.PublicKeys)).Msg("Calling ListValidators()")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		validatorsResp, err := conn.ListValidators(opCtx, validatorsReq)
 		cancel()
@@ -282,7 +307,7 @@ func (s *Service) validatorsByPubKeys(ctx context.Context, stateID string, valid
 
 		validatorBalancesReq.PublicKeys = balancePubKeys[i:lastIndex]
 
-		log.Trace().Msg("Calling ListValidatorBalances()")
+		s.log.Trace().Msg("Calling ListValidatorBalances()")
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
 		validatorBalancesResp, err := conn.ListValidatorBalances(opCtx, validatorBalancesReq)
 		cancel()
@@ -24,6 +24,10 @@ import (
 
 // VoluntaryExitDomain provides the voluntary exit domain of the chain.
 func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "VoluntaryExitDomain")
+	defer span.End()
+
+	s.monitorCache("voluntary_exit_domain", s.voluntaryExitDomain != nil)
 	if s.voluntaryExitDomain == nil {
 		conn := ethpb.NewBeaconChainClient(s.conn)
 		opCtx, cancel := context.WithTimeout(ctx, s.timeout)
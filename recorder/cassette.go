@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Cassette is a set of recorded interactions with a beacon node.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+// Bodies of HTTP requests and responses are stored as-is; gRPC messages are stored as
+// base64-encoded protobuf.
+type Interaction struct {
+	// Protocol is either "http" or "grpc".
+	Protocol string `json:"protocol"`
+	// Method is the HTTP method, or the full gRPC method name.
+	Method string `json:"method"`
+	// Endpoint is the path and query of an HTTP request.
+	Endpoint string `json:"endpoint,omitempty"`
+	// RequestBody is the body of the request.
+	RequestBody string `json:"request_body,omitempty"`
+	// StatusCode is the status code of an HTTP response.
+	StatusCode int `json:"status_code,omitempty"`
+	// Header is the header of an HTTP response.
+	Header http.Header `json:"header,omitempty"`
+	// ResponseBody is the body of the response.
+	ResponseBody string `json:"response_body,omitempty"`
+	// Code is the status code of a failed gRPC call.
+	Code int `json:"code,omitempty"`
+	// Message is the status message of a failed gRPC call.
+	Message string `json:"message,omitempty"`
+	// Error is the error returned by the transport, if the request did not receive a response.
+	Error string `json:"error,omitempty"`
+	// Stream is true if the response is a stream, in which case its data is in Events.
+	Stream bool `json:"stream,omitempty"`
+	// Events are the data received on a stream, with their timing.
+	Events []*Event `json:"events,omitempty"`
+}
+
+// Event is data received on a stream.
+type Event struct {
+	// Offset is the time since the start of the stream at which the data was received.
+	Offset time.Duration `json:"offset"`
+	// Data is the data received; raw text for HTTP event streams, a single message for gRPC streams.
+	Data string `json:"data"`
+}
+
+// loadCassette loads a cassette from a file.
+func loadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read cassette")
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, errors.Wrap(err, "failed to parse cassette")
+	}
+
+	return cassette, nil
+}
+
+// save saves a cassette to a file.
+func (c *Cassette) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal cassette")
+	}
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write cassette")
+	}
+
+	return nil
+}
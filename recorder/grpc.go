@@ -0,0 +1,218 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	grpcproto "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// codec is the codec used to store gRPC messages; it is the codec used by gRPC on the wire.
+var codec = encoding.GetCodec(grpcproto.Name)
+
+// marshal marshals a gRPC message for the cassette.
+func marshal(msg interface{}) (string, error) {
+	data, err := codec.Marshal(msg)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal message")
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// unmarshal unmarshals a gRPC message from the cassette.
+func unmarshal(input string, msg interface{}) error {
+	data, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return errors.Wrap(err, "invalid message encoding")
+	}
+	if err := codec.Unmarshal(data, msg); err != nil {
+		return errors.Wrap(err, "failed to unmarshal message")
+	}
+	return nil
+}
+
+// UnaryClientInterceptor provides a gRPC interceptor that records unary calls, or in replay mode
+// serves them from the cassette without making the call.
+func (r *Recorder) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		request, err := marshal(req)
+		if err != nil {
+			return err
+		}
+
+		if r.mode == ModeReplay {
+			interaction := r.match("grpc", method, "", request, false)
+			if interaction == nil {
+				return fmt.Errorf("%s: %w", method, ErrUnmatched)
+			}
+			if interaction.Code != int(codes.OK) {
+				return status.Error(codes.Code(interaction.Code), interaction.Message)
+			}
+			return unmarshal(interaction.ResponseBody, reply)
+		}
+
+		interaction := &Interaction{
+			Protocol:    "grpc",
+			Method:      method,
+			RequestBody: request,
+		}
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			st := status.Convert(err)
+			interaction.Code = int(st.Code())
+			interaction.Message = st.Message()
+			r.record(interaction)
+			return err
+		}
+		interaction.ResponseBody, err = marshal(reply)
+		if err != nil {
+			return err
+		}
+		r.record(interaction)
+
+		return nil
+	}
+}
+
+// StreamClientInterceptor provides a gRPC interceptor that records server streams, with the timing
+// of their messages, or in replay mode serves them from the cassette without opening the stream.
+// Streams are matched on their method alone.
+func (r *Recorder) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if r.mode == ModeReplay {
+			interaction := r.match("grpc", method, "", "", true)
+			if interaction == nil {
+				return nil, fmt.Errorf("%s: %w", method, ErrUnmatched)
+			}
+			if interaction.Code != int(codes.OK) {
+				return nil, status.Error(codes.Code(interaction.Code), interaction.Message)
+			}
+			return &replayingStream{
+				ctx:    ctx,
+				events: interaction.Events,
+				start:  time.Now(),
+			}, nil
+		}
+
+		interaction := &Interaction{
+			Protocol: "grpc",
+			Method:   method,
+			Stream:   true,
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			st := status.Convert(err)
+			interaction.Code = int(st.Code())
+			interaction.Message = st.Message()
+			r.record(interaction)
+			return nil, err
+		}
+		r.record(interaction)
+
+		return &recordingStream{
+			ClientStream: stream,
+			recorder:     r,
+			interaction:  interaction,
+			start:        time.Now(),
+		}, nil
+	}
+}
+
+// recordingStream records messages received on a stream.
+type recordingStream struct {
+	grpc.ClientStream
+	recorder    *Recorder
+	interaction *Interaction
+	start       time.Time
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	data, err := marshal(m)
+	if err != nil {
+		s.recorder.log.Warn().Err(err).Msg("Failed to record stream message")
+		return nil
+	}
+	s.recorder.recordEvent(s.interaction, &Event{
+		Offset: time.Since(s.start),
+		Data:   data,
+	})
+
+	return nil
+}
+
+// replayingStream replays messages for a stream with their original timing.
+// The stream stays open after the messages have been replayed until its context is done,
+// as it would for a live stream.
+type replayingStream struct {
+	ctx    context.Context
+	events []*Event
+	start  time.Time
+	next   int
+}
+
+// Header implements grpc.ClientStream.
+func (s *replayingStream) Header() (metadata.MD, error) {
+	return metadata.MD{}, nil
+}
+
+// Trailer implements grpc.ClientStream.
+func (s *replayingStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+// CloseSend implements grpc.ClientStream.
+func (s *replayingStream) CloseSend() error {
+	return nil
+}
+
+// Context implements grpc.ClientStream.
+func (s *replayingStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg implements grpc.ClientStream.
+func (s *replayingStream) SendMsg(m interface{}) error {
+	return nil
+}
+
+// RecvMsg implements grpc.ClientStream.
+func (s *replayingStream) RecvMsg(m interface{}) error {
+	if s.next >= len(s.events) {
+		<-s.ctx.Done()
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+
+	event := s.events[s.next]
+	select {
+	case <-time.After(time.Until(s.start.Add(event.Offset))):
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+	s.next++
+
+	return unmarshal(event.Data, m)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/recorder"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// dial dials a gRPC connection through the recorder.
+func dial(ctx context.Context, t *testing.T, address string, r *recorder.Recorder) *grpc.ClientConn {
+	conn, err := grpc.DialContext(ctx, address,
+		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(r.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(r.StreamClientInterceptor()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	return conn
+}
+
+func TestGRPC(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(tempDir(t), "cassette.json")
+
+	// Record, against a server providing the standard health service.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("beacon", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	r, err := recorder.New(recorder.WithMode(recorder.ModeRecord), recorder.WithPath(path))
+	require.NoError(t, err)
+	client := healthpb.NewHealthClient(dial(ctx, t, listener.Addr().String(), r))
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: "beacon"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream, err := client.Watch(streamCtx, &healthpb.HealthCheckRequest{Service: "beacon"})
+	require.NoError(t, err)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	healthServer.SetServingStatus("beacon", healthpb.HealthCheckResponse_NOT_SERVING)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+	cancel()
+	require.NoError(t, r.Save())
+	server.Stop()
+
+	// Replay, without a server.
+	r, err = recorder.New(recorder.WithMode(recorder.ModeReplay), recorder.WithPath(path))
+	require.NoError(t, err)
+	client = healthpb.NewHealthClient(dial(ctx, t, listener.Addr().String(), r))
+	resp, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "beacon"})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{Service: "other"})
+	require.True(t, errors.Is(err, recorder.ErrUnmatched))
+
+	streamCtx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	stream, err = client.Watch(streamCtx, &healthpb.HealthCheckRequest{Service: "beacon"})
+	require.NoError(t, err)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	// The stream stays open until cancelled.
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+	_, err = stream.Recv()
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
@@ -0,0 +1,220 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// roundTripper records or replays HTTP interactions.
+type roundTripper struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+// RoundTripper provides an HTTP round tripper that records requests passed to next, or in
+// replay mode serves them from the cassette without calling next.
+// Responses with a content type of text/event-stream are recorded as streams, with the timing
+// of their data.
+func (r *Recorder) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{
+		recorder: r,
+		next:     next,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		data, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read request body")
+		}
+		if err := req.Body.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to close request body")
+		}
+		body = string(data)
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+	}
+
+	if t.recorder.mode == ModeReplay {
+		return t.replay(req, body)
+	}
+	return t.record(req, body)
+}
+
+// record passes the request to the next round tripper and records the interaction.
+func (t *roundTripper) record(req *http.Request, body string) (*http.Response, error) {
+	interaction := &Interaction{
+		Protocol:    "http",
+		Method:      req.Method,
+		Endpoint:    req.URL.RequestURI(),
+		RequestBody: body,
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		interaction.Error = err.Error()
+		t.recorder.record(interaction)
+		return nil, err
+	}
+	interaction.StatusCode = resp.StatusCode
+	interaction.Header = resp.Header.Clone()
+
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/event-stream") {
+		interaction.Stream = true
+		t.recorder.record(interaction)
+		resp.Body = &recordingBody{
+			ReadCloser:  resp.Body,
+			recorder:    t.recorder,
+			interaction: interaction,
+			start:       time.Now(),
+		}
+		return resp, nil
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if closeErr := resp.Body.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+	interaction.ResponseBody = string(data)
+	t.recorder.record(interaction)
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return resp, nil
+}
+
+// replay serves the request from the cassette.
+func (t *roundTripper) replay(req *http.Request, body string) (*http.Response, error) {
+	interaction := t.recorder.match("http", req.Method, req.URL.RequestURI(), body, false)
+	if interaction == nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.RequestURI(), ErrUnmatched)
+	}
+	if interaction.Error != "" {
+		return nil, errors.New(interaction.Error)
+	}
+
+	resp := &http.Response{
+		Status:     fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode: interaction.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     interaction.Header.Clone(),
+		Request:    req,
+	}
+	if resp.Header == nil {
+		resp.Header = make(http.Header)
+	}
+
+	if !interaction.Stream {
+		resp.Body = ioutil.NopCloser(strings.NewReader(interaction.ResponseBody))
+		resp.ContentLength = int64(len(interaction.ResponseBody))
+		return resp, nil
+	}
+
+	// Copy the events, as the recorder may be recording to the same interaction.
+	t.recorder.mu.Lock()
+	events := make([]*Event, len(interaction.Events))
+	copy(events, interaction.Events)
+	t.recorder.mu.Unlock()
+
+	reader, writer := io.Pipe()
+	replayBody := &replayingBody{
+		PipeReader: reader,
+		closed:     make(chan struct{}),
+	}
+	resp.Body = replayBody
+	resp.ContentLength = -1
+	go replayBody.replay(req, writer, events)
+
+	return resp, nil
+}
+
+// recordingBody records data from a streamed response body as it is read.
+type recordingBody struct {
+	io.ReadCloser
+	recorder    *Recorder
+	interaction *Interaction
+	start       time.Time
+}
+
+// Read implements io.Reader.
+func (b *recordingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.recorder.recordEvent(b.interaction, &Event{
+			Offset: time.Since(b.start),
+			Data:   string(p[:n]),
+		})
+	}
+	return n, err
+}
+
+// replayingBody replays data for a streamed response body with its original timing.
+// The stream stays open after the data has been replayed until the request is cancelled or
+// the body is closed, as it would for a live stream.
+type replayingBody struct {
+	*io.PipeReader
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Close implements io.Closer.
+func (b *replayingBody) Close() error {
+	b.closeOnce.Do(func() {
+		close(b.closed)
+	})
+	return b.PipeReader.Close()
+}
+
+// replay writes the events to the pipe at their offsets.
+func (b *replayingBody) replay(req *http.Request, writer *io.PipeWriter, events []*Event) {
+	start := time.Now()
+	for _, event := range events {
+		select {
+		case <-time.After(time.Until(start.Add(event.Offset))):
+		case <-req.Context().Done():
+			_ = writer.CloseWithError(req.Context().Err())
+			return
+		case <-b.closed:
+			return
+		}
+		if _, err := writer.Write([]byte(event.Data)); err != nil {
+			return
+		}
+	}
+
+	select {
+	case <-req.Context().Done():
+		_ = writer.CloseWithError(req.Context().Err())
+	case <-b.closed:
+	}
+}
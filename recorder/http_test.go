@@ -0,0 +1,141 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/fakenode"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/recorder"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTP(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(tempDir(t), "cassette.json")
+
+	// Record.
+	server, err := fakenode.New(ctx)
+	require.NoError(t, err)
+	r, err := recorder.New(recorder.WithMode(recorder.ModeRecord), recorder.WithPath(path))
+	require.NoError(t, err)
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithAddress(server.Address()),
+		standardhttp.WithRecorder(r),
+	)
+	require.NoError(t, err)
+	recordedGenesis, err := service.Genesis(ctx)
+	require.NoError(t, err)
+	recordedHeader, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	_, err = service.BeaconBlockHeader(ctx, "1000")
+	require.Error(t, err)
+	require.NoError(t, r.Save())
+	server.Close()
+
+	// Replay, without a node.
+	r, err = recorder.New(recorder.WithMode(recorder.ModeReplay), recorder.WithPath(path))
+	require.NoError(t, err)
+	service, err = standardhttp.New(ctx,
+		standardhttp.WithAddress(server.Address()),
+		standardhttp.WithRecorder(r),
+	)
+	require.NoError(t, err)
+	genesis, err := service.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, recordedGenesis, genesis)
+	header, err := service.BeaconBlockHeader(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, recordedHeader.Root, header.Root)
+	_, err = service.BeaconBlockHeader(ctx, "1000")
+	require.Error(t, err)
+
+	// Each interaction is replayed once.
+	_, err = service.BeaconBlockHeader(ctx, "head")
+	require.True(t, errors.Is(err, recorder.ErrUnmatched))
+	_, err = service.BeaconBlockHeader(ctx, "genesis")
+	require.True(t, errors.Is(err, recorder.ErrUnmatched))
+}
+
+func TestHTTPEvents(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(tempDir(t), "cassette.json")
+
+	// Record.
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	server, err := fakenode.New(ctx, fakenode.WithService(mockService))
+	require.NoError(t, err)
+	r, err := recorder.New(recorder.WithMode(recorder.ModeRecord), recorder.WithPath(path))
+	require.NoError(t, err)
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithAddress(server.Address()),
+		standardhttp.WithRecorder(r),
+	)
+	require.NoError(t, err)
+	recorded := collectHeads(ctx, t, service, func() {
+		require.NoError(t, mockService.Advance(ctx))
+	})
+	require.NoError(t, r.Save())
+	server.Close()
+
+	// Replay, without a node.
+	r, err = recorder.New(recorder.WithMode(recorder.ModeReplay), recorder.WithPath(path))
+	require.NoError(t, err)
+	service, err = standardhttp.New(ctx,
+		standardhttp.WithAddress(server.Address()),
+		standardhttp.WithRecorder(r),
+	)
+	require.NoError(t, err)
+	replayed := collectHeads(ctx, t, service, func() {})
+	require.Equal(t, recorded[0].Slot, replayed[0].Slot)
+	require.Equal(t, recorded[0].Block, replayed[0].Block)
+}
+
+// collectHeads subscribes to head events, calling step until at least one has been received.
+func collectHeads(ctx context.Context, t *testing.T, service *standardhttp.Service, step func()) []*api.HeadEvent {
+	eventsCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	heads := make([]*api.HeadEvent, 0)
+	require.NoError(t, service.Events(eventsCtx, []string{"head"}, func(event *api.Event) {
+		mu.Lock()
+		heads = append(heads, event.Data.(*api.HeadEvent))
+		mu.Unlock()
+	}))
+	for i := 0; i < 50; i++ {
+		step()
+		time.Sleep(100 * time.Millisecond)
+		mu.Lock()
+		received := len(heads)
+		mu.Unlock()
+		if received > 0 {
+			break
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, heads)
+	return heads
+}
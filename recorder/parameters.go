@@ -0,0 +1,94 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel zerolog.Level
+	logger   zerolog.Logger
+	mode     Mode
+	path     string
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithMode sets the mode of the recorder.
+func WithMode(mode Mode) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.mode = mode
+	})
+}
+
+// WithPath sets the path of the cassette file.
+func WithPath(path string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.path = path
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	switch parameters.mode {
+	case ModeRecord, ModeReplay:
+	case ModeUnknown:
+		return nil, errors.New("no mode specified")
+	default:
+		return nil, errors.New("invalid mode specified")
+	}
+	if parameters.path == "" {
+		return nil, errors.New("no path specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,135 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder
+
+import (
+	"errors"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Mode is the mode of a recorder.
+type Mode int
+
+const (
+	// ModeUnknown is an unset mode.
+	ModeUnknown Mode = iota
+	// ModeRecord passes requests to the node and records the interactions.
+	ModeRecord
+	// ModeReplay serves requests from the cassette without contacting the node.
+	ModeReplay
+)
+
+// ErrUnmatched is returned in replay mode when a request has no unused matching interaction in the cassette.
+var ErrUnmatched = errors.New("no matching interaction in cassette")
+
+// Recorder records interactions with a beacon node to a cassette, or replays them from it.
+type Recorder struct {
+	log zerolog.Logger
+
+	mode Mode
+	path string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     map[*Interaction]bool
+}
+
+// New creates a new recorder.
+// In replay mode the cassette is loaded from its path immediately.
+func New(params ...Parameter) (*Recorder, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "recorder").Str("path", parameters.path).Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	r := &Recorder{
+		log:      log,
+		mode:     parameters.mode,
+		path:     parameters.path,
+		cassette: &Cassette{},
+		used:     make(map[*Interaction]bool),
+	}
+	if r.mode == ModeReplay {
+		r.cassette, err = loadCassette(r.path)
+		if err != nil {
+			return nil, err
+		}
+		log.Trace().Str("path", r.path).Int("interactions", len(r.cassette.Interactions)).Msg("Loaded cassette")
+	}
+
+	return r, nil
+}
+
+// Mode provides the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Save saves the recorded interactions to the cassette file.
+// Interactions on streams that are still open are saved as far as they have progressed.
+// This does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.save(r.path)
+}
+
+// record adds an interaction to the cassette.
+func (r *Recorder) record(interaction *Interaction) {
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+}
+
+// recordEvent adds an event to a recorded stream.
+func (r *Recorder) recordEvent(interaction *Interaction, event *Event) {
+	r.mu.Lock()
+	interaction.Events = append(interaction.Events, event)
+	r.mu.Unlock()
+}
+
+// match finds the first unused interaction that matches the request, and marks it as used.
+// The request body is not matched for streams.
+func (r *Recorder) match(protocol string, method string, endpoint string, body string, stream bool) *Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, interaction := range r.cassette.Interactions {
+		if r.used[interaction] ||
+			interaction.Protocol != protocol ||
+			interaction.Method != method ||
+			interaction.Endpoint != endpoint ||
+			(!stream && interaction.RequestBody != body) {
+			continue
+		}
+		r.used[interaction] = true
+		return interaction
+	}
+
+	return nil
+}
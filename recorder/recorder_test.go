@@ -0,0 +1,82 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recorder_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/recorder"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	dir := tempDir(t)
+
+	tests := []struct {
+		name   string
+		params []recorder.Parameter
+		err    string
+	}{
+		{
+			name: "ModeMissing",
+			params: []recorder.Parameter{
+				recorder.WithPath(filepath.Join(dir, "cassette.json")),
+			},
+			err: "problem with parameters: no mode specified",
+		},
+		{
+			name: "ModeInvalid",
+			params: []recorder.Parameter{
+				recorder.WithMode(99),
+				recorder.WithPath(filepath.Join(dir, "cassette.json")),
+			},
+			err: "problem with parameters: invalid mode specified",
+		},
+		{
+			name: "PathMissing",
+			params: []recorder.Parameter{
+				recorder.WithMode(recorder.ModeRecord),
+			},
+			err: "problem with parameters: no path specified",
+		},
+		{
+			name: "ReplayMissingCassette",
+			params: []recorder.Parameter{
+				recorder.WithMode(recorder.ModeReplay),
+				recorder.WithPath(filepath.Join(dir, "missing.json")),
+			},
+			err: "failed to read cassette: open " + filepath.Join(dir, "missing.json") + ": no such file or directory",
+		},
+		{
+			name: "Record",
+			params: []recorder.Parameter{
+				recorder.WithMode(recorder.ModeRecord),
+				recorder.WithPath(filepath.Join(dir, "cassette.json")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, err := recorder.New(test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, r.Save())
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// stateRootJSON is the standard API representation of a state root.
+type stateRootJSON struct {
+	Root string `json:"root"`
+}
+
+func (s *Service) genesis(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.GenesisProvider)
+	if !isProvider {
+		return nil, notSupported("genesis")
+	}
+	genesis, err := provider.Genesis(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if genesis == nil {
+		return nil, fmt.Errorf("genesis not found: %w", client.ErrNotFound)
+	}
+
+	return genesis, nil
+}
+
+func (s *Service) stateRoot(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.StateIDResolver)
+	if !isProvider {
+		return nil, notSupported("state root")
+	}
+	stateID, err := api.ParseStateID(params[0])
+	if err != nil {
+		return nil, badRequest("invalid state ID: %v", err)
+	}
+	resolved, err := provider.ResolveStateID(r.Context(), stateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateRootJSON{Root: fmt.Sprintf("%#x", resolved.Root)}, nil
+}
+
+func (s *Service) fork(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ForkProvider)
+	if !isProvider {
+		return nil, notSupported("fork")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+	fork, err := provider.Fork(r.Context(), params[0])
+	if err != nil {
+		return nil, err
+	}
+	if fork == nil {
+		return nil, fmt.Errorf("fork for state %s not found: %w", params[0], client.ErrNotFound)
+	}
+
+	return fork, nil
+}
+
+func (s *Service) finality(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.FinalityProvider)
+	if !isProvider {
+		return nil, notSupported("finality")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+	finality, err := provider.Finality(r.Context(), params[0])
+	if err != nil {
+		return nil, err
+	}
+	if finality == nil {
+		return nil, fmt.Errorf("finality for state %s not found: %w", params[0], client.ErrNotFound)
+	}
+
+	return finality, nil
+}
+
+func (s *Service) validators(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ValidatorsProvider)
+	if !isProvider {
+		return nil, notSupported("validators")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+
+	// IDs are either all indices or all public keys.
+	ids := r.URL.Query()["id"]
+	var validators map[spec.ValidatorIndex]*api.Validator
+	if len(ids) > 0 && strings.HasPrefix(ids[0], "0x") {
+		pubKeys := make([]spec.BLSPubKey, 0)
+		for _, input := range ids {
+			for _, item := range strings.Split(input, ",") {
+				data, err := parseFixedBytes("id", item, len(spec.BLSPubKey{}))
+				if err != nil {
+					return nil, err
+				}
+				var pubKey spec.BLSPubKey
+				copy(pubKey[:], data)
+				pubKeys = append(pubKeys, pubKey)
+			}
+		}
+		var err error
+		validators, err = provider.ValidatorsByPubKey(r.Context(), params[0], pubKeys)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		indices, err := parseValidatorIndices("id", ids)
+		if err != nil {
+			return nil, err
+		}
+		validators, err = provider.Validators(r.Context(), params[0], indices)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := make([]*api.Validator, 0, len(validators))
+	for _, validator := range validators {
+		res = append(res, validator)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Index < res[j].Index
+	})
+
+	return res, nil
+}
+
+func (s *Service) validator(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ValidatorsProvider)
+	if !isProvider {
+		return nil, notSupported("validators")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+
+	// The ID is either an index or a public key.
+	var validators map[spec.ValidatorIndex]*api.Validator
+	if strings.HasPrefix(params[1], "0x") {
+		data, err := parseFixedBytes("validator_id", params[1], len(spec.BLSPubKey{}))
+		if err != nil {
+			return nil, err
+		}
+		var pubKey spec.BLSPubKey
+		copy(pubKey[:], data)
+		validators, err = provider.ValidatorsByPubKey(r.Context(), params[0], []spec.BLSPubKey{pubKey})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		index, err := parseUint64("validator_id", params[1])
+		if err != nil {
+			return nil, err
+		}
+		validators, err = provider.Validators(r.Context(), params[0], []spec.ValidatorIndex{spec.ValidatorIndex(index)})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, validator := range validators {
+		return validator, nil
+	}
+	return nil, fmt.Errorf("validator %s not found: %w", params[1], client.ErrNotFound)
+}
+
+func (s *Service) validatorBalances(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ValidatorBalancesProvider)
+	if !isProvider {
+		return nil, notSupported("validator balances")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+	indices, err := parseValidatorIndices("id", r.URL.Query()["id"])
+	if err != nil {
+		return nil, err
+	}
+	balances, err := provider.ValidatorBalances(r.Context(), params[0], indices)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*api.ValidatorBalance, 0, len(balances))
+	for index, balance := range balances {
+		res = append(res, &api.ValidatorBalance{
+			Index:   index,
+			Balance: balance,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Index < res[j].Index
+	})
+
+	return res, nil
+}
+
+func (s *Service) beaconCommittees(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.BeaconCommitteesProvider)
+	if !isProvider {
+		return nil, notSupported("beacon committees")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+	return provider.BeaconCommittees(r.Context(), params[0])
+}
+
+func (s *Service) beaconBlockHeader(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.BeaconBlockHeadersProvider)
+	if !isProvider {
+		return nil, notSupported("beacon block headers")
+	}
+	if err := checkBlockID(params[0]); err != nil {
+		return nil, err
+	}
+	header, err := provider.BeaconBlockHeader(r.Context(), params[0])
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return nil, fmt.Errorf("block %s not found: %w", params[0], client.ErrNotFound)
+	}
+
+	return header, nil
+}
+
+func (s *Service) signedBeaconBlock(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.SignedBeaconBlockProvider)
+	if !isProvider {
+		return nil, notSupported("signed beacon blocks")
+	}
+	if err := checkBlockID(params[0]); err != nil {
+		return nil, err
+	}
+	block, err := provider.SignedBeaconBlock(r.Context(), params[0])
+	if err != nil {
+		return nil, err
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block %s not found: %w", params[0], client.ErrNotFound)
+	}
+
+	return block, nil
+}
+
+func (s *Service) submitBeaconBlock(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	submitter, isSubmitter := s.service.(client.BeaconBlockSubmitter)
+	if !isSubmitter {
+		return nil, notSupported("beacon block submission")
+	}
+	block := &spec.SignedBeaconBlock{}
+	if err := json.NewDecoder(r.Body).Decode(block); err != nil {
+		return nil, badRequest("invalid block: %v", err)
+	}
+
+	return nil, submitter.SubmitBeaconBlock(r.Context(), block)
+}
+
+func (s *Service) attestationPool(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.AttestationPoolProvider)
+	if !isProvider {
+		return nil, notSupported("attestation pool")
+	}
+	slot, err := parseUint64("slot", r.URL.Query().Get("slot"))
+	if err != nil {
+		return nil, err
+	}
+	attestations, err := provider.AttestationPool(r.Context(), spec.Slot(slot))
+	if err != nil {
+		return nil, err
+	}
+	if attestations == nil {
+		attestations = make([]*spec.Attestation, 0)
+	}
+
+	return attestations, nil
+}
+
+func (s *Service) submitAttestations(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	submitter, isSubmitter := s.service.(client.AttestationsSubmitter)
+	if !isSubmitter {
+		return nil, notSupported("attestation submission")
+	}
+	attestations := make([]*spec.Attestation, 0)
+	if err := json.NewDecoder(r.Body).Decode(&attestations); err != nil {
+		return nil, badRequest("invalid attestations: %v", err)
+	}
+
+	return nil, submitter.SubmitAttestations(r.Context(), attestations)
+}
+
+func (s *Service) submitVoluntaryExit(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	submitter, isSubmitter := s.service.(client.VoluntaryExitSubmitter)
+	if !isSubmitter {
+		return nil, notSupported("voluntary exit submission")
+	}
+	voluntaryExit := &spec.SignedVoluntaryExit{}
+	if err := json.NewDecoder(r.Body).Decode(voluntaryExit); err != nil {
+		return nil, badRequest("invalid voluntary exit: %v", err)
+	}
+
+	return nil, submitter.SubmitVoluntaryExit(r.Context(), voluntaryExit)
+}
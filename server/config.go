@@ -0,0 +1,86 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+func (s *Service) spec(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.SpecProvider)
+	if !isProvider {
+		return nil, notSupported("spec")
+	}
+	values, err := provider.Spec(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// The standard API represents all spec values as strings.
+	res := make(map[string]string, len(values))
+	for k, v := range values {
+		switch val := v.(type) {
+		case time.Duration:
+			res[k] = fmt.Sprintf("%d", uint64(val.Seconds()))
+		case time.Time:
+			res[k] = fmt.Sprintf("%d", val.Unix())
+		case spec.Version:
+			res[k] = fmt.Sprintf("%#x", val[:])
+		case spec.DomainType:
+			res[k] = fmt.Sprintf("%#x", val[:])
+		case []byte:
+			res[k] = fmt.Sprintf("%#x", val)
+		case string:
+			res[k] = val
+		default:
+			res[k] = fmt.Sprintf("%v", val)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) forkSchedule(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ForkScheduleProvider)
+	if !isProvider {
+		return nil, notSupported("fork schedule")
+	}
+	return provider.ForkSchedule(r.Context())
+}
+
+func (s *Service) depositContract(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.DepositContractProvider)
+	if !isProvider {
+		return nil, notSupported("deposit contract")
+	}
+	address, err := provider.DepositContractAddress(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := provider.DepositContractChainID(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DepositContract{
+		ChainID: chainID,
+		Address: address,
+	}, nil
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+func (s *Service) beaconState(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.BeaconStateProvider)
+	if !isProvider {
+		return nil, notSupported("beacon states")
+	}
+	if err := checkStateID(params[0]); err != nil {
+		return nil, err
+	}
+	state, err := provider.BeaconState(r.Context(), params[0])
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, fmt.Errorf("state %s not found: %w", params[0], client.ErrNotFound)
+	}
+
+	return state, nil
+}
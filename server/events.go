@@ -0,0 +1,90 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// eventBufferSize is the number of events buffered for each stream.
+const eventBufferSize = 64
+
+// events streams events to the client as server-sent events, until the client disconnects.
+func (s *Service) events(w http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.EventsProvider)
+	if !isProvider {
+		return nil, notSupported("events")
+	}
+	flusher, isFlusher := w.(http.Flusher)
+	if !isFlusher {
+		return nil, fmt.Errorf("streaming not supported: %w", client.ErrNotSupported)
+	}
+
+	topics := make([]string, 0)
+	for _, input := range r.URL.Query()["topics"] {
+		topics = append(topics, strings.Split(input, ",")...)
+	}
+	if len(topics) == 0 {
+		return nil, badRequest("topics missing")
+	}
+
+	// The service calls the handler synchronously, so pass events through a buffer to avoid
+	// blocking it on a slow client.
+	events := make(chan *api.Event, eventBufferSize)
+	ctx := r.Context()
+	if err := provider.Events(ctx, topics, func(event *api.Event) {
+		select {
+		case events <- event:
+		case <-ctx.Done():
+		default:
+			s.log.Warn().Str("topic", event.Topic).Msg("Event buffer full; dropping event")
+		}
+	}); err != nil {
+		if errors.Is(err, client.ErrNotSupported) {
+			return nil, err
+		}
+		return nil, badRequest("failed to subscribe to events: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case event := <-events:
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				s.log.Error().Err(err).Str("topic", event.Topic).Msg("Failed to marshal event")
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Topic, data); err != nil {
+				s.log.Debug().Err(err).Msg("Failed to write event; closing stream")
+				return nil, nil
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// nodeVersionJSON is the standard API representation of the node version.
+type nodeVersionJSON struct {
+	Version string `json:"version"`
+}
+
+func (s *Service) nodeVersion(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.NodeVersionProvider)
+	if !isProvider {
+		return nil, notSupported("node version")
+	}
+	version, err := provider.NodeVersion(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodeVersionJSON{Version: version}, nil
+}
+
+func (s *Service) nodeSyncing(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.NodeSyncingProvider)
+	if !isProvider {
+		return nil, notSupported("node syncing")
+	}
+	return provider.NodeSyncing(r.Context())
+}
+
+func (s *Service) nodeHealth(w http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.NodeSyncingProvider)
+	if !isProvider {
+		return nil, notSupported("node health")
+	}
+	syncState, err := provider.NodeSyncing(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// The standard API reports health with the status code alone.
+	if syncState.IsSyncing {
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	return nil, nil
+}
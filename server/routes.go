@@ -0,0 +1,173 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// errBadRequest is returned by handlers when the request is invalid.
+var errBadRequest = errors.New("bad request")
+
+// handlerFunc handles a request, returning the data for the response.
+// If the handler returns nil data it is assumed to have written its own response.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params []string) (interface{}, error)
+
+// route is a route to a handler.
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler handlerFunc
+}
+
+// buildRoutes builds the routes for the API.
+func (s *Service) buildRoutes() []*route {
+	routes := []struct {
+		method  string
+		pattern string
+		handler handlerFunc
+	}{
+		{http.MethodGet, `/eth/v1/node/version`, s.nodeVersion},
+		{http.MethodGet, `/eth/v1/node/syncing`, s.nodeSyncing},
+		{http.MethodGet, `/eth/v1/node/health`, s.nodeHealth},
+		{http.MethodGet, `/eth/v1/config/spec`, s.spec},
+		{http.MethodGet, `/eth/v1/config/fork_schedule`, s.forkSchedule},
+		{http.MethodGet, `/eth/v1/config/deposit_contract`, s.depositContract},
+		{http.MethodGet, `/eth/v1/beacon/genesis`, s.genesis},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/root`, s.stateRoot},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/fork`, s.fork},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/finality_checkpoints`, s.finality},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/validators`, s.validators},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/validators/([^/]+)`, s.validator},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/validator_balances`, s.validatorBalances},
+		{http.MethodGet, `/eth/v1/beacon/states/([^/]+)/committees`, s.beaconCommittees},
+		{http.MethodGet, `/eth/v1/beacon/headers/([^/]+)`, s.beaconBlockHeader},
+		{http.MethodGet, `/eth/v1/beacon/blocks/([^/]+)`, s.signedBeaconBlock},
+		{http.MethodPost, `/eth/v1/beacon/blocks`, s.submitBeaconBlock},
+		{http.MethodGet, `/eth/v1/beacon/pool/attestations`, s.attestationPool},
+		{http.MethodPost, `/eth/v1/beacon/pool/attestations`, s.submitAttestations},
+		{http.MethodPost, `/eth/v1/beacon/pool/voluntary_exits`, s.submitVoluntaryExit},
+		{http.MethodPost, `/eth/v1/validator/duties/attester/([0-9]+)`, s.attesterDuties},
+		{http.MethodGet, `/eth/v1/validator/duties/attester/([0-9]+)`, s.attesterDuties},
+		{http.MethodGet, `/eth/v1/validator/duties/proposer/([0-9]+)`, s.proposerDuties},
+		{http.MethodGet, `/eth/v1/validator/attestation_data`, s.attestationData},
+		{http.MethodGet, `/eth/v1/validator/aggregate_attestation`, s.aggregateAttestation},
+		{http.MethodGet, `/eth/v1/validator/blocks/([0-9]+)`, s.beaconBlockProposal},
+		{http.MethodPost, `/eth/v1/validator/beacon_committee_subscriptions`, s.submitBeaconCommitteeSubscriptions},
+		{http.MethodPost, `/eth/v1/validator/aggregate_and_proofs`, s.submitAggregateAttestations},
+		{http.MethodGet, `/eth/v1/events`, s.events},
+		{http.MethodGet, `/eth/v1/debug/beacon/states/([^/]+)`, s.beaconState},
+	}
+
+	res := make([]*route, len(routes))
+	for i := range routes {
+		res[i] = &route{
+			method:  routes[i].method,
+			pattern: regexp.MustCompile(fmt.Sprintf("^%s$", routes[i].pattern)),
+			handler: routes[i].handler,
+		}
+	}
+	return res
+}
+
+// notSupported is the error returned when the service does not provide the data for a request.
+func notSupported(name string) error {
+	return fmt.Errorf("service does not provide %s: %w", name, client.ErrNotSupported)
+}
+
+// badRequest is the error returned when a request is invalid.
+func badRequest(format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), errBadRequest)
+}
+
+// checkStateID checks that a state ID from a request is valid.
+func checkStateID(input string) error {
+	if _, err := api.ParseStateID(input); err != nil {
+		return badRequest("invalid state ID: %v", err)
+	}
+	return nil
+}
+
+// checkBlockID checks that a block ID from a request is valid.
+func checkBlockID(input string) error {
+	if _, err := api.ParseBlockID(input); err != nil {
+		return badRequest("invalid block ID: %v", err)
+	}
+	return nil
+}
+
+// parseUint64 parses an unsigned integer from a request.
+func parseUint64(name string, input string) (uint64, error) {
+	if input == "" {
+		return 0, badRequest("%s missing", name)
+	}
+	val, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, badRequest("invalid value for %s", name)
+	}
+	return val, nil
+}
+
+// parseFixedBytes parses a hex input parameter that must be of a given length.
+func parseFixedBytes(name string, input string, length int) ([]byte, error) {
+	val, err := parseBytes(name, input)
+	if err != nil {
+		return nil, err
+	}
+	if len(val) != length {
+		return nil, badRequest("invalid length for %s", name)
+	}
+	return val, nil
+}
+
+// parseBytes parses a hex string from a request.
+func parseBytes(name string, input string) ([]byte, error) {
+	if input == "" {
+		return nil, badRequest("%s missing", name)
+	}
+	val, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return nil, badRequest("invalid value for %s", name)
+	}
+	return val, nil
+}
+
+// parseValidatorIndices parses a list of validator indices from a request.
+// Indices can be supplied as separate parameters or comma-separated values.
+func parseValidatorIndices(name string, inputs []string) ([]spec.ValidatorIndex, error) {
+	res := make([]spec.ValidatorIndex, 0)
+	for _, input := range inputs {
+		for _, item := range strings.Split(input, ",") {
+			if item == "" {
+				continue
+			}
+			index, err := parseUint64(name, item)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, spec.ValidatorIndex(index))
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,210 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service serves the standard beacon node API over HTTP from a client service, for example to provide the
+// standard API for a beacon node that only has a gRPC API.
+// Endpoints that need a provider interface the service does not implement respond with 501 Not Implemented.
+type Service struct {
+	log zerolog.Logger
+
+	service client.Service
+	routes  []*route
+
+	// The HTTP server, if listening.
+	listener net.Listener
+	server   *http.Server
+	// cancel cancels the contexts of requests to the HTTP server, ending event streams.
+	cancel context.CancelFunc
+}
+
+// New creates a new server for the standard beacon node API.
+// If a listen address is supplied the server listens on it until the context is done or it is closed;
+// otherwise it is used as an http.Handler.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "server").Str("impl", "standardv1").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:     log,
+		service: parameters.service,
+	}
+	s.routes = s.buildRoutes()
+
+	if parameters.listenAddress == "" {
+		return s, nil
+	}
+
+	listener, err := net.Listen("tcp", parameters.listenAddress)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to listen")
+	}
+	baseCtx, cancel := context.WithCancel(ctx)
+	s.listener = listener
+	s.cancel = cancel
+	s.server = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return baseCtx
+		},
+	}
+	go func(s *Service) {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error().Err(err).Msg("Server failed")
+		}
+	}(s)
+	log.Trace().Str("address", s.Address()).Msg("Listening")
+
+	// Close the server on context done.
+	go func(s *Service) {
+		<-ctx.Done()
+		log.Trace().Msg("Context done; closing server")
+		if err := s.Close(context.Background()); err != nil {
+			log.Warn().Err(err).Msg("Failed to close server")
+		}
+	}(s)
+
+	return s, nil
+}
+
+// Address provides the address on which the server is listening, for example "127.0.0.1:5052".
+// It is empty if the server is not listening.
+func (s *Service) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// Close stops the server listening, ends event streams and waits for other requests to complete.
+// It returns an error if the context is done first.
+func (s *Service) Close(ctx context.Context) error {
+	if s.server == nil {
+		return nil
+	}
+	s.cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		return pkgerrors.Wrap(err, "failed to shut down server")
+	}
+	return nil
+}
+
+// ServeHTTP serves a request to the API.
+func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.log.Trace().Str("method", r.Method).Str("url", r.URL.String()).Msg("Request")
+
+	pathMatched := false
+	for _, route := range s.routes {
+		matches := route.pattern.FindStringSubmatch(r.URL.Path)
+		if matches == nil {
+			continue
+		}
+		pathMatched = true
+		if route.method != r.Method {
+			continue
+		}
+		data, err := route.handler(w, r, matches[1:])
+		if err != nil {
+			writeError(w, errorStatusCode(err), err.Error())
+			return
+		}
+		if data != nil {
+			writeData(w, data)
+		}
+		return
+	}
+
+	if pathMatched {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeError(w, http.StatusNotFound, "endpoint not found")
+}
+
+// errorStatusCode provides the status code for an error from the service.
+func errorStatusCode(err error) int {
+	var apiErr *client.APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr.StatusCode
+	case errors.Is(err, client.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, client.ErrNotSupported):
+		return http.StatusNotImplemented
+	case errors.Is(err, client.ErrSyncing):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, errBadRequest), errors.Is(err, client.ErrBadRequest):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// dataJSON is the standard API representation of a successful response.
+type dataJSON struct {
+	Data interface{} `json:"data"`
+}
+
+// writeData writes a successful response.
+func writeData(w http.ResponseWriter, data interface{}) {
+	body, err := json.Marshal(&dataJSON{Data: data})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// errorJSON is the standard API representation of an error.
+type errorJSON struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeError writes an error response.
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	body, err := json.Marshal(&errorJSON{
+		Code:    statusCode,
+		Message: message,
+	})
+	if err != nil {
+		body = []byte(`{}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(body)
+}
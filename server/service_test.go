@@ -0,0 +1,307 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	"github.com/attestantio/go-eth2-client/server"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		params []server.Parameter
+		err    string
+	}{
+		{
+			name: "ServiceMissing",
+			params: []server.Parameter{
+				server.WithListenAddress("127.0.0.1:0"),
+			},
+			err: "problem with parameters: no service specified",
+		},
+		{
+			name: "ListenAddressInvalid",
+			params: []server.Parameter{
+				server.WithService(mockService),
+				server.WithListenAddress("invalid"),
+			},
+			err: "failed to listen: listen tcp: address invalid: missing port in address",
+		},
+		{
+			name: "Handler",
+			params: []server.Parameter{
+				server.WithService(mockService),
+			},
+		},
+		{
+			name: "Good",
+			params: []server.Parameter{
+				server.WithService(mockService),
+				server.WithListenAddress("127.0.0.1:0"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := server.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, s.Close(ctx))
+		})
+	}
+}
+
+func TestGateway(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, mockService.Advance(ctx))
+	}
+	s, err := server.New(ctx,
+		server.WithService(mockService),
+		server.WithListenAddress("127.0.0.1:0"),
+	)
+	require.NoError(t, err)
+
+	// The standard client talks to the mock through the server.
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithAddress(s.Address()),
+		standardhttp.WithTimeout(5*time.Second),
+	)
+	require.NoError(t, err)
+
+	expectedFork, err := mockService.Fork(ctx, "head")
+	require.NoError(t, err)
+	fork, err := service.Fork(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, expectedFork, fork)
+
+	expectedDuties, err := mockService.AttesterDuties(ctx, 2, []spec.ValidatorIndex{0, 1, 2})
+	require.NoError(t, err)
+	duties, err := service.AttesterDuties(ctx, 2, []spec.ValidatorIndex{0, 1, 2})
+	require.NoError(t, err)
+	require.Equal(t, expectedDuties, duties)
+
+	tests := []struct {
+		name       string
+		endpoint   string
+		statusCode int
+	}{
+		{
+			name:       "Health",
+			endpoint:   "/eth/v1/node/health",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "ValidatorByIndex",
+			endpoint:   "/eth/v1/beacon/states/head/validators/1",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "ValidatorUnknown",
+			endpoint:   "/eth/v1/beacon/states/head/validators/999999",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "StateIDInvalid",
+			endpoint:   "/eth/v1/beacon/states/invalid/fork",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "ValidatorPubKeyShort",
+			endpoint:   "/eth/v1/beacon/states/head/validators/0x0102",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "ValidatorsPubKeyLong",
+			endpoint:   "/eth/v1/beacon/states/head/validators?id=0x" + strings.Repeat("01", 49),
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "AggregateAttestationRootShort",
+			endpoint:   "/eth/v1/validator/aggregate_attestation?slot=1&attestation_data_root=0x0102",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "BeaconState",
+			endpoint:   "/eth/v1/debug/beacon/states/head",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "EndpointUnknown",
+			endpoint:   "/eth/v1/unknown",
+			statusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := http.Get(fmt.Sprintf("http://%s%s", s.Address(), test.endpoint))
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, test.statusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The static service provides the chain configuration but no chain data.
+	staticService, err := static.New(ctx, static.WithNetwork("mainnet"))
+	require.NoError(t, err)
+	s, err := server.New(ctx,
+		server.WithService(staticService),
+		server.WithListenAddress("127.0.0.1:0"),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		method     string
+		endpoint   string
+		statusCode int
+	}{
+		{
+			name:       "Spec",
+			method:     http.MethodGet,
+			endpoint:   "/eth/v1/config/spec",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Fork",
+			method:     http.MethodGet,
+			endpoint:   "/eth/v1/beacon/states/head/fork",
+			statusCode: http.StatusNotImplemented,
+		},
+		{
+			name:       "Events",
+			method:     http.MethodGet,
+			endpoint:   "/eth/v1/events?topics=head",
+			statusCode: http.StatusNotImplemented,
+		},
+		{
+			name:       "SubmitAttestations",
+			method:     http.MethodPost,
+			endpoint:   "/eth/v1/beacon/pool/attestations",
+			statusCode: http.StatusNotImplemented,
+		},
+		{
+			name:       "MethodNotAllowed",
+			method:     http.MethodPost,
+			endpoint:   "/eth/v1/config/spec",
+			statusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := http.NewRequestWithContext(ctx, test.method, fmt.Sprintf("http://%s%s", s.Address(), test.endpoint), nil)
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			require.Equal(t, test.statusCode, resp.StatusCode)
+		})
+	}
+
+	// The standard client connects, as the static service provides the configuration, but sees the
+	// missing providers as not supported.
+	service, err := standardhttp.New(ctx, standardhttp.WithAddress(s.Address()))
+	require.NoError(t, err)
+	_, err = service.Fork(ctx, "head")
+	require.ErrorIs(t, err, client.ErrNotSupported)
+}
+
+func TestProxyNotFound(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	upstream, err := server.New(ctx,
+		server.WithService(mockService),
+		server.WithListenAddress("127.0.0.1:0"),
+	)
+	require.NoError(t, err)
+
+	// The standard client returns nil without an error for a missing aggregate, which the
+	// proxying server must still serve as not found.
+	service, err := standardhttp.New(ctx, standardhttp.WithAddress(upstream.Address()))
+	require.NoError(t, err)
+	s, err := server.New(ctx,
+		server.WithService(service),
+		server.WithListenAddress("127.0.0.1:0"),
+	)
+	require.NoError(t, err)
+
+	resp, err := http.Get(fmt.Sprintf("http://%s/eth/v1/validator/aggregate_attestation?slot=1&attestation_data_root=0x%s", s.Address(), strings.Repeat("01", 32)))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, err := mock.New(ctx, mock.WithManualAdvance(true))
+	require.NoError(t, err)
+	s, err := server.New(ctx,
+		server.WithService(mockService),
+		server.WithListenAddress("127.0.0.1:0"),
+	)
+	require.NoError(t, err)
+	address := s.Address()
+
+	// An open event stream does not hold up closing.
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/eth/v1/events?topics=head", address), nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.Close(closeCtx))
+
+	_, err = http.Get(fmt.Sprintf("http://%s/eth/v1/node/version", address))
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+func (s *Service) attesterDuties(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.AttesterDutiesProvider)
+	if !isProvider {
+		return nil, notSupported("attester duties")
+	}
+	epoch, err := parseUint64("epoch", params[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// Indices are supplied in the body for POST requests, and the query for GET requests.
+	var indices []spec.ValidatorIndex
+	if r.Method == http.MethodPost {
+		inputs := make([]string, 0)
+		if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+			return nil, badRequest("invalid validator indices: %v", err)
+		}
+		indices, err = parseValidatorIndices("index", inputs)
+	} else {
+		indices, err = parseValidatorIndices("index", r.URL.Query()["index"])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	duties, err := provider.AttesterDuties(r.Context(), spec.Epoch(epoch), indices)
+	if err != nil {
+		return nil, err
+	}
+	if duties == nil {
+		duties = make([]*api.AttesterDuty, 0)
+	}
+
+	return duties, nil
+}
+
+func (s *Service) proposerDuties(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.ProposerDutiesProvider)
+	if !isProvider {
+		return nil, notSupported("proposer duties")
+	}
+	epoch, err := parseUint64("epoch", params[0])
+	if err != nil {
+		return nil, err
+	}
+	duties, err := provider.ProposerDuties(r.Context(), spec.Epoch(epoch), nil)
+	if err != nil {
+		return nil, err
+	}
+	if duties == nil {
+		duties = make([]*api.ProposerDuty, 0)
+	}
+
+	return duties, nil
+}
+
+func (s *Service) attestationData(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.AttestationDataProvider)
+	if !isProvider {
+		return nil, notSupported("attestation data")
+	}
+	slot, err := parseUint64("slot", r.URL.Query().Get("slot"))
+	if err != nil {
+		return nil, err
+	}
+	committeeIndex, err := parseUint64("committee_index", r.URL.Query().Get("committee_index"))
+	if err != nil {
+		return nil, err
+	}
+
+	attestationData, err := provider.AttestationData(r.Context(), spec.Slot(slot), spec.CommitteeIndex(committeeIndex))
+	if err != nil {
+		return nil, err
+	}
+	if attestationData == nil {
+		return nil, fmt.Errorf("attestation data for slot %d not found: %w", slot, client.ErrNotFound)
+	}
+
+	return attestationData, nil
+}
+
+func (s *Service) aggregateAttestation(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.AggregateAttestationProvider)
+	if !isProvider {
+		return nil, notSupported("aggregate attestations")
+	}
+	slot, err := parseUint64("slot", r.URL.Query().Get("slot"))
+	if err != nil {
+		return nil, err
+	}
+	data, err := parseFixedBytes("attestation_data_root", r.URL.Query().Get("attestation_data_root"), len(spec.Root{}))
+	if err != nil {
+		return nil, err
+	}
+	var attestationDataRoot spec.Root
+	copy(attestationDataRoot[:], data)
+
+	aggregate, err := provider.AggregateAttestation(r.Context(), spec.Slot(slot), attestationDataRoot)
+	if err != nil {
+		return nil, err
+	}
+	if aggregate == nil {
+		return nil, fmt.Errorf("aggregate attestation for slot %d not found: %w", slot, client.ErrNotFound)
+	}
+
+	return aggregate, nil
+}
+
+func (s *Service) beaconBlockProposal(_ http.ResponseWriter, r *http.Request, params []string) (interface{}, error) {
+	provider, isProvider := s.service.(client.BeaconBlockProposalProvider)
+	if !isProvider {
+		return nil, notSupported("beacon block proposals")
+	}
+	slot, err := parseUint64("slot", params[0])
+	if err != nil {
+		return nil, err
+	}
+	data, err := parseFixedBytes("randao_reveal", r.URL.Query().Get("randao_reveal"), len(spec.BLSSignature{}))
+	if err != nil {
+		return nil, err
+	}
+	var randaoReveal spec.BLSSignature
+	copy(randaoReveal[:], data)
+	var graffiti []byte
+	if r.URL.Query().Get("graffiti") != "" {
+		graffiti, err = parseBytes("graffiti", r.URL.Query().Get("graffiti"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proposal, err := provider.BeaconBlockProposal(r.Context(), spec.Slot(slot), randaoReveal, graffiti)
+	if err != nil {
+		return nil, err
+	}
+	if proposal == nil {
+		return nil, fmt.Errorf("beacon block proposal for slot %d not found: %w", slot, client.ErrNotFound)
+	}
+
+	return proposal, nil
+}
+
+func (s *Service) submitBeaconCommitteeSubscriptions(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	submitter, isSubmitter := s.service.(client.BeaconCommitteeSubscriptionsSubmitter)
+	if !isSubmitter {
+		return nil, notSupported("beacon committee subscription submission")
+	}
+	subscriptions := make([]*api.BeaconCommitteeSubscription, 0)
+	if err := json.NewDecoder(r.Body).Decode(&subscriptions); err != nil {
+		return nil, badRequest("invalid subscriptions: %v", err)
+	}
+
+	return nil, submitter.SubmitBeaconCommitteeSubscriptions(r.Context(), subscriptions)
+}
+
+func (s *Service) submitAggregateAttestations(_ http.ResponseWriter, r *http.Request, _ []string) (interface{}, error) {
+	submitter, isSubmitter := s.service.(client.AggregateAttestationsSubmitter)
+	if !isSubmitter {
+		return nil, notSupported("aggregate attestation submission")
+	}
+	aggregateAndProofs := make([]*spec.SignedAggregateAndProof, 0)
+	if err := json.NewDecoder(r.Body).Decode(&aggregateAndProofs); err != nil {
+		return nil, badRequest("invalid aggregate and proofs: %v", err)
+	}
+
+	return nil, submitter.SubmitAggregateAttestations(r.Context(), aggregateAndProofs)
+}
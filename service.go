@@ -30,6 +30,23 @@ type Service interface {
 	Address() string
 }
 
+// Closer is the interface for services that can be closed explicitly.
+type Closer interface {
+	// Close closes the service, stopping event streams, cancelling in-flight requests and releasing connections,
+	// then waits for its background goroutines to exit.  It returns an error if the context is done first.
+	Close(ctx context.Context) error
+
+	// Wait waits for the service's background goroutines to exit, which happens once the service is closed or the
+	// context used to create it is done.  It returns an error if the context is done first.
+	Wait(ctx context.Context) error
+}
+
+// ConnectionStateProvider is the interface for providing the state of the connection to the beacon node.
+type ConnectionStateProvider interface {
+	// ConnectionState provides the state of the connection to the beacon node.
+	ConnectionState() ConnectionState
+}
+
 // PrysmAttesterDutiesProvider is the interface for providing attester duties with prysm-specific parameters.
 type PrysmAttesterDutiesProvider interface {
 	// PrysmAttesterDuties obtains attester duties with prysm-specific parameters.
@@ -57,6 +74,20 @@ type EpochFromStateIDProvider interface {
 	EpochFromStateID(ctx context.Context, stateID string) (spec.Epoch, error)
 }
 
+// StateIDResolver is the interface for resolving state IDs to concrete values.
+type StateIDResolver interface {
+	// ResolveStateID resolves a state ID to the slot, epoch and root of the state.
+	// Implementations that cannot resolve some states, for example those at empty slots,
+	// return ErrNotSupported for them.
+	ResolveStateID(ctx context.Context, stateID api.StateID) (*api.ResolvedStateID, error)
+}
+
+// BlockIDResolver is the interface for resolving block IDs to concrete values.
+type BlockIDResolver interface {
+	// ResolveBlockID resolves a block ID to the slot, epoch and root of the block.
+	ResolveBlockID(ctx context.Context, blockID api.BlockID) (*api.ResolvedBlockID, error)
+}
+
 // SlotFromStateIDProvider is the interface for providing slots from state IDs.
 type SlotFromStateIDProvider interface {
 	// SlotFromStateID converts a state ID to its slot.
@@ -69,6 +100,12 @@ type NodeVersionProvider interface {
 	NodeVersion(ctx context.Context) (string, error)
 }
 
+// NodeFamilyProvider is the interface for providing the family of the beacon node software.
+type NodeFamilyProvider interface {
+	// NodeFamily returns the family of the beacon node software.
+	NodeFamily(ctx context.Context) (Family, error)
+}
+
 // SlotDurationProvider is the interface for providing the duration of each slot of a chain.
 type SlotDurationProvider interface {
 	// SlotDuration provides the duration of a slot of the chain.
@@ -263,6 +300,7 @@ type AttestationPoolProvider interface {
 // AttestationsSubmitter is the interface for submitting attestations.
 type AttestationsSubmitter interface {
 	// SubmitAttestations submits attestations.
+	// If the node rejects individual attestations the error is a *BatchError listing them.
 	SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error
 }
 
@@ -294,6 +332,7 @@ type BeaconBlockSubmitter interface {
 // BeaconCommitteeSubscriptionsSubmitter is the interface for submitting beacon committee subnet subscription requests.
 type BeaconCommitteeSubscriptionsSubmitter interface {
 	// SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+	// If the node rejects individual subscriptions the error is a *BatchError listing them.
 	SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error
 }
 
@@ -346,6 +385,12 @@ type ProposerDutiesProvider interface {
 	ProposerDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error)
 }
 
+// ChainSpecProvider is the interface for providing typed spec data.
+type ChainSpecProvider interface {
+	// ChainSpec provides the typed spec information of the chain.
+	ChainSpec(ctx context.Context) (*api.ChainSpec, error)
+}
+
 // SpecProvider is the interface for providing spec data.
 type SpecProvider interface {
 	// Spec provides the spec information of the chain.
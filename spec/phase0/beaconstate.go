@@ -14,17 +14,22 @@
 package phase0
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 	bitfield "github.com/prysmaticlabs/go-bitfield"
 )
 
 // BeaconState represents a beacon state.
+// ETH1DepositIndex and the 2048 limit on ETH1DataVotes follow the phase0
+// specification; earlier versions of this structure lacked the former and
+// limited the latter to 1024, so their SSZ encodings and roots differ.
 type BeaconState struct {
 	GenesisTime                 uint64
 	GenesisValidatorsRoot       []byte `ssz-size:"32"`
@@ -35,7 +40,8 @@ type BeaconState struct {
 	StateRoots                  [][]byte `ssz-size:"8192,32"`
 	HistoricalRoots             [][]byte `ssz-size:"?,32" ssz-max:"16777216"`
 	ETH1Data                    *ETH1Data
-	ETH1DataVotes               []*ETH1Data           `ssz-max:"1024"` // Should be 2048 for mainnet?
+	ETH1DataVotes               []*ETH1Data `ssz-max:"2048"`
+	ETH1DepositIndex            uint64
 	Validators                  []*Validator          `ssz-max:"1099511627776"`
 	Balances                    []uint64              `ssz-max:"1099511627776"`
 	RANDAOMixes                 [][]byte              `ssz-size:"65536,32"`
@@ -60,6 +66,7 @@ type beaconStateJSON struct {
 	HistoricalRoots             []string              `json:"historical_roots"`
 	ETH1Data                    *ETH1Data             `json:"eth1_data"`
 	ETH1DataVotes               []*ETH1Data           `json:"eth1_data_votes"`
+	ETH1DepositIndex            string                `json:"eth1_deposit_index"`
 	Validators                  []*Validator          `json:"validators"`
 	Balances                    []string              `json:"balances"`
 	RANDAOMixes                 []string              `json:"randao_mixes"`
@@ -72,6 +79,31 @@ type beaconStateJSON struct {
 	FinalizedCheckpoint         *Checkpoint           `json:"finalized_checkpoint"`
 }
 
+// beaconStateYAML is the spec representation of the struct.
+type beaconStateYAML struct {
+	GenesisTime                 uint64                `yaml:"genesis_time"`
+	GenesisValidatorsRoot       string                `yaml:"genesis_validators_root"`
+	Slot                        uint64                `yaml:"slot"`
+	Fork                        *Fork                 `yaml:"fork"`
+	LatestBlockHeader           *BeaconBlockHeader    `yaml:"latest_block_header"`
+	BlockRoots                  []string              `yaml:"block_roots"`
+	StateRoots                  []string              `yaml:"state_roots"`
+	HistoricalRoots             []string              `yaml:"historical_roots"`
+	ETH1Data                    *ETH1Data             `yaml:"eth1_data"`
+	ETH1DataVotes               []*ETH1Data           `yaml:"eth1_data_votes"`
+	ETH1DepositIndex            uint64                `yaml:"eth1_deposit_index"`
+	Validators                  []*Validator          `yaml:"validators"`
+	Balances                    []uint64              `yaml:"balances"`
+	RANDAOMixes                 []string              `yaml:"randao_mixes"`
+	Slashings                   []uint64              `yaml:"slashings"`
+	PreviousEpochAttestations   []*PendingAttestation `yaml:"previous_epoch_attestations"`
+	CurrentEpochAttestations    []*PendingAttestation `yaml:"current_epoch_attestations"`
+	JustificationBits           string                `yaml:"justification_bits"`
+	PreviousJustifiedCheckpoint *Checkpoint           `yaml:"previous_justified_checkpoint"`
+	CurrentJustifiedCheckpoint  *Checkpoint           `yaml:"current_justified_checkpoint"`
+	FinalizedCheckpoint         *Checkpoint           `yaml:"finalized_checkpoint"`
+}
+
 // MarshalJSON implements json.Marshaler.
 func (s *BeaconState) MarshalJSON() ([]byte, error) {
 	blockRoots := make([]string, len(s.BlockRoots))
@@ -109,6 +141,7 @@ func (s *BeaconState) MarshalJSON() ([]byte, error) {
 		HistoricalRoots:             historicalRoots,
 		ETH1Data:                    s.ETH1Data,
 		ETH1DataVotes:               s.ETH1DataVotes,
+		ETH1DepositIndex:            fmt.Sprintf("%d", s.ETH1DepositIndex),
 		Validators:                  s.Validators,
 		Balances:                    balances,
 		RANDAOMixes:                 randaoMixes,
@@ -123,14 +156,18 @@ func (s *BeaconState) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// nolint:gocyclo
 func (s *BeaconState) UnmarshalJSON(input []byte) error {
-	var err error
-
 	var beaconStateJSON beaconStateJSON
-	if err = json.Unmarshal(input, &beaconStateJSON); err != nil {
+	if err := json.Unmarshal(input, &beaconStateJSON); err != nil {
 		return errors.Wrap(err, "invalid JSON")
 	}
+	return s.unpack(&beaconStateJSON)
+}
+
+// nolint:gocyclo
+func (s *BeaconState) unpack(beaconStateJSON *beaconStateJSON) error {
+	var err error
+
 	if beaconStateJSON.GenesisTime == "" {
 		return errors.New("genesis time missing")
 	}
@@ -205,6 +242,12 @@ func (s *BeaconState) UnmarshalJSON(input []byte) error {
 	s.ETH1Data = beaconStateJSON.ETH1Data
 	// ETH1DataVotes can be empty.
 	s.ETH1DataVotes = beaconStateJSON.ETH1DataVotes
+	if beaconStateJSON.ETH1DepositIndex == "" {
+		return errors.New("eth1 deposit index missing")
+	}
+	if s.ETH1DepositIndex, err = strconv.ParseUint(beaconStateJSON.ETH1DepositIndex, 10, 64); err != nil {
+		return errors.Wrap(err, "invalid value for eth1 deposit index")
+	}
 	if beaconStateJSON.Validators == nil {
 		return errors.New("validators missing")
 	}
@@ -263,6 +306,63 @@ func (s *BeaconState) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// MarshalYAML implements yaml.Marshaler.
+func (s *BeaconState) MarshalYAML() ([]byte, error) {
+	blockRoots := make([]string, len(s.BlockRoots))
+	for i := range s.BlockRoots {
+		blockRoots[i] = fmt.Sprintf("%#x", s.BlockRoots[i])
+	}
+	stateRoots := make([]string, len(s.StateRoots))
+	for i := range s.StateRoots {
+		stateRoots[i] = fmt.Sprintf("%#x", s.StateRoots[i])
+	}
+	historicalRoots := make([]string, len(s.HistoricalRoots))
+	for i := range s.HistoricalRoots {
+		historicalRoots[i] = fmt.Sprintf("%#x", s.HistoricalRoots[i])
+	}
+	randaoMixes := make([]string, len(s.RANDAOMixes))
+	for i := range s.RANDAOMixes {
+		randaoMixes[i] = fmt.Sprintf("%#x", s.RANDAOMixes[i])
+	}
+	yamlBytes, err := yaml.MarshalWithOptions(&beaconStateYAML{
+		GenesisTime:                 s.GenesisTime,
+		GenesisValidatorsRoot:       fmt.Sprintf("%#x", s.GenesisValidatorsRoot),
+		Slot:                        s.Slot,
+		Fork:                        s.Fork,
+		LatestBlockHeader:           s.LatestBlockHeader,
+		BlockRoots:                  blockRoots,
+		StateRoots:                  stateRoots,
+		HistoricalRoots:             historicalRoots,
+		ETH1Data:                    s.ETH1Data,
+		ETH1DataVotes:               s.ETH1DataVotes,
+		ETH1DepositIndex:            s.ETH1DepositIndex,
+		Validators:                  s.Validators,
+		Balances:                    s.Balances,
+		RANDAOMixes:                 randaoMixes,
+		Slashings:                   s.Slashings,
+		PreviousEpochAttestations:   s.PreviousEpochAttestations,
+		CurrentEpochAttestations:    s.CurrentEpochAttestations,
+		JustificationBits:           fmt.Sprintf("%#x", s.JustificationBits.Bytes()),
+		PreviousJustifiedCheckpoint: s.PreviousJustifiedCheckpoint,
+		CurrentJustifiedCheckpoint:  s.CurrentJustifiedCheckpoint,
+		FinalizedCheckpoint:         s.FinalizedCheckpoint,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *BeaconState) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var beaconStateJSON beaconStateJSON
+	if err := yaml.Unmarshal(input, &beaconStateJSON); err != nil {
+		return err
+	}
+	return s.unpack(&beaconStateJSON)
+}
+
 // String returns a string version of the structure.
 func (s *BeaconState) String() string {
 	data, err := json.Marshal(s)
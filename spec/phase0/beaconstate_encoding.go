@@ -13,7 +13,7 @@ func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the BeaconState object to a target array
 func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(2687369)
+	offset := int(2687377)
 
 	// Field (0) 'GenesisTime'
 	dst = ssz.MarshalUint64(dst, b.GenesisTime)
@@ -86,15 +86,18 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.ETH1DataVotes) * 72
 
-	// Offset (10) 'Validators'
+	// Field (10) 'ETH1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.ETH1DepositIndex)
+
+	// Offset (11) 'Validators'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.Validators) * 121
 
-	// Offset (11) 'Balances'
+	// Offset (12) 'Balances'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(b.Balances) * 8
 
-	// Field (12) 'RANDAOMixes'
+	// Field (13) 'RANDAOMixes'
 	if len(b.RANDAOMixes) != 65536 {
 		err = ssz.ErrVectorLength
 		return
@@ -107,7 +110,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = append(dst, b.RANDAOMixes[ii]...)
 	}
 
-	// Field (13) 'Slashings'
+	// Field (14) 'Slashings'
 	if len(b.Slashings) != 8192 {
 		err = ssz.ErrVectorLength
 		return
@@ -116,28 +119,28 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Slashings[ii])
 	}
 
-	// Offset (14) 'PreviousEpochAttestations'
+	// Offset (15) 'PreviousEpochAttestations'
 	dst = ssz.WriteOffset(dst, offset)
 	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
 		offset += 4
 		offset += b.PreviousEpochAttestations[ii].SizeSSZ()
 	}
 
-	// Offset (15) 'CurrentEpochAttestations'
+	// Offset (16) 'CurrentEpochAttestations'
 	dst = ssz.WriteOffset(dst, offset)
 	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
 		offset += 4
 		offset += b.CurrentEpochAttestations[ii].SizeSSZ()
 	}
 
-	// Field (16) 'JustificationBits'
+	// Field (17) 'JustificationBits'
 	if len(b.JustificationBits) != 1 {
 		err = ssz.ErrBytesLength
 		return
 	}
 	dst = append(dst, b.JustificationBits...)
 
-	// Field (17) 'PreviousJustifiedCheckpoint'
+	// Field (18) 'PreviousJustifiedCheckpoint'
 	if b.PreviousJustifiedCheckpoint == nil {
 		b.PreviousJustifiedCheckpoint = new(Checkpoint)
 	}
@@ -145,7 +148,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
-	// Field (18) 'CurrentJustifiedCheckpoint'
+	// Field (19) 'CurrentJustifiedCheckpoint'
 	if b.CurrentJustifiedCheckpoint == nil {
 		b.CurrentJustifiedCheckpoint = new(Checkpoint)
 	}
@@ -153,7 +156,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		return
 	}
 
-	// Field (19) 'FinalizedCheckpoint'
+	// Field (20) 'FinalizedCheckpoint'
 	if b.FinalizedCheckpoint == nil {
 		b.FinalizedCheckpoint = new(Checkpoint)
 	}
@@ -175,7 +178,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 
 	// Field (9) 'ETH1DataVotes'
-	if len(b.ETH1DataVotes) > 1024 {
+	if len(b.ETH1DataVotes) > 2048 {
 		err = ssz.ErrListTooBig
 		return
 	}
@@ -185,7 +188,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
-	// Field (10) 'Validators'
+	// Field (11) 'Validators'
 	if len(b.Validators) > 1099511627776 {
 		err = ssz.ErrListTooBig
 		return
@@ -196,7 +199,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
-	// Field (11) 'Balances'
+	// Field (12) 'Balances'
 	if len(b.Balances) > 1099511627776 {
 		err = ssz.ErrListTooBig
 		return
@@ -205,7 +208,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		dst = ssz.MarshalUint64(dst, b.Balances[ii])
 	}
 
-	// Field (14) 'PreviousEpochAttestations'
+	// Field (15) 'PreviousEpochAttestations'
 	if len(b.PreviousEpochAttestations) > 4096 {
 		err = ssz.ErrListTooBig
 		return
@@ -223,7 +226,7 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 		}
 	}
 
-	// Field (15) 'CurrentEpochAttestations'
+	// Field (16) 'CurrentEpochAttestations'
 	if len(b.CurrentEpochAttestations) > 4096 {
 		err = ssz.ErrListTooBig
 		return
@@ -248,12 +251,12 @@ func (b *BeaconState) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 2687369 {
+	if size < 2687377 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o7, o9, o10, o11, o14, o15 uint64
+	var o7, o9, o11, o12, o15, o16 uint64
 
 	// Field (0) 'GenesisTime'
 	b.GenesisTime = ssz.UnmarshallUint64(buf[0:8])
@@ -319,68 +322,71 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	// Offset (10) 'Validators'
-	if o10 = ssz.ReadOffset(buf[524544:524548]); o10 > size || o9 > o10 {
+	// Field (10) 'ETH1DepositIndex'
+	b.ETH1DepositIndex = ssz.UnmarshallUint64(buf[524544:524552])
+
+	// Offset (11) 'Validators'
+	if o11 = ssz.ReadOffset(buf[524552:524556]); o11 > size || o9 > o11 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (11) 'Balances'
-	if o11 = ssz.ReadOffset(buf[524548:524552]); o11 > size || o10 > o11 {
+	// Offset (12) 'Balances'
+	if o12 = ssz.ReadOffset(buf[524556:524560]); o12 > size || o11 > o12 {
 		return ssz.ErrOffset
 	}
 
-	// Field (12) 'RANDAOMixes'
+	// Field (13) 'RANDAOMixes'
 	b.RANDAOMixes = make([][]byte, 65536)
 	for ii := 0; ii < 65536; ii++ {
 		if cap(b.RANDAOMixes[ii]) == 0 {
-			b.RANDAOMixes[ii] = make([]byte, 0, len(buf[524552:2621704][ii*32:(ii+1)*32]))
+			b.RANDAOMixes[ii] = make([]byte, 0, len(buf[524560:2621712][ii*32:(ii+1)*32]))
 		}
-		b.RANDAOMixes[ii] = append(b.RANDAOMixes[ii], buf[524552:2621704][ii*32:(ii+1)*32]...)
+		b.RANDAOMixes[ii] = append(b.RANDAOMixes[ii], buf[524560:2621712][ii*32:(ii+1)*32]...)
 	}
 
-	// Field (13) 'Slashings'
+	// Field (14) 'Slashings'
 	b.Slashings = ssz.ExtendUint64(b.Slashings, 8192)
 	for ii := 0; ii < 8192; ii++ {
-		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621704:2687240][ii*8 : (ii+1)*8])
+		b.Slashings[ii] = ssz.UnmarshallUint64(buf[2621712:2687248][ii*8 : (ii+1)*8])
 	}
 
-	// Offset (14) 'PreviousEpochAttestations'
-	if o14 = ssz.ReadOffset(buf[2687240:2687244]); o14 > size || o11 > o14 {
+	// Offset (15) 'PreviousEpochAttestations'
+	if o15 = ssz.ReadOffset(buf[2687248:2687252]); o15 > size || o12 > o15 {
 		return ssz.ErrOffset
 	}
 
-	// Offset (15) 'CurrentEpochAttestations'
-	if o15 = ssz.ReadOffset(buf[2687244:2687248]); o15 > size || o14 > o15 {
+	// Offset (16) 'CurrentEpochAttestations'
+	if o16 = ssz.ReadOffset(buf[2687252:2687256]); o16 > size || o15 > o16 {
 		return ssz.ErrOffset
 	}
 
-	// Field (16) 'JustificationBits'
+	// Field (17) 'JustificationBits'
 	if cap(b.JustificationBits) == 0 {
-		b.JustificationBits = make([]byte, 0, len(buf[2687248:2687249]))
+		b.JustificationBits = make([]byte, 0, len(buf[2687256:2687257]))
 	}
-	b.JustificationBits = append(b.JustificationBits, buf[2687248:2687249]...)
+	b.JustificationBits = append(b.JustificationBits, buf[2687256:2687257]...)
 
-	// Field (17) 'PreviousJustifiedCheckpoint'
+	// Field (18) 'PreviousJustifiedCheckpoint'
 	if b.PreviousJustifiedCheckpoint == nil {
 		b.PreviousJustifiedCheckpoint = new(Checkpoint)
 	}
-	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687249:2687289]); err != nil {
+	if err = b.PreviousJustifiedCheckpoint.UnmarshalSSZ(buf[2687257:2687297]); err != nil {
 		return err
 	}
 
-	// Field (18) 'CurrentJustifiedCheckpoint'
+	// Field (19) 'CurrentJustifiedCheckpoint'
 	if b.CurrentJustifiedCheckpoint == nil {
 		b.CurrentJustifiedCheckpoint = new(Checkpoint)
 	}
-	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687289:2687329]); err != nil {
+	if err = b.CurrentJustifiedCheckpoint.UnmarshalSSZ(buf[2687297:2687337]); err != nil {
 		return err
 	}
 
-	// Field (19) 'FinalizedCheckpoint'
+	// Field (20) 'FinalizedCheckpoint'
 	if b.FinalizedCheckpoint == nil {
 		b.FinalizedCheckpoint = new(Checkpoint)
 	}
-	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687329:2687369]); err != nil {
+	if err = b.FinalizedCheckpoint.UnmarshalSSZ(buf[2687337:2687377]); err != nil {
 		return err
 	}
 
@@ -402,8 +408,8 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 	// Field (9) 'ETH1DataVotes'
 	{
-		buf = tail[o9:o10]
-		num, err := ssz.DivideInt2(len(buf), 72, 1024)
+		buf = tail[o9:o11]
+		num, err := ssz.DivideInt2(len(buf), 72, 2048)
 		if err != nil {
 			return err
 		}
@@ -418,9 +424,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (10) 'Validators'
+	// Field (11) 'Validators'
 	{
-		buf = tail[o10:o11]
+		buf = tail[o11:o12]
 		num, err := ssz.DivideInt2(len(buf), 121, 1099511627776)
 		if err != nil {
 			return err
@@ -436,9 +442,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (11) 'Balances'
+	// Field (12) 'Balances'
 	{
-		buf = tail[o11:o14]
+		buf = tail[o12:o15]
 		num, err := ssz.DivideInt2(len(buf), 8, 1099511627776)
 		if err != nil {
 			return err
@@ -449,9 +455,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (14) 'PreviousEpochAttestations'
+	// Field (15) 'PreviousEpochAttestations'
 	{
-		buf = tail[o14:o15]
+		buf = tail[o15:o16]
 		num, err := ssz.DecodeDynamicLength(buf, 4096)
 		if err != nil {
 			return err
@@ -471,9 +477,9 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 		}
 	}
 
-	// Field (15) 'CurrentEpochAttestations'
+	// Field (16) 'CurrentEpochAttestations'
 	{
-		buf = tail[o15:]
+		buf = tail[o16:]
 		num, err := ssz.DecodeDynamicLength(buf, 4096)
 		if err != nil {
 			return err
@@ -497,7 +503,7 @@ func (b *BeaconState) UnmarshalSSZ(buf []byte) error {
 
 // SizeSSZ returns the ssz encoded size in bytes for the BeaconState object
 func (b *BeaconState) SizeSSZ() (size int) {
-	size = 2687369
+	size = 2687377
 
 	// Field (7) 'HistoricalRoots'
 	size += len(b.HistoricalRoots) * 32
@@ -505,19 +511,19 @@ func (b *BeaconState) SizeSSZ() (size int) {
 	// Field (9) 'ETH1DataVotes'
 	size += len(b.ETH1DataVotes) * 72
 
-	// Field (10) 'Validators'
+	// Field (11) 'Validators'
 	size += len(b.Validators) * 121
 
-	// Field (11) 'Balances'
+	// Field (12) 'Balances'
 	size += len(b.Balances) * 8
 
-	// Field (14) 'PreviousEpochAttestations'
+	// Field (15) 'PreviousEpochAttestations'
 	for ii := 0; ii < len(b.PreviousEpochAttestations); ii++ {
 		size += 4
 		size += b.PreviousEpochAttestations[ii].SizeSSZ()
 	}
 
-	// Field (15) 'CurrentEpochAttestations'
+	// Field (16) 'CurrentEpochAttestations'
 	for ii := 0; ii < len(b.CurrentEpochAttestations); ii++ {
 		size += 4
 		size += b.CurrentEpochAttestations[ii].SizeSSZ()
@@ -619,7 +625,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.ETH1DataVotes))
-		if num > 1024 {
+		if num > 2048 {
 			err = ssz.ErrIncorrectListSize
 			return
 		}
@@ -628,10 +634,13 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 				return
 			}
 		}
-		hh.MerkleizeWithMixin(subIndx, num, 1024)
+		hh.MerkleizeWithMixin(subIndx, num, 2048)
 	}
 
-	// Field (10) 'Validators'
+	// Field (10) 'ETH1DepositIndex'
+	hh.PutUint64(b.ETH1DepositIndex)
+
+	// Field (11) 'Validators'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.Validators))
@@ -647,7 +656,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 1099511627776)
 	}
 
-	// Field (11) 'Balances'
+	// Field (12) 'Balances'
 	{
 		if len(b.Balances) > 1099511627776 {
 			err = ssz.ErrListTooBig
@@ -662,7 +671,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(1099511627776, numItems, 8))
 	}
 
-	// Field (12) 'RANDAOMixes'
+	// Field (13) 'RANDAOMixes'
 	{
 		if len(b.RANDAOMixes) != 65536 {
 			err = ssz.ErrVectorLength
@@ -679,7 +688,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.Merkleize(subIndx)
 	}
 
-	// Field (13) 'Slashings'
+	// Field (14) 'Slashings'
 	{
 		if len(b.Slashings) != 8192 {
 			err = ssz.ErrVectorLength
@@ -692,7 +701,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.Merkleize(subIndx)
 	}
 
-	// Field (14) 'PreviousEpochAttestations'
+	// Field (15) 'PreviousEpochAttestations'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.PreviousEpochAttestations))
@@ -708,7 +717,7 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 4096)
 	}
 
-	// Field (15) 'CurrentEpochAttestations'
+	// Field (16) 'CurrentEpochAttestations'
 	{
 		subIndx := hh.Index()
 		num := uint64(len(b.CurrentEpochAttestations))
@@ -724,24 +733,24 @@ func (b *BeaconState) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 		hh.MerkleizeWithMixin(subIndx, num, 4096)
 	}
 
-	// Field (16) 'JustificationBits'
+	// Field (17) 'JustificationBits'
 	if len(b.JustificationBits) != 1 {
 		err = ssz.ErrBytesLength
 		return
 	}
 	hh.PutBytes(b.JustificationBits)
 
-	// Field (17) 'PreviousJustifiedCheckpoint'
+	// Field (18) 'PreviousJustifiedCheckpoint'
 	if err = b.PreviousJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (18) 'CurrentJustifiedCheckpoint'
+	// Field (19) 'CurrentJustifiedCheckpoint'
 	if err = b.CurrentJustifiedCheckpoint.HashTreeRootWith(hh); err != nil {
 		return
 	}
 
-	// Field (19) 'FinalizedCheckpoint'
+	// Field (20) 'FinalizedCheckpoint'
 	if err = b.FinalizedCheckpoint.HashTreeRootWith(hh); err != nil {
 		return
 	}
@@ -0,0 +1,151 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package phase0_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	require "github.com/stretchr/testify/require"
+)
+
+// testBeaconState creates a populated beacon state.
+func testBeaconState() *spec.BeaconState {
+	roots := func(count int, val byte) [][]byte {
+		res := make([][]byte, count)
+		for i := range res {
+			res[i] = make([]byte, 32)
+			res[i][0] = val
+			res[i][1] = byte(i)
+		}
+		return res
+	}
+	return &spec.BeaconState{
+		GenesisTime:           1606824023,
+		GenesisValidatorsRoot: roots(1, 0x01)[0],
+		Slot:                  12345,
+		Fork: &spec.Fork{
+			PreviousVersion: spec.Version{0x00, 0x00, 0x00, 0x00},
+			CurrentVersion:  spec.Version{0x00, 0x00, 0x00, 0x00},
+		},
+		LatestBlockHeader: &spec.BeaconBlockHeader{
+			Slot:       12344,
+			ParentRoot: spec.Root{0x02},
+			StateRoot:  spec.Root{0x03},
+			BodyRoot:   spec.Root{0x04},
+		},
+		BlockRoots:      roots(8192, 0x05),
+		StateRoots:      roots(8192, 0x06),
+		HistoricalRoots: roots(2, 0x07),
+		ETH1Data: &spec.ETH1Data{
+			DepositRoot:  spec.Root{0x08},
+			DepositCount: 100,
+			BlockHash:    roots(1, 0x09)[0],
+		},
+		ETH1DataVotes: []*spec.ETH1Data{
+			{
+				DepositRoot:  spec.Root{0x0a},
+				DepositCount: 101,
+				BlockHash:    roots(1, 0x0b)[0],
+			},
+		},
+		ETH1DepositIndex: 99,
+		Validators: []*spec.Validator{
+			{
+				PublicKey:             spec.BLSPubKey{0x0c},
+				WithdrawalCredentials: roots(1, 0x0d)[0],
+				EffectiveBalance:      32000000000,
+				ExitEpoch:             0xffffffffffffffff,
+				WithdrawableEpoch:     0xffffffffffffffff,
+			},
+		},
+		Balances:                    []uint64{32000000001},
+		RANDAOMixes:                 roots(65536, 0x0e),
+		Slashings:                   make([]uint64, 8192),
+		PreviousEpochAttestations:   []*spec.PendingAttestation{},
+		CurrentEpochAttestations:    []*spec.PendingAttestation{},
+		JustificationBits:           bitfield.Bitvector4{0x03},
+		PreviousJustifiedCheckpoint: &spec.Checkpoint{Epoch: 383, Root: spec.Root{0x0f}},
+		CurrentJustifiedCheckpoint:  &spec.Checkpoint{Epoch: 384, Root: spec.Root{0x10}},
+		FinalizedCheckpoint:         &spec.Checkpoint{Epoch: 382, Root: spec.Root{0x11}},
+	}
+}
+
+func TestBeaconStateJSON(t *testing.T) {
+	state := testBeaconState()
+	data, err := json.Marshal(state)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"eth1_deposit_index":"99"`)
+
+	var res spec.BeaconState
+	require.NoError(t, json.Unmarshal(data, &res))
+	require.Equal(t, state, &res)
+
+	err = json.Unmarshal([]byte(strings.Replace(string(data), `"eth1_deposit_index":"99",`, "", 1)), &res)
+	require.EqualError(t, err, "eth1 deposit index missing")
+
+	err = json.Unmarshal([]byte(strings.Replace(string(data), `"eth1_deposit_index":"99"`, `"eth1_deposit_index":"-1"`, 1)), &res)
+	require.EqualError(t, err, "invalid value for eth1 deposit index: strconv.ParseUint: parsing \"-1\": invalid syntax")
+}
+
+func TestBeaconStateYAML(t *testing.T) {
+	state := testBeaconState()
+	data, err := yaml.Marshal(state)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "eth1_deposit_index: 99")
+
+	var res spec.BeaconState
+	require.NoError(t, yaml.Unmarshal(data, &res))
+	require.Equal(t, state, &res)
+
+	err = yaml.Unmarshal([]byte(strings.Replace(string(data), "eth1_deposit_index: 99", "eth1_deposit_index: -1", 1)), &res)
+	require.EqualError(t, err, "invalid value for eth1 deposit index: strconv.ParseUint: parsing \"-1\": invalid syntax")
+}
+
+func TestBeaconStateSSZ(t *testing.T) {
+	state := testBeaconState()
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	require.Len(t, data, state.SizeSSZ())
+
+	var res spec.BeaconState
+	require.NoError(t, res.UnmarshalSSZ(data))
+	require.Equal(t, state.ETH1DepositIndex, res.ETH1DepositIndex)
+	require.Equal(t, state.Validators, res.Validators)
+	require.Equal(t, state.FinalizedCheckpoint, res.FinalizedCheckpoint)
+
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+	rtRoot, err := res.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, rtRoot)
+
+	// The deposit index contributes to the root.
+	res.ETH1DepositIndex++
+	rtRoot, err = res.HashTreeRoot()
+	require.NoError(t, err)
+	require.NotEqual(t, root, rtRoot)
+
+	// Votes are limited to 2048.
+	state.ETH1DataVotes = make([]*spec.ETH1Data, 2049)
+	for i := range state.ETH1DataVotes {
+		state.ETH1DataVotes[i] = state.ETH1Data
+	}
+	_, err = state.HashTreeRoot()
+	require.Error(t, err)
+}
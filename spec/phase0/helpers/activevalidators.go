@@ -0,0 +1,36 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// IsActiveValidator returns true if the validator is active at the given epoch.
+// This is is_active_validator in the Ethereum 2 specification.
+func IsActiveValidator(validator *spec.Validator, epoch spec.Epoch) bool {
+	return validator.ActivationEpoch <= epoch && epoch < validator.ExitEpoch
+}
+
+// ActiveValidatorIndices provides the indices of the validators active at the given epoch.
+// This is get_active_validator_indices in the Ethereum 2 specification.
+func (s *Service) ActiveValidatorIndices(state *spec.BeaconState, epoch spec.Epoch) []spec.ValidatorIndex {
+	res := make([]spec.ValidatorIndex, 0, len(state.Validators))
+	for i, validator := range state.Validators {
+		if IsActiveValidator(validator, epoch) {
+			res = append(res, spec.ValidatorIndex(i))
+		}
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActiveValidatorIndices(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	state := testState(17, 4)
+	state.Validators[1].ActivationEpoch = 3
+	state.Validators[2].ExitEpoch = 2
+
+	require.Equal(t, []spec.ValidatorIndex{0, 2, 3}, service.ActiveValidatorIndices(state, 1))
+	require.Equal(t, []spec.ValidatorIndex{0, 3}, service.ActiveValidatorIndices(state, 2))
+	require.Equal(t, []spec.ValidatorIndex{0, 1, 3}, service.ActiveValidatorIndices(state, 3))
+}
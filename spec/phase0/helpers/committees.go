@@ -0,0 +1,112 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"fmt"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// CommitteeCountPerSlot provides the number of committees in each slot for the given epoch.
+// This is get_committee_count_per_slot in the Ethereum 2 specification.
+func (s *Service) CommitteeCountPerSlot(state *spec.BeaconState, epoch spec.Epoch) uint64 {
+	return s.committeeCountPerSlot(uint64(len(s.ActiveValidatorIndices(state, epoch))))
+}
+
+// committeeCountPerSlot provides the number of committees in each slot given the number of active validators.
+func (s *Service) committeeCountPerSlot(activeValidators uint64) uint64 {
+	count := activeValidators / s.slotsPerEpoch / s.targetCommitteeSize
+	if count > s.maxCommitteesPerSlot {
+		count = s.maxCommitteesPerSlot
+	}
+	if count == 0 {
+		count = 1
+	}
+	return count
+}
+
+// BeaconCommittee provides the beacon committee at the given slot and index.
+// This is get_beacon_committee in the Ethereum 2 specification.
+func (s *Service) BeaconCommittee(state *spec.BeaconState, slot spec.Slot, index spec.CommitteeIndex) ([]spec.ValidatorIndex, error) {
+	epoch := s.EpochAtSlot(slot)
+	shuffling, err := s.shuffling(state, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	committeesPerSlot := s.committeeCountPerSlot(uint64(len(shuffling)))
+	if uint64(index) >= committeesPerSlot {
+		return nil, fmt.Errorf("committee index %d out of range; %d committees per slot", index, committeesPerSlot)
+	}
+
+	return computeCommittee(shuffling,
+		(uint64(slot)%s.slotsPerEpoch)*committeesPerSlot+uint64(index),
+		committeesPerSlot*s.slotsPerEpoch,
+	), nil
+}
+
+// BeaconCommittees provides all beacon committees for the given epoch.
+func (s *Service) BeaconCommittees(state *spec.BeaconState, epoch spec.Epoch) ([]*api.BeaconCommittee, error) {
+	shuffling, err := s.shuffling(state, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	committeesPerSlot := s.committeeCountPerSlot(uint64(len(shuffling)))
+	res := make([]*api.BeaconCommittee, 0, committeesPerSlot*s.slotsPerEpoch)
+	startSlot := s.EpochStartSlot(epoch)
+	for i := uint64(0); i < s.slotsPerEpoch; i++ {
+		for j := uint64(0); j < committeesPerSlot; j++ {
+			res = append(res, &api.BeaconCommittee{
+				Slot:       startSlot + spec.Slot(i),
+				Index:      spec.CommitteeIndex(j),
+				Validators: computeCommittee(shuffling, i*committeesPerSlot+j, committeesPerSlot*s.slotsPerEpoch),
+			})
+		}
+	}
+	return res, nil
+}
+
+// shuffling provides the active validators for the given epoch in their shuffled order.
+func (s *Service) shuffling(state *spec.BeaconState, epoch spec.Epoch) ([]spec.ValidatorIndex, error) {
+	if epoch > s.CurrentEpoch(state)+spec.Epoch(s.minSeedLookahead) {
+		return nil, fmt.Errorf("epoch %d too far in the future for state at epoch %d", epoch, s.CurrentEpoch(state))
+	}
+
+	seed, err := s.Seed(state, epoch, s.beaconAttesterDomain)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain seed")
+	}
+
+	indices := s.ActiveValidatorIndices(state, epoch)
+	shuffledIndices := s.shuffledIndices(uint64(len(indices)), seed)
+	res := make([]spec.ValidatorIndex, len(indices))
+	for i := range shuffledIndices {
+		res[i] = indices[shuffledIndices[i]]
+	}
+	return res, nil
+}
+
+// computeCommittee provides the committee at the given index from the shuffled validators.
+// This is compute_committee in the Ethereum 2 specification, operating on a pre-shuffled list.
+func computeCommittee(shuffling []spec.ValidatorIndex, index uint64, count uint64) []spec.ValidatorIndex {
+	start := uint64(len(shuffling)) * index / count
+	end := uint64(len(shuffling)) * (index + 1) / count
+	res := make([]spec.ValidatorIndex, end-start)
+	copy(res, shuffling[start:end])
+	return res
+}
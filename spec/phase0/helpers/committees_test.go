@@ -0,0 +1,153 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitteeCountPerSlot(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		validators int
+		expected   uint64
+	}{
+		{
+			name:       "Minimum",
+			validators: 8,
+			expected:   1,
+		},
+		{
+			name:       "Two",
+			validators: 64,
+			expected:   2,
+		},
+		{
+			name:       "Maximum",
+			validators: 1000,
+			expected:   4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := testState(0, test.validators)
+			require.Equal(t, test.expected, service.CommitteeCountPerSlot(state, 0))
+		})
+	}
+}
+
+func TestBeaconCommittee(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	state := testState(17, 100)
+	// Exit a validator and delay activation of another to ensure they are excluded.
+	state.Validators[5].ExitEpoch = 1
+	state.Validators[6].ActivationEpoch = 3
+
+	tests := []struct {
+		name  string
+		slot  spec.Slot
+		index spec.CommitteeIndex
+		err   string
+	}{
+		{
+			name:  "IndexOutOfRange",
+			slot:  17,
+			index: 3,
+			err:   "committee index 3 out of range; 3 committees per slot",
+		},
+		{
+			name:  "TooFarInFuture",
+			slot:  32,
+			index: 0,
+			err:   "epoch 4 too far in the future for state at epoch 2",
+		},
+		{
+			name:  "Current",
+			slot:  17,
+			index: 2,
+		},
+		{
+			name:  "Previous",
+			slot:  8,
+			index: 0,
+		},
+		{
+			name:  "Next",
+			slot:  31,
+			index: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			committee, err := service.BeaconCommittee(state, test.slot, test.index)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.NotEmpty(t, committee)
+				// Confirm the committee matches that provided as part of the full set for the epoch.
+				committees, err := service.BeaconCommittees(state, service.EpochAtSlot(test.slot))
+				require.NoError(t, err)
+				found := false
+				for _, c := range committees {
+					if c.Slot == test.slot && c.Index == test.index {
+						require.Equal(t, c.Validators, committee)
+						found = true
+					}
+				}
+				require.True(t, found)
+			}
+		})
+	}
+}
+
+func TestBeaconCommittees(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	state := testState(17, 100)
+	state.Validators[5].ExitEpoch = 2
+	state.Validators[6].ActivationEpoch = 3
+
+	for epoch := spec.Epoch(0); epoch <= 3; epoch++ {
+		committees, err := service.BeaconCommittees(state, epoch)
+		require.NoError(t, err)
+		require.Len(t, committees, 3*8)
+
+		// Every active validator appears exactly once in the epoch.
+		seen := make(map[spec.ValidatorIndex]bool)
+		for _, committee := range committees {
+			require.Equal(t, epoch, service.EpochAtSlot(committee.Slot))
+			for _, index := range committee.Validators {
+				require.False(t, seen[index])
+				seen[index] = true
+			}
+		}
+		require.Equal(t, len(service.ActiveValidatorIndices(state, epoch)), len(seen))
+		require.Equal(t, epoch < 2, seen[5])
+		require.Equal(t, epoch >= 3, seen[6])
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// minimalSpec is a spec provider using the minimal preset values.
+type minimalSpec map[string]interface{}
+
+func (m minimalSpec) Spec(ctx context.Context) (map[string]interface{}, error) {
+	return m, nil
+}
+
+func newMinimalSpec() minimalSpec {
+	return minimalSpec{
+		"SLOTS_PER_EPOCH":              uint64(8),
+		"SHUFFLE_ROUND_COUNT":          uint64(10),
+		"TARGET_COMMITTEE_SIZE":        uint64(4),
+		"MAX_COMMITTEES_PER_SLOT":      uint64(4),
+		"MIN_SEED_LOOKAHEAD":           uint64(1),
+		"EPOCHS_PER_HISTORICAL_VECTOR": uint64(64),
+		"MAX_EFFECTIVE_BALANCE":        uint64(32000000000),
+		"DOMAIN_BEACON_ATTESTER":       spec.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_BEACON_PROPOSER":       spec.DomainType{0x00, 0x00, 0x00, 0x00},
+	}
+}
+
+// testState creates a state at the given slot with the given number of active validators.
+func testState(slot uint64, validators int) *spec.BeaconState {
+	state := &spec.BeaconState{
+		GenesisValidatorsRoot: make([]byte, 32),
+		Slot:                  slot,
+		Validators:            make([]*spec.Validator, validators),
+		Balances:              make([]uint64, validators),
+		RANDAOMixes:           make([][]byte, 64),
+		JustificationBits:     bitfield.Bitvector4{0x00},
+	}
+	for i := range state.Validators {
+		state.Validators[i] = &spec.Validator{
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+			ExitEpoch:             0xffffffffffffffff,
+			WithdrawableEpoch:     0xffffffffffffffff,
+		}
+		state.Balances[i] = 32000000000
+	}
+	for i := range state.RANDAOMixes {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, uint64(i))
+		mix := sha256.Sum256(data)
+		state.RANDAOMixes[i] = mix[:]
+	}
+	return state
+}
@@ -0,0 +1,80 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// maxRandomByte is the largest value of a random byte.
+const maxRandomByte = 1<<8 - 1
+
+// BeaconProposerIndex provides the index of the proposer for the slot of the state.
+// This is get_beacon_proposer_index in the Ethereum 2 specification.
+func (s *Service) BeaconProposerIndex(state *spec.BeaconState) (spec.ValidatorIndex, error) {
+	return s.BeaconProposerIndexAtSlot(state, spec.Slot(state.Slot))
+}
+
+// BeaconProposerIndexAtSlot provides the index of the proposer for a slot in the current epoch of the state.
+// This allows calculation of all proposers for an epoch from a single state.
+func (s *Service) BeaconProposerIndexAtSlot(state *spec.BeaconState, slot spec.Slot) (spec.ValidatorIndex, error) {
+	epoch := s.CurrentEpoch(state)
+	if s.EpochAtSlot(slot) != epoch {
+		return 0, fmt.Errorf("slot %d not in current epoch %d of state", slot, epoch)
+	}
+
+	epochSeed, err := s.Seed(state, epoch, s.beaconProposerDomain)
+	if err != nil {
+		return 0, err
+	}
+	data := make([]byte, 32+8)
+	copy(data, epochSeed[:])
+	binary.LittleEndian.PutUint64(data[32:], uint64(slot))
+	seed := sha256.Sum256(data)
+
+	return s.computeProposerIndex(state, s.ActiveValidatorIndices(state, epoch), seed)
+}
+
+// computeProposerIndex provides the proposer index from the supplied candidates.
+// This is compute_proposer_index in the Ethereum 2 specification.
+func (s *Service) computeProposerIndex(state *spec.BeaconState, indices []spec.ValidatorIndex, seed [32]byte) (spec.ValidatorIndex, error) {
+	if len(indices) == 0 {
+		return 0, errors.New("no active validators")
+	}
+
+	total := uint64(len(indices))
+	buf := make([]byte, 32+8)
+	copy(buf, seed[:])
+	var randomBytes [32]byte
+	for i := uint64(0); ; i++ {
+		shuffledIndex, err := s.ComputeShuffledIndex(i%total, total, seed)
+		if err != nil {
+			return 0, err
+		}
+		candidate := indices[shuffledIndex]
+		if i%32 == 0 {
+			binary.LittleEndian.PutUint64(buf[32:], i/32)
+			randomBytes = sha256.Sum256(buf)
+		}
+		effectiveBalance := uint64(state.Validators[candidate].EffectiveBalance)
+		if effectiveBalance*maxRandomByte >= s.maxEffectiveBalance*uint64(randomBytes[i%32]) {
+			return candidate, nil
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeaconProposerIndex(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	state := testState(17, 100)
+	// A validator with no effective balance can never be selected.
+	state.Validators[10].EffectiveBalance = 0
+
+	_, err = service.BeaconProposerIndexAtSlot(state, 24)
+	require.EqualError(t, err, "slot 24 not in current epoch 2 of state")
+
+	proposer, err := service.BeaconProposerIndex(state)
+	require.NoError(t, err)
+	proposerAtSlot, err := service.BeaconProposerIndexAtSlot(state, 17)
+	require.NoError(t, err)
+	require.Equal(t, proposer, proposerAtSlot)
+
+	for slot := spec.Slot(16); slot < 24; slot++ {
+		proposer, err := service.BeaconProposerIndexAtSlot(state, slot)
+		require.NoError(t, err)
+		require.True(t, helpers.IsActiveValidator(state.Validators[proposer], 2))
+		require.NotEqual(t, spec.ValidatorIndex(10), proposer)
+	}
+
+	state.Validators = nil
+	_, err = service.BeaconProposerIndex(state)
+	require.EqualError(t, err, "no active validators")
+}
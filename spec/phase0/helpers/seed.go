@@ -0,0 +1,47 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// RANDAOMix provides the RANDAO mix of the state at the given epoch.
+// This is get_randao_mix in the Ethereum 2 specification.
+func (s *Service) RANDAOMix(state *spec.BeaconState, epoch spec.Epoch) ([]byte, error) {
+	if uint64(len(state.RANDAOMixes)) != s.epochsPerHistoricalVector {
+		return nil, fmt.Errorf("state has %d RANDAO mixes; expected %d", len(state.RANDAOMixes), s.epochsPerHistoricalVector)
+	}
+	return state.RANDAOMixes[uint64(epoch)%s.epochsPerHistoricalVector], nil
+}
+
+// Seed provides the seed for the given epoch and domain type.
+// This is get_seed in the Ethereum 2 specification.
+func (s *Service) Seed(state *spec.BeaconState, epoch spec.Epoch, domainType spec.DomainType) ([32]byte, error) {
+	// Avoid underflow for the earliest epochs; the mix index wraps around the vector in any case.
+	mix, err := s.RANDAOMix(state, spec.Epoch(uint64(epoch)+s.epochsPerHistoricalVector-s.minSeedLookahead-1))
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	data := make([]byte, 4+8+32)
+	copy(data, domainType[:])
+	binary.LittleEndian.PutUint64(data[4:], uint64(epoch))
+	copy(data[12:], mix)
+	return sha256.Sum256(data), nil
+}
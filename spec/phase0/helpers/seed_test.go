@@ -0,0 +1,46 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeed(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	state := testState(17, 8)
+
+	// Seed for epoch 2 uses the mix at index (2 + 64 - 1 - 1) % 64 = 0.
+	expected := sha256.Sum256(append([]byte{0x01, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, state.RANDAOMixes[0]...))
+	seed, err := service.Seed(state, 2, spec.DomainType{0x01, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	require.Equal(t, expected, seed)
+
+	// Different domains give different seeds.
+	proposerSeed, err := service.Seed(state, 2, spec.DomainType{0x00, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+	require.NotEqual(t, seed, proposerSeed)
+
+	state.RANDAOMixes = state.RANDAOMixes[:10]
+	_, err = service.Seed(state, 2, spec.DomainType{0x01, 0x00, 0x00, 0x00})
+	require.EqualError(t, err, "state has 10 RANDAO mixes; expected 64")
+}
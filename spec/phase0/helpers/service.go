@@ -0,0 +1,125 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"context"
+	"sync"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service provides the beacon state accessor and helper functions of the
+// Ethereum 2 specification.
+type Service struct {
+	log                       zerolog.Logger
+	slotsPerEpoch             uint64
+	shuffleRoundCount         uint64
+	targetCommitteeSize       uint64
+	maxCommitteesPerSlot      uint64
+	minSeedLookahead          uint64
+	epochsPerHistoricalVector uint64
+	maxEffectiveBalance       uint64
+	beaconAttesterDomain      spec.DomainType
+	beaconProposerDomain      spec.DomainType
+
+	// Shufflings are expensive to calculate and are shared by all committees
+	// in an epoch, so we keep the most recent of them.
+	shufflings         map[shufflingKey][]uint64
+	shufflingKeys      []shufflingKey
+	shufflingCacheSize int
+	shufflingsMu       sync.Mutex
+}
+
+// New creates a new helper service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "helpers").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	chainSpec, err := parameters.specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	s := &Service{
+		log:                log,
+		shufflings:         make(map[shufflingKey][]uint64),
+		shufflingKeys:      make([]shufflingKey, 0, parameters.shufflingCacheSize),
+		shufflingCacheSize: parameters.shufflingCacheSize,
+	}
+	for k, v := range map[string]*uint64{
+		"SLOTS_PER_EPOCH":              &s.slotsPerEpoch,
+		"SHUFFLE_ROUND_COUNT":          &s.shuffleRoundCount,
+		"TARGET_COMMITTEE_SIZE":        &s.targetCommitteeSize,
+		"MAX_COMMITTEES_PER_SLOT":      &s.maxCommitteesPerSlot,
+		"MIN_SEED_LOOKAHEAD":           &s.minSeedLookahead,
+		"EPOCHS_PER_HISTORICAL_VECTOR": &s.epochsPerHistoricalVector,
+		"MAX_EFFECTIVE_BALANCE":        &s.maxEffectiveBalance,
+	} {
+		if *v, err = api.SpecUint64(chainSpec, k); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range map[string]*spec.DomainType{
+		"DOMAIN_BEACON_ATTESTER": &s.beaconAttesterDomain,
+		"DOMAIN_BEACON_PROPOSER": &s.beaconProposerDomain,
+	} {
+		if *v, err = api.SpecDomainType(chainSpec, k); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.slotsPerEpoch == 0 {
+		return nil, errors.New("slots per epoch cannot be 0")
+	}
+	if s.targetCommitteeSize == 0 {
+		return nil, errors.New("target committee size cannot be 0")
+	}
+	if s.epochsPerHistoricalVector <= s.minSeedLookahead {
+		return nil, errors.New("epochs per historical vector must be greater than min seed lookahead")
+	}
+
+	return s, nil
+}
+
+// SlotsPerEpoch provides the number of slots in an epoch.
+func (s *Service) SlotsPerEpoch() uint64 {
+	return s.slotsPerEpoch
+}
+
+// EpochAtSlot provides the epoch of the given slot.
+func (s *Service) EpochAtSlot(slot spec.Slot) spec.Epoch {
+	return spec.Epoch(uint64(slot) / s.slotsPerEpoch)
+}
+
+// EpochStartSlot provides the first slot of the given epoch.
+func (s *Service) EpochStartSlot(epoch spec.Epoch) spec.Slot {
+	return spec.Slot(uint64(epoch) * s.slotsPerEpoch)
+}
+
+// CurrentEpoch provides the epoch of the state.
+func (s *Service) CurrentEpoch(state *spec.BeaconState) spec.Epoch {
+	return s.EpochAtSlot(spec.Slot(state.Slot))
+}
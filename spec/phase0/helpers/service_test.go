@@ -0,0 +1,97 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	missingSlotsPerEpoch := newMinimalSpec()
+	delete(missingSlotsPerEpoch, "SLOTS_PER_EPOCH")
+	wrongTypeDomain := newMinimalSpec()
+	wrongTypeDomain["DOMAIN_BEACON_ATTESTER"] = []byte{0x01, 0x00}
+	zeroSlotsPerEpoch := newMinimalSpec()
+	zeroSlotsPerEpoch["SLOTS_PER_EPOCH"] = uint64(0)
+
+	tests := []struct {
+		name   string
+		params []helpers.Parameter
+		err    string
+	}{
+		{
+			name: "SpecProviderMissing",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no spec provider specified",
+		},
+		{
+			name: "ShufflingCacheSizeZero",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+				helpers.WithSpecProvider(newMinimalSpec()),
+				helpers.WithShufflingCacheSize(0),
+			},
+			err: "problem with parameters: shuffling cache size must be at least 1",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+				helpers.WithSpecProvider(missingSlotsPerEpoch),
+			},
+			err: "SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "DomainInvalid",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+				helpers.WithSpecProvider(wrongTypeDomain),
+			},
+			err: "invalid value for DOMAIN_BEACON_ATTESTER: incorrect length 2",
+		},
+		{
+			name: "SlotsPerEpochZero",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+				helpers.WithSpecProvider(zeroSlotsPerEpoch),
+			},
+			err: "slots per epoch cannot be 0",
+		},
+		{
+			name: "Good",
+			params: []helpers.Parameter{
+				helpers.WithLogLevel(zerolog.Disabled),
+				helpers.WithSpecProvider(newMinimalSpec()),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := helpers.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// shufflingKey is the key for the shuffling cache.
+type shufflingKey struct {
+	seed  [32]byte
+	count uint64
+}
+
+// ComputeShuffledIndex provides the shuffled index corresponding to the
+// given index in a list of indexCount entries.
+// This is compute_shuffled_index in the Ethereum 2 specification.
+func (s *Service) ComputeShuffledIndex(index uint64, indexCount uint64, seed [32]byte) (uint64, error) {
+	if index >= indexCount {
+		return 0, errors.New("index out of range")
+	}
+
+	buf := make([]byte, 32+1+4)
+	copy(buf, seed[:])
+	for round := uint64(0); round < s.shuffleRoundCount; round++ {
+		buf[32] = byte(round)
+		pivotHash := sha256.Sum256(buf[:33])
+		pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % indexCount
+		flip := (pivot + indexCount - index) % indexCount
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+		source := sha256.Sum256(buf)
+		if (source[(position%256)/8]>>(position%8))%2 == 1 {
+			index = flip
+		}
+	}
+	return index, nil
+}
+
+// shuffledIndices provides the indices of a list of the given size in shuffled
+// order, such that entry i of the result is ComputeShuffledIndex(i, count, seed).
+// This runs all rounds over the full list at once, sharing the hashes between
+// positions, and caches the result.
+func (s *Service) shuffledIndices(count uint64, seed [32]byte) []uint64 {
+	key := shufflingKey{seed: seed, count: count}
+	s.shufflingsMu.Lock()
+	defer s.shufflingsMu.Unlock()
+	if res, exists := s.shufflings[key]; exists {
+		return res
+	}
+
+	res := make([]uint64, count)
+	for i := range res {
+		res[i] = uint64(i)
+	}
+	if count > 1 {
+		buf := make([]byte, 32+1+4)
+		copy(buf, seed[:])
+		sources := make([][32]byte, (count+255)/256)
+		for round := uint64(0); round < s.shuffleRoundCount; round++ {
+			buf[32] = byte(round)
+			pivotHash := sha256.Sum256(buf[:33])
+			pivot := binary.LittleEndian.Uint64(pivotHash[:8]) % count
+			for i := range sources {
+				binary.LittleEndian.PutUint32(buf[33:], uint32(i))
+				sources[i] = sha256.Sum256(buf)
+			}
+			for i, index := range res {
+				flip := (pivot + count - index) % count
+				position := index
+				if flip > position {
+					position = flip
+				}
+				if (sources[position/256][(position%256)/8]>>(position%8))%2 == 1 {
+					res[i] = flip
+				}
+			}
+		}
+	}
+
+	if len(s.shufflingKeys) == s.shufflingCacheSize {
+		delete(s.shufflings, s.shufflingKeys[0])
+		s.shufflingKeys = s.shufflingKeys[1:]
+	}
+	s.shufflings[key] = res
+	s.shufflingKeys = append(s.shufflingKeys, key)
+	s.log.Trace().Uint64("count", count).Msg("Calculated shuffling")
+
+	return res
+}
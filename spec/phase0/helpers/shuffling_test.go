@@ -0,0 +1,77 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeShuffledIndex(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	seed := sha256.Sum256([]byte("seed"))
+
+	_, err = service.ComputeShuffledIndex(10, 10, seed)
+	require.EqualError(t, err, "index out of range")
+
+	// A single entry always maps to itself.
+	index, err := service.ComputeShuffledIndex(0, 1, seed)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), index)
+
+	// Shuffled indices form a permutation.
+	for _, count := range []uint64{2, 7, 100, 300, 1000} {
+		seen := make(map[uint64]bool)
+		for i := uint64(0); i < count; i++ {
+			index, err := service.ComputeShuffledIndex(i, count, seed)
+			require.NoError(t, err)
+			require.Less(t, index, count)
+			seen[index] = true
+		}
+		require.Len(t, seen, int(count))
+	}
+}
+
+func TestShufflingMatchesShuffledIndex(t *testing.T) {
+	service, err := helpers.New(context.Background(), helpers.WithSpecProvider(newMinimalSpec()))
+	require.NoError(t, err)
+
+	// 600 validators gives 4 committees per slot of varying sizes, and spans multiple 256-position hash chunks.
+	state := testState(20, 600)
+	epoch := service.CurrentEpoch(state)
+	seed, err := service.Seed(state, epoch, spec.DomainType{0x01, 0x00, 0x00, 0x00})
+	require.NoError(t, err)
+
+	committees, err := service.BeaconCommittees(state, epoch)
+	require.NoError(t, err)
+
+	// Rebuild the committees index by index using the specification's algorithm.
+	i := uint64(0)
+	for _, committee := range committees {
+		for _, validatorIndex := range committee.Validators {
+			shuffledIndex, err := service.ComputeShuffledIndex(i, 600, seed)
+			require.NoError(t, err)
+			require.Equal(t, spec.ValidatorIndex(shuffledIndex), validatorIndex)
+			i++
+		}
+	}
+	require.Equal(t, uint64(600), i)
+}
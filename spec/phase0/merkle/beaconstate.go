@@ -0,0 +1,135 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BeaconStateField is the index of a field in the BeaconState container.
+type BeaconStateField uint64
+
+// Fields of the BeaconState container, in SSZ order.
+const (
+	BeaconStateGenesisTime BeaconStateField = iota
+	BeaconStateGenesisValidatorsRoot
+	BeaconStateSlot
+	BeaconStateFork
+	BeaconStateLatestBlockHeader
+	BeaconStateBlockRoots
+	BeaconStateStateRoots
+	BeaconStateHistoricalRoots
+	BeaconStateETH1Data
+	BeaconStateETH1DataVotes
+	BeaconStateETH1DepositIndex
+	BeaconStateValidators
+	BeaconStateBalances
+	BeaconStateRANDAOMixes
+	BeaconStateSlashings
+	BeaconStatePreviousEpochAttestations
+	BeaconStateCurrentEpochAttestations
+	BeaconStateJustificationBits
+	BeaconStatePreviousJustifiedCheckpoint
+	BeaconStateCurrentJustifiedCheckpoint
+	BeaconStateFinalizedCheckpoint
+
+	beaconStateFields
+)
+
+// Depths of the trees within the BeaconState, as defined by the SSZ sizes and limits of the fields.
+const (
+	beaconStateDepth         = 5
+	blockRootsDepth          = 13 // 8192 roots.
+	stateRootsDepth          = 13 // 8192 roots.
+	historicalRootsDepth     = 24 // 16777216 roots.
+	eth1DataVotesDepth       = 11 // 2048 votes.
+	validatorsDepth          = 40 // 1099511627776 validators.
+	balancesDepth            = 38 // 1099511627776 balances, 4 per chunk.
+	randaoMixesDepth         = 16 // 65536 mixes.
+	slashingsDepth           = 11 // 8192 amounts, 4 per chunk.
+	pendingAttestationsDepth = 12 // 4096 attestations.
+)
+
+// GeneralizedIndex returns the generalized index of the field within a BeaconState.
+func (f BeaconStateField) GeneralizedIndex() uint64 {
+	return 1<<beaconStateDepth + uint64(f)
+}
+
+// ValidatorGeneralizedIndex returns the generalized index of a validator within a BeaconState.
+func ValidatorGeneralizedIndex(index spec.ValidatorIndex) uint64 {
+	// The list data is the left child of the list root, the length the right child.
+	return ConcatGeneralizedIndices(BeaconStateValidators.GeneralizedIndex(), 2, 1<<validatorsDepth+uint64(index))
+}
+
+// BalanceGeneralizedIndex returns the generalized index of the chunk containing a validator's balance within a BeaconState.
+func BalanceGeneralizedIndex(index spec.ValidatorIndex) uint64 {
+	return ConcatGeneralizedIndices(BeaconStateBalances.GeneralizedIndex(), 2, 1<<balancesDepth+uint64(index)/4)
+}
+
+// BalanceFromChunk returns a validator's balance from the chunk that contains it.
+func BalanceFromChunk(chunk [32]byte, index spec.ValidatorIndex) spec.Gwei {
+	offset := (uint64(index) % 4) * 8
+	res := uint64(0)
+	for i := uint64(0); i < 8; i++ {
+		res |= uint64(chunk[offset+i]) << (8 * i)
+	}
+	return spec.Gwei(res)
+}
+
+// Proof is a Merkle proof that a leaf is present in a tree.
+type Proof struct {
+	// Leaf is the chunk being proved.
+	Leaf [32]byte
+	// Branch is the list of sibling chunks, from the leaf upwards.
+	Branch [][32]byte
+	// GeneralizedIndex is the generalized index of the leaf.
+	GeneralizedIndex uint64
+}
+
+// Verify verifies the proof against the given root.
+func (p *Proof) Verify(root spec.Root) bool {
+	return VerifyProof(root, p.Leaf, p.Branch, p.GeneralizedIndex)
+}
+
+// ProveBeaconStateField generates a proof of the root of a field in the state.
+// For example, the finalized checkpoint in a state can be proved with the field
+// BeaconStateFinalizedCheckpoint, with the leaf being the hash tree root of the checkpoint.
+func ProveBeaconStateField(state *spec.BeaconState, field BeaconStateField) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ProveField(field)
+}
+
+// ProveValidator generates a proof of a validator in the state.
+// The leaf of the proof is the hash tree root of the validator.
+func ProveValidator(state *spec.BeaconState, index spec.ValidatorIndex) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ProveValidator(index)
+}
+
+// ProveBalance generates a proof of a validator's balance in the state.
+// The leaf of the proof is the chunk containing the balance, which is shared with
+// three other validators; use BalanceFromChunk to obtain the balance from the leaf.
+func ProveBalance(state *spec.BeaconState, index spec.ValidatorIndex) (*Proof, error) {
+	tree, err := NewBeaconStateTree(state)
+	if err != nil {
+		return nil, err
+	}
+	return tree.ProveBalance(index)
+}
@@ -0,0 +1,122 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle_test
+
+import (
+	"fmt"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProveBeaconStateField(t *testing.T) {
+	state := testState(t, 10)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	for field := merkle.BeaconStateGenesisTime; field <= merkle.BeaconStateFinalizedCheckpoint; field++ {
+		t.Run(fmt.Sprintf("Field%d", field), func(t *testing.T) {
+			proof, err := merkle.ProveBeaconStateField(state, field)
+			require.NoError(t, err)
+			require.Equal(t, field.GeneralizedIndex(), proof.GeneralizedIndex)
+			require.True(t, proof.Verify(root))
+		})
+	}
+
+	_, err = merkle.ProveBeaconStateField(state, merkle.BeaconStateFinalizedCheckpoint+1)
+	require.EqualError(t, err, "unknown field 21")
+}
+
+func TestProveFinalizedCheckpoint(t *testing.T) {
+	state := testState(t, 10)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	proof, err := merkle.ProveBeaconStateField(state, merkle.BeaconStateFinalizedCheckpoint)
+	require.NoError(t, err)
+	checkpointRoot, err := state.FinalizedCheckpoint.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, checkpointRoot, proof.Leaf)
+	require.True(t, proof.Verify(root))
+
+	// Extend the proof down to the finalized root within the checkpoint.
+	epochChunk := [32]byte{}
+	epochChunk[0] = byte(state.FinalizedCheckpoint.Epoch)
+	epochChunk[1] = byte(state.FinalizedCheckpoint.Epoch >> 8)
+	branch := append([][32]byte{epochChunk}, proof.Branch...)
+	index := merkle.ConcatGeneralizedIndices(merkle.BeaconStateFinalizedCheckpoint.GeneralizedIndex(), 3)
+	require.True(t, merkle.VerifyProof(root, state.FinalizedCheckpoint.Root, branch, index))
+
+	// A different finalized root does not verify.
+	require.False(t, merkle.VerifyProof(root, spec.Root{0x01}, branch, index))
+}
+
+func TestProveValidator(t *testing.T) {
+	state := testState(t, 37)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	for _, index := range []spec.ValidatorIndex{0, 1, 17, 36} {
+		proof, err := merkle.ProveValidator(state, index)
+		require.NoError(t, err)
+		validatorRoot, err := state.Validators[index].HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, validatorRoot, proof.Leaf)
+		require.Equal(t, merkle.ValidatorGeneralizedIndex(index), proof.GeneralizedIndex)
+		require.Len(t, proof.Branch, 46)
+		require.True(t, proof.Verify(root))
+
+		// The proof does not hold for a modified validator.
+		proof.Leaf[0] ^= 0xff
+		require.False(t, proof.Verify(root))
+	}
+
+	_, err = merkle.ProveValidator(state, 37)
+	require.EqualError(t, err, "validator 37 not present in state")
+}
+
+func TestProveBalance(t *testing.T) {
+	state := testState(t, 37)
+	root, err := state.HashTreeRoot()
+	require.NoError(t, err)
+
+	for _, index := range []spec.ValidatorIndex{0, 3, 4, 36} {
+		proof, err := merkle.ProveBalance(state, index)
+		require.NoError(t, err)
+		require.Equal(t, spec.Gwei(state.Balances[index]), merkle.BalanceFromChunk(proof.Leaf, index))
+		require.Equal(t, merkle.BalanceGeneralizedIndex(index), proof.GeneralizedIndex)
+		require.Len(t, proof.Branch, 44)
+		require.True(t, proof.Verify(root))
+	}
+
+	_, err = merkle.ProveBalance(state, 37)
+	require.EqualError(t, err, "balance 37 not present in state")
+}
+
+func TestProveInvalidState(t *testing.T) {
+	_, err := merkle.ProveBeaconStateField(nil, merkle.BeaconStateSlot)
+	require.EqualError(t, err, "no state supplied")
+
+	state := testState(t, 1)
+	state.BlockRoots = state.BlockRoots[1:]
+	_, err = merkle.ProveBeaconStateField(state, merkle.BeaconStateSlot)
+	require.EqualError(t, err, "block roots length 8191 is not 8192")
+
+	state = testState(t, 1)
+	state.HistoricalRoots[1] = []byte{0x01}
+	_, err = merkle.ProveBeaconStateField(state, merkle.BeaconStateSlot)
+	require.EqualError(t, err, "historical roots entry 1 length 1 is not 32")
+}
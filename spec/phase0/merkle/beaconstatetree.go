@@ -0,0 +1,263 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	"fmt"
+	"sort"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// BeaconStateTree is a cached Merkle tree for a BeaconState.
+//
+// The tree holds a reference to the state, and rehashes only what has changed
+// when the root is requested.  Fields that are not lists or vectors are small,
+// and are rehashed on every request.  Entries appended to lists, for example new
+// validators, are picked up automatically.  Entries modified in place in lists or
+// vectors, for example a validator's balance, must be reported with MarkEntryDirty,
+// and a list or vector replaced in its entirety must be reported with MarkFieldDirty.
+//
+// A tree is not safe for concurrent use.
+type BeaconStateTree struct {
+	state  *spec.BeaconState
+	fields *Tree
+	// subtrees contains the trees for list and vector fields; a missing
+	// entry is rebuilt from the state when the root is next requested.
+	subtrees map[BeaconStateField]*subtree
+}
+
+// subtree is the tree for a list or vector field.
+type subtree struct {
+	tree *Tree
+	// entries is the number of entries in the field when last hashed.
+	entries int
+	// dirty contains the indices of chunks whose entries have changed.
+	dirty map[int]struct{}
+}
+
+// NewBeaconStateTree creates a cached tree for the given state.
+func NewBeaconStateTree(state *spec.BeaconState) (*BeaconStateTree, error) {
+	if state == nil {
+		return nil, errors.New("no state supplied")
+	}
+
+	fields, err := NewTree(make([][32]byte, beaconStateFields), beaconStateDepth)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build state tree")
+	}
+	t := &BeaconStateTree{
+		state:    state,
+		fields:   fields,
+		subtrees: make(map[BeaconStateField]*subtree),
+	}
+	if err := t.update(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// MarkFieldDirty marks a field as changed in its entirety.
+func (t *BeaconStateTree) MarkFieldDirty(field BeaconStateField) {
+	delete(t.subtrees, field)
+}
+
+// MarkEntryDirty marks an entry in a list or vector field as changed.
+func (t *BeaconStateTree) MarkEntryDirty(field BeaconStateField, index uint64) error {
+	compositeField, exists := compositeFields[field]
+	if !exists {
+		return fmt.Errorf("field %d is not a list or vector", field)
+	}
+	subtree, exists := t.subtrees[field]
+	if !exists {
+		// Will be rebuilt in full.
+		return nil
+	}
+	subtree.dirty[int(index)/compositeField.perChunk] = struct{}{}
+	return nil
+}
+
+// Root returns the hash tree root of the state.
+func (t *BeaconStateTree) Root() (spec.Root, error) {
+	if err := t.update(); err != nil {
+		return spec.Root{}, err
+	}
+	return t.fields.Root(), nil
+}
+
+// update updates the tree with changes to the state.
+func (t *BeaconStateTree) update() error {
+	for field := BeaconStateField(0); field < beaconStateFields; field++ {
+		var root [32]byte
+		var err error
+		if compositeField, exists := compositeFields[field]; exists {
+			root, err = t.updateSubtree(field, compositeField)
+		} else {
+			root, err = basicFieldRoot(t.state, field)
+		}
+		if err != nil {
+			return err
+		}
+		if err := t.fields.SetChunk(uint64(field), root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSubtree updates the tree for a list or vector field, returning the root of the field.
+func (t *BeaconStateTree) updateSubtree(field BeaconStateField, compositeField *compositeField) ([32]byte, error) {
+	entries := compositeField.length(t.state)
+	if !compositeField.isList {
+		vectorLength := (1 << compositeField.depth) * compositeField.perChunk
+		if entries != vectorLength {
+			return [32]byte{}, fmt.Errorf("%s length %d is not %d", compositeField.name, entries, vectorLength)
+		}
+	}
+
+	st, exists := t.subtrees[field]
+	if !exists || entries < st.entries {
+		// Build the subtree from scratch.
+		chunks := make([][32]byte, compositeField.chunks(entries))
+		for i := range chunks {
+			chunk, err := compositeField.chunk(t.state, i)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			chunks[i] = chunk
+		}
+		tree, err := NewTree(chunks, compositeField.depth)
+		if err != nil {
+			return [32]byte{}, errors.Wrapf(err, "failed to build %s tree", compositeField.name)
+		}
+		st = &subtree{
+			tree:    tree,
+			entries: entries,
+			dirty:   make(map[int]struct{}),
+		}
+		t.subtrees[field] = st
+	} else {
+		if entries > st.entries {
+			// Entries have been appended.  The last existing chunk may have been partially filled.
+			for i := st.entries / compositeField.perChunk; i < compositeField.chunks(entries); i++ {
+				st.dirty[i] = struct{}{}
+			}
+		}
+		// Sort the indices, as appended chunks must be added in order.
+		indices := make([]int, 0, len(st.dirty))
+		for i := range st.dirty {
+			indices = append(indices, i)
+		}
+		sort.Ints(indices)
+		for _, i := range indices {
+			chunk, err := compositeField.chunk(t.state, i)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			if i < st.tree.Len() {
+				err = st.tree.SetChunk(uint64(i), chunk)
+			} else {
+				err = st.tree.Append(chunk)
+			}
+			if err != nil {
+				return [32]byte{}, errors.Wrapf(err, "failed to update %s tree", compositeField.name)
+			}
+		}
+		st.entries = entries
+		st.dirty = make(map[int]struct{})
+	}
+
+	if compositeField.isList {
+		return mixInLength(st.tree.Root(), uint64(entries)), nil
+	}
+	return st.tree.Root(), nil
+}
+
+// ProveField generates a proof of the root of a field in the state.
+func (t *BeaconStateTree) ProveField(field BeaconStateField) (*Proof, error) {
+	if field >= beaconStateFields {
+		return nil, fmt.Errorf("unknown field %d", field)
+	}
+	if err := t.update(); err != nil {
+		return nil, err
+	}
+	branch, err := t.fields.Proof(uint64(field))
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := t.fields.Chunk(uint64(field))
+	if err != nil {
+		return nil, err
+	}
+	return &Proof{
+		Leaf:             leaf,
+		Branch:           branch,
+		GeneralizedIndex: field.GeneralizedIndex(),
+	}, nil
+}
+
+// ProveValidator generates a proof of a validator in the state.
+// The leaf of the proof is the hash tree root of the validator.
+func (t *BeaconStateTree) ProveValidator(index spec.ValidatorIndex) (*Proof, error) {
+	if uint64(index) >= uint64(len(t.state.Validators)) {
+		return nil, fmt.Errorf("validator %d not present in state", index)
+	}
+	return t.proveEntry(BeaconStateValidators, uint64(index), ValidatorGeneralizedIndex(index))
+}
+
+// ProveBalance generates a proof of a validator's balance in the state.
+// The leaf of the proof is the chunk containing the balance, which is shared with
+// three other validators; use BalanceFromChunk to obtain the balance from the leaf.
+func (t *BeaconStateTree) ProveBalance(index spec.ValidatorIndex) (*Proof, error) {
+	if uint64(index) >= uint64(len(t.state.Balances)) {
+		return nil, fmt.Errorf("balance %d not present in state", index)
+	}
+	return t.proveEntry(BeaconStateBalances, uint64(index), BalanceGeneralizedIndex(index))
+}
+
+// proveEntry generates the proof for the chunk holding an entry in a list field.
+func (t *BeaconStateTree) proveEntry(field BeaconStateField, index uint64, generalizedIndex uint64) (*Proof, error) {
+	if err := t.update(); err != nil {
+		return nil, err
+	}
+	st := t.subtrees[field]
+	chunkIndex := index / uint64(compositeFields[field].perChunk)
+
+	listBranch, err := st.tree.Proof(chunkIndex)
+	if err != nil {
+		return nil, err
+	}
+	fieldBranch, err := t.fields.Proof(uint64(field))
+	if err != nil {
+		return nil, err
+	}
+	leaf, err := st.tree.Chunk(chunkIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// The list data is the left child of the field root, with the length on the right.
+	branch := make([][32]byte, 0, len(listBranch)+1+len(fieldBranch))
+	branch = append(branch, listBranch...)
+	branch = append(branch, lengthChunk(uint64(st.entries)))
+	branch = append(branch, fieldBranch...)
+
+	return &Proof{
+		Leaf:             leaf,
+		Branch:           branch,
+		GeneralizedIndex: generalizedIndex,
+	}, nil
+}
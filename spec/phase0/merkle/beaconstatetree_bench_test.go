@@ -0,0 +1,108 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+)
+
+// benchmarkValidators is the number of validators in benchmark states.
+const benchmarkValidators = 100000
+
+func BenchmarkHashTreeRoot(b *testing.B) {
+	state := testState(b, benchmarkValidators)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		state.Slot++
+		if _, err := state.HashTreeRoot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateTreeNew(b *testing.B) {
+	state := testState(b, benchmarkValidators)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := merkle.NewBeaconStateTree(state); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchmarkBeaconStateTree benchmarks the re-root time after the given mutation.
+func benchmarkBeaconStateTree(b *testing.B, mutate func(int, *spec.BeaconState, *merkle.BeaconStateTree)) {
+	state := testState(b, benchmarkValidators)
+	tree, err := merkle.NewBeaconStateTree(state)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mutate(i, state, tree)
+		if _, err := tree.Root(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBeaconStateTreeSlot(b *testing.B) {
+	benchmarkBeaconStateTree(b, func(i int, state *spec.BeaconState, tree *merkle.BeaconStateTree) {
+		state.Slot++
+	})
+}
+
+func BenchmarkBeaconStateTreeBalance(b *testing.B) {
+	benchmarkBeaconStateTree(b, func(i int, state *spec.BeaconState, tree *merkle.BeaconStateTree) {
+		index := i % len(state.Balances)
+		state.Balances[index]++
+		if err := tree.MarkEntryDirty(merkle.BeaconStateBalances, uint64(index)); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+func BenchmarkBeaconStateTreeValidator(b *testing.B) {
+	benchmarkBeaconStateTree(b, func(i int, state *spec.BeaconState, tree *merkle.BeaconStateTree) {
+		index := i % len(state.Validators)
+		state.Validators[index].EffectiveBalance--
+		if err := tree.MarkEntryDirty(merkle.BeaconStateValidators, uint64(index)); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+func BenchmarkBeaconStateTreeAppendValidator(b *testing.B) {
+	benchmarkBeaconStateTree(b, func(i int, state *spec.BeaconState, tree *merkle.BeaconStateTree) {
+		state.Validators = append(state.Validators, &spec.Validator{
+			PublicKey:             spec.BLSPubKey{byte(i), byte(i >> 8), byte(i >> 16)},
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      32000000000,
+		})
+		state.Balances = append(state.Balances, 32000000000)
+	})
+}
+
+func BenchmarkBeaconStateTreeBlockRoot(b *testing.B) {
+	benchmarkBeaconStateTree(b, func(i int, state *spec.BeaconState, tree *merkle.BeaconStateTree) {
+		index := i % len(state.BlockRoots)
+		state.BlockRoots[index][31]++
+		if err := tree.MarkEntryDirty(merkle.BeaconStateBlockRoots, uint64(index)); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
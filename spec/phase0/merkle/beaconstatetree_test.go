@@ -0,0 +1,181 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// requireRoot requires that the tree root matches the root calculated from scratch.
+func requireRoot(t *testing.T, tree *merkle.BeaconStateTree, state *spec.BeaconState) {
+	expected, err := state.HashTreeRoot()
+	require.NoError(t, err)
+	root, err := tree.Root()
+	require.NoError(t, err)
+	require.Equal(t, spec.Root(expected), root)
+}
+
+func TestBeaconStateTree(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*spec.BeaconState, *merkle.BeaconStateTree) error
+	}{
+		{
+			name:   "Unchanged",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error { return nil },
+		},
+		{
+			name: "Slot",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.Slot++
+				return nil
+			},
+		},
+		{
+			name: "FinalizedCheckpoint",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.FinalizedCheckpoint = &spec.Checkpoint{Epoch: 383, Root: spec.Root{0x13}}
+				return nil
+			},
+		},
+		{
+			name: "Balance",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.Balances[6]++
+				return tree.MarkEntryDirty(merkle.BeaconStateBalances, 6)
+			},
+		},
+		{
+			name: "AllBalances",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				for i := range state.Balances {
+					state.Balances[i] -= 5
+				}
+				tree.MarkFieldDirty(merkle.BeaconStateBalances)
+				return nil
+			},
+		},
+		{
+			name: "Validator",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.Validators[3].Slashed = true
+				return tree.MarkEntryDirty(merkle.BeaconStateValidators, 3)
+			},
+		},
+		{
+			name: "AppendValidators",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				for i := 0; i < 5; i++ {
+					state.Validators = append(state.Validators, &spec.Validator{
+						PublicKey:             spec.BLSPubKey{0xff, byte(i)},
+						WithdrawalCredentials: make([]byte, 32),
+						EffectiveBalance:      32000000000,
+					})
+					state.Balances = append(state.Balances, 32000000000)
+				}
+				return nil
+			},
+		},
+		{
+			name: "TruncateAttestations",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.PreviousEpochAttestations = state.CurrentEpochAttestations
+				state.CurrentEpochAttestations = nil
+				return nil
+			},
+		},
+		{
+			name: "BlockRoot",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.BlockRoots[100] = make([]byte, 32)
+				return tree.MarkEntryDirty(merkle.BeaconStateBlockRoots, 100)
+			},
+		},
+		{
+			name: "RANDAOMixes",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.RANDAOMixes = filledRoots(65536, 0x20)
+				tree.MarkFieldDirty(merkle.BeaconStateRANDAOMixes)
+				return nil
+			},
+		},
+		{
+			name: "Slashing",
+			mutate: func(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+				state.Slashings[8191] = 1000
+				return tree.MarkEntryDirty(merkle.BeaconStateSlashings, 8191)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := testState(t, 21)
+			tree, err := merkle.NewBeaconStateTree(state)
+			require.NoError(t, err)
+			requireRoot(t, tree, state)
+
+			require.NoError(t, test.mutate(state, tree))
+			requireRoot(t, tree, state)
+
+			// Proofs are generated from the updated tree.
+			root, err := tree.Root()
+			require.NoError(t, err)
+			last := spec.ValidatorIndex(len(state.Validators) - 1)
+			proof, err := tree.ProveValidator(last)
+			require.NoError(t, err)
+			require.True(t, proof.Verify(root))
+			proof, err = tree.ProveBalance(last)
+			require.NoError(t, err)
+			require.Equal(t, spec.Gwei(state.Balances[last]), merkle.BalanceFromChunk(proof.Leaf, last))
+			require.True(t, proof.Verify(root))
+		})
+	}
+}
+
+func TestBeaconStateTreeUnmarked(t *testing.T) {
+	state := testState(t, 8)
+	tree, err := merkle.NewBeaconStateTree(state)
+	require.NoError(t, err)
+	before, err := tree.Root()
+	require.NoError(t, err)
+
+	// An in-place change that is not marked is not seen.
+	state.Balances[2]++
+	root, err := tree.Root()
+	require.NoError(t, err)
+	require.Equal(t, before, root)
+
+	require.NoError(t, tree.MarkEntryDirty(merkle.BeaconStateBalances, 2))
+	requireRoot(t, tree, state)
+}
+
+func TestBeaconStateTreeErrors(t *testing.T) {
+	_, err := merkle.NewBeaconStateTree(nil)
+	require.EqualError(t, err, "no state supplied")
+
+	state := testState(t, 8)
+	tree, err := merkle.NewBeaconStateTree(state)
+	require.NoError(t, err)
+
+	require.EqualError(t, tree.MarkEntryDirty(merkle.BeaconStateSlot, 0), "field 2 is not a list or vector")
+
+	state.StateRoots = append(state.StateRoots, make([]byte, 32))
+	_, err = tree.Root()
+	require.EqualError(t, err, "state roots length 8193 is not 8192")
+}
@@ -0,0 +1,175 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// compositeField describes a list or vector field of the state, which has its own tree.
+type compositeField struct {
+	name     string
+	depth    uint64
+	isList   bool
+	perChunk int
+	// length returns the number of entries in the field.
+	length func(state *spec.BeaconState) int
+	// chunk returns the chunk at the given index of the field.
+	chunk func(state *spec.BeaconState, index int) ([32]byte, error)
+}
+
+// chunks returns the number of chunks required to hold the given number of entries.
+func (f *compositeField) chunks(entries int) int {
+	return (entries + f.perChunk - 1) / f.perChunk
+}
+
+// compositeFields contains the list and vector fields of the state.
+var compositeFields = map[BeaconStateField]*compositeField{
+	BeaconStateBlockRoots: rootsField("block roots", blockRootsDepth, false,
+		func(state *spec.BeaconState) [][]byte { return state.BlockRoots }),
+	BeaconStateStateRoots: rootsField("state roots", stateRootsDepth, false,
+		func(state *spec.BeaconState) [][]byte { return state.StateRoots }),
+	BeaconStateHistoricalRoots: rootsField("historical roots", historicalRootsDepth, true,
+		func(state *spec.BeaconState) [][]byte { return state.HistoricalRoots }),
+	BeaconStateETH1DataVotes: containersField("eth1 data votes", eth1DataVotesDepth,
+		func(state *spec.BeaconState) int { return len(state.ETH1DataVotes) },
+		func(state *spec.BeaconState, index int) ssz.HashRoot { return state.ETH1DataVotes[index] }),
+	BeaconStateValidators: containersField("validators", validatorsDepth,
+		func(state *spec.BeaconState) int { return len(state.Validators) },
+		func(state *spec.BeaconState, index int) ssz.HashRoot { return state.Validators[index] }),
+	BeaconStateBalances: uint64sField("balances", balancesDepth, true,
+		func(state *spec.BeaconState) []uint64 { return state.Balances }),
+	BeaconStateRANDAOMixes: rootsField("RANDAO mixes", randaoMixesDepth, false,
+		func(state *spec.BeaconState) [][]byte { return state.RANDAOMixes }),
+	BeaconStateSlashings: uint64sField("slashings", slashingsDepth, false,
+		func(state *spec.BeaconState) []uint64 { return state.Slashings }),
+	BeaconStatePreviousEpochAttestations: containersField("previous epoch attestations", pendingAttestationsDepth,
+		func(state *spec.BeaconState) int { return len(state.PreviousEpochAttestations) },
+		func(state *spec.BeaconState, index int) ssz.HashRoot { return state.PreviousEpochAttestations[index] }),
+	BeaconStateCurrentEpochAttestations: containersField("current epoch attestations", pendingAttestationsDepth,
+		func(state *spec.BeaconState) int { return len(state.CurrentEpochAttestations) },
+		func(state *spec.BeaconState, index int) ssz.HashRoot { return state.CurrentEpochAttestations[index] }),
+}
+
+// rootsField describes a field containing 32-byte roots.
+func rootsField(name string, depth uint64, isList bool, get func(*spec.BeaconState) [][]byte) *compositeField {
+	return &compositeField{
+		name:     name,
+		depth:    depth,
+		isList:   isList,
+		perChunk: 1,
+		length:   func(state *spec.BeaconState) int { return len(get(state)) },
+		chunk: func(state *spec.BeaconState, index int) ([32]byte, error) {
+			return bytesChunk(fmt.Sprintf("%s entry %d", name, index), get(state)[index], 32)
+		},
+	}
+}
+
+// uint64sField describes a field containing uint64s, packed 4 to a chunk.
+func uint64sField(name string, depth uint64, isList bool, get func(*spec.BeaconState) []uint64) *compositeField {
+	return &compositeField{
+		name:     name,
+		depth:    depth,
+		isList:   isList,
+		perChunk: 4,
+		length:   func(state *spec.BeaconState) int { return len(get(state)) },
+		chunk: func(state *spec.BeaconState, index int) ([32]byte, error) {
+			vals := get(state)
+			var chunk [32]byte
+			for i := 0; i < 4 && index*4+i < len(vals); i++ {
+				putUint64(chunk[i*8:], vals[index*4+i])
+			}
+			return chunk, nil
+		},
+	}
+}
+
+// containersField describes a list field containing containers.
+func containersField(name string,
+	depth uint64,
+	length func(*spec.BeaconState) int,
+	get func(*spec.BeaconState, int) ssz.HashRoot,
+) *compositeField {
+	return &compositeField{
+		name:     name,
+		depth:    depth,
+		isList:   true,
+		perChunk: 1,
+		length:   length,
+		chunk: func(state *spec.BeaconState, index int) ([32]byte, error) {
+			return containerRoot(fmt.Sprintf("%s entry %d", name, index), get(state, index))
+		},
+	}
+}
+
+// basicFieldRoot calculates the root of a field in the state that does not have its own tree.
+// These fields are small, so are recalculated whenever the state root is requested.
+func basicFieldRoot(state *spec.BeaconState, field BeaconStateField) ([32]byte, error) {
+	switch field {
+	case BeaconStateGenesisTime:
+		return uint64Chunk(state.GenesisTime), nil
+	case BeaconStateGenesisValidatorsRoot:
+		return bytesChunk("genesis validators root", state.GenesisValidatorsRoot, 32)
+	case BeaconStateSlot:
+		return uint64Chunk(state.Slot), nil
+	case BeaconStateFork:
+		return containerRoot("fork", state.Fork)
+	case BeaconStateLatestBlockHeader:
+		return containerRoot("latest block header", state.LatestBlockHeader)
+	case BeaconStateETH1Data:
+		return containerRoot("eth1 data", state.ETH1Data)
+	case BeaconStateETH1DepositIndex:
+		return uint64Chunk(state.ETH1DepositIndex), nil
+	case BeaconStateJustificationBits:
+		return bytesChunk("justification bits", state.JustificationBits, 1)
+	case BeaconStatePreviousJustifiedCheckpoint:
+		return containerRoot("previous justified checkpoint", state.PreviousJustifiedCheckpoint)
+	case BeaconStateCurrentJustifiedCheckpoint:
+		return containerRoot("current justified checkpoint", state.CurrentJustifiedCheckpoint)
+	case BeaconStateFinalizedCheckpoint:
+		return containerRoot("finalized checkpoint", state.FinalizedCheckpoint)
+	default:
+		return [32]byte{}, fmt.Errorf("unhandled field %d", field)
+	}
+}
+
+// uint64Chunk returns the chunk for a uint64.
+func uint64Chunk(val uint64) [32]byte {
+	var chunk [32]byte
+	putUint64(chunk[:], val)
+	return chunk
+}
+
+// bytesChunk returns the chunk for a fixed-length byte array.
+func bytesChunk(name string, data []byte, length int) ([32]byte, error) {
+	if len(data) != length {
+		return [32]byte{}, fmt.Errorf("%s length %d is not %d", name, len(data), length)
+	}
+	var chunk [32]byte
+	copy(chunk[:], data)
+	return chunk, nil
+}
+
+// containerRoot returns the hash tree root of a container.
+func containerRoot(name string, container ssz.HashRoot) ([32]byte, error) {
+	root, err := container.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "failed to calculate %s root", name)
+	}
+	return root, nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	"math/bits"
+)
+
+// GeneralizedIndexDepth returns the depth of a generalized index in its tree.
+func GeneralizedIndexDepth(index uint64) uint64 {
+	if index == 0 {
+		return 0
+	}
+	return uint64(bits.Len64(index) - 1)
+}
+
+// ConcatGeneralizedIndices returns the generalized index of a path made up of
+// the generalized indices of each step through nested trees.
+// This is concat_generalized_indices in the Ethereum 2 specification.
+func ConcatGeneralizedIndices(indices ...uint64) uint64 {
+	res := uint64(1)
+	for _, index := range indices {
+		depth := GeneralizedIndexDepth(index)
+		res = res<<depth | (index ^ 1<<depth)
+	}
+	return res
+}
+
+// VerifyProof verifies that the leaf is at the given generalized index in the tree with the given root.
+// This is is_valid_merkle_branch in the Ethereum 2 specification, using a generalized index.
+func VerifyProof(root [32]byte, leaf [32]byte, branch [][32]byte, index uint64) bool {
+	if uint64(len(branch)) != GeneralizedIndexDepth(index) {
+		return false
+	}
+
+	value := leaf
+	for i := range branch {
+		if (index>>uint(i))&1 == 1 {
+			value = hash(branch[i], value)
+		} else {
+			value = hash(value, branch[i])
+		}
+	}
+	return value == root
+}
@@ -0,0 +1,57 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	"crypto/sha256"
+)
+
+// maxDepth is the maximum depth of tree supported.
+const maxDepth = 64
+
+// zeroHashes contains the roots of trees of zero chunks of each depth.
+var zeroHashes [maxDepth + 1][32]byte
+
+func init() {
+	for i := 1; i <= maxDepth; i++ {
+		zeroHashes[i] = hash(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+// hash returns the hash of the concatenation of two chunks.
+func hash(left [32]byte, right [32]byte) [32]byte {
+	var data [64]byte
+	copy(data[:32], left[:])
+	copy(data[32:], right[:])
+	return sha256.Sum256(data[:])
+}
+
+// mixInLength mixes the length of a list in to the root of its data.
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	return hash(root, lengthChunk(length))
+}
+
+// lengthChunk returns the chunk holding the length of a list.
+func lengthChunk(length uint64) [32]byte {
+	var chunk [32]byte
+	putUint64(chunk[:], length)
+	return chunk
+}
+
+// putUint64 writes a little-endian uint64 to the start of the supplied slice.
+func putUint64(b []byte, val uint64) {
+	for i := 0; i < 8; i++ {
+		b[i] = byte(val >> (8 * i))
+	}
+}
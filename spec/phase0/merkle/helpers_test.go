@@ -0,0 +1,115 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+)
+
+// testState creates a fully-populated state with the given number of validators.
+func testState(t testing.TB, validators int) *spec.BeaconState {
+	state := &spec.BeaconState{
+		GenesisTime:           1606824023,
+		GenesisValidatorsRoot: filledBytes(32, 0x01),
+		Slot:                  12345,
+		Fork: &spec.Fork{
+			PreviousVersion: spec.Version{0x00, 0x00, 0x00, 0x00},
+			CurrentVersion:  spec.Version{0x01, 0x00, 0x00, 0x00},
+			Epoch:           100,
+		},
+		LatestBlockHeader: &spec.BeaconBlockHeader{
+			Slot:          12344,
+			ProposerIndex: 3,
+			ParentRoot:    spec.Root{0x02},
+			StateRoot:     spec.Root{0x03},
+			BodyRoot:      spec.Root{0x04},
+		},
+		BlockRoots:      filledRoots(8192, 0x05),
+		StateRoots:      filledRoots(8192, 0x06),
+		HistoricalRoots: filledRoots(3, 0x07),
+		ETH1Data: &spec.ETH1Data{
+			DepositRoot:  spec.Root{0x08},
+			DepositCount: 10,
+			BlockHash:    filledBytes(32, 0x09),
+		},
+		ETH1DataVotes: []*spec.ETH1Data{
+			{
+				DepositRoot:  spec.Root{0x0a},
+				DepositCount: 11,
+				BlockHash:    filledBytes(32, 0x0b),
+			},
+		},
+		ETH1DepositIndex: 10,
+		Validators:       make([]*spec.Validator, validators),
+		Balances:         make([]uint64, validators),
+		RANDAOMixes:      filledRoots(65536, 0x0c),
+		Slashings:        make([]uint64, 8192),
+		PreviousEpochAttestations: []*spec.PendingAttestation{
+			{
+				AggregationBits: bitfield.NewBitlist(8),
+				Data: &spec.AttestationData{
+					Slot:            12300,
+					BeaconBlockRoot: spec.Root{0x0d},
+					Source:          &spec.Checkpoint{Epoch: 380, Root: spec.Root{0x0e}},
+					Target:          &spec.Checkpoint{Epoch: 384, Root: spec.Root{0x0f}},
+				},
+				InclusionDelay: 1,
+				ProposerIndex:  4,
+			},
+		},
+		CurrentEpochAttestations:    []*spec.PendingAttestation{},
+		JustificationBits:           bitfield.Bitvector4{0x0b},
+		PreviousJustifiedCheckpoint: &spec.Checkpoint{Epoch: 383, Root: spec.Root{0x10}},
+		CurrentJustifiedCheckpoint:  &spec.Checkpoint{Epoch: 384, Root: spec.Root{0x11}},
+		FinalizedCheckpoint:         &spec.Checkpoint{Epoch: 382, Root: spec.Root{0x12}},
+	}
+	for i := 0; i < validators; i++ {
+		state.Validators[i] = &spec.Validator{
+			PublicKey:                  spec.BLSPubKey{byte(i), byte(i >> 8)},
+			WithdrawalCredentials:      filledBytes(32, byte(i)),
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            spec.Epoch(i),
+			ExitEpoch:                  0xffffffffffffffff,
+			WithdrawableEpoch:          0xffffffffffffffff,
+		}
+		state.Balances[i] = 32000000000 + uint64(i)
+	}
+	for i := range state.Slashings {
+		state.Slashings[i] = uint64(i)
+	}
+
+	return state
+}
+
+func filledBytes(length int, val byte) []byte {
+	res := make([]byte, length)
+	for i := range res {
+		res[i] = val
+	}
+	return res
+}
+
+func filledRoots(count int, val byte) [][]byte {
+	res := make([][]byte, count)
+	for i := range res {
+		res[i] = filledBytes(32, val)
+		res[i][0] = byte(i)
+		res[i][1] = byte(i >> 8)
+	}
+	return res
+}
@@ -0,0 +1,181 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// Tree is a binary Merkle tree over a list of chunks.
+// The tree is of a fixed depth, with chunks beyond the end of the list
+// treated as zero, as per SSZ merkleization.
+//
+// Chunks can be updated and appended; only the branches above changed
+// chunks are rehashed when the root or a proof is next requested.
+// A tree is not safe for concurrent use.
+type Tree struct {
+	depth uint64
+	// layers[0] contains the chunks; layers[depth] contains the root.
+	// Each layer only holds the non-zero part of the tree.
+	layers [][][32]byte
+	// dirty contains the indices of chunks changed since the last rehash.
+	dirty map[uint64]struct{}
+}
+
+// NewTree creates a tree of the given depth from the supplied chunks.
+// The tree takes ownership of the chunks slice.
+func NewTree(chunks [][32]byte, depth uint64) (*Tree, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("depth %d exceeds maximum of %d", depth, maxDepth)
+	}
+	if !fits(uint64(len(chunks)), depth) {
+		return nil, fmt.Errorf("%d chunks do not fit in tree of depth %d", len(chunks), depth)
+	}
+
+	t := &Tree{
+		depth:  depth,
+		layers: make([][][32]byte, depth+1),
+		dirty:  make(map[uint64]struct{}),
+	}
+	t.layers[0] = chunks
+	for i := uint64(1); i <= depth; i++ {
+		t.layers[i] = make([][32]byte, (len(t.layers[i-1])+1)/2)
+		for j := range t.layers[i] {
+			t.hashNode(i, uint64(j))
+		}
+	}
+
+	return t, nil
+}
+
+// fits returns true if the number of chunks fits in a tree of the given depth.
+func fits(chunks uint64, depth uint64) bool {
+	return depth >= 64 || chunks <= 1<<depth
+}
+
+// hashNode calculates an entry in a layer from its children in the layer below.
+func (t *Tree) hashNode(layer uint64, index uint64) {
+	below := t.layers[layer-1]
+	if 2*index+1 < uint64(len(below)) {
+		t.layers[layer][index] = hash(below[2*index], below[2*index+1])
+	} else {
+		t.layers[layer][index] = hash(below[2*index], zeroHashes[layer-1])
+	}
+}
+
+// Depth returns the depth of the tree.
+func (t *Tree) Depth() uint64 {
+	return t.depth
+}
+
+// Len returns the number of chunks in the tree.
+func (t *Tree) Len() int {
+	return len(t.layers[0])
+}
+
+// Chunk returns the chunk at the given index.
+func (t *Tree) Chunk(index uint64) ([32]byte, error) {
+	if index >= uint64(len(t.layers[0])) {
+		return [32]byte{}, errors.New("index out of range")
+	}
+	return t.layers[0][index], nil
+}
+
+// SetChunk sets the chunk at the given index.
+func (t *Tree) SetChunk(index uint64, chunk [32]byte) error {
+	if index >= uint64(len(t.layers[0])) {
+		return errors.New("index out of range")
+	}
+	if t.layers[0][index] == chunk {
+		return nil
+	}
+	t.layers[0][index] = chunk
+	t.dirty[index] = struct{}{}
+	return nil
+}
+
+// Append adds a chunk to the end of the tree.
+func (t *Tree) Append(chunk [32]byte) error {
+	if !fits(uint64(len(t.layers[0]))+1, t.depth) {
+		return errors.New("tree is full")
+	}
+	t.layers[0] = append(t.layers[0], chunk)
+	t.dirty[uint64(len(t.layers[0])-1)] = struct{}{}
+	return nil
+}
+
+// rehash rehashes the branches above dirty chunks.
+func (t *Tree) rehash() {
+	if len(t.dirty) == 0 {
+		return
+	}
+
+	indices := make([]uint64, 0, len(t.dirty))
+	for index := range t.dirty {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+
+	for i := uint64(1); i <= t.depth; i++ {
+		// Grow the layer if chunks have been appended.
+		for uint64(len(t.layers[i])) < uint64(len(t.layers[i-1])+1)/2 {
+			t.layers[i] = append(t.layers[i], [32]byte{})
+		}
+		// Indices are sorted, so parents are deduplicated by comparing with the previous entry.
+		parents := indices[:0]
+		for _, index := range indices {
+			if len(parents) == 0 || parents[len(parents)-1] != index/2 {
+				parents = append(parents, index/2)
+			}
+		}
+		for _, index := range parents {
+			t.hashNode(i, index)
+		}
+		indices = parents
+	}
+
+	t.dirty = make(map[uint64]struct{})
+}
+
+// Root returns the root of the tree.
+func (t *Tree) Root() [32]byte {
+	t.rehash()
+	if len(t.layers[t.depth]) == 0 {
+		return zeroHashes[t.depth]
+	}
+	return t.layers[t.depth][0]
+}
+
+// Proof returns the branch for the chunk at the given index, ordered from the leaf upwards.
+func (t *Tree) Proof(index uint64) ([][32]byte, error) {
+	if index >= uint64(len(t.layers[0])) {
+		return nil, errors.New("index out of range")
+	}
+	t.rehash()
+
+	branch := make([][32]byte, t.depth)
+	for i := uint64(0); i < t.depth; i++ {
+		sibling := (index >> i) ^ 1
+		if sibling < uint64(len(t.layers[i])) {
+			branch[i] = t.layers[i][sibling]
+		} else {
+			branch[i] = zeroHashes[i]
+		}
+	}
+
+	return branch, nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merkle_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+func hash(left [32]byte, right [32]byte) [32]byte {
+	return sha256.Sum256(append(left[:], right[:]...))
+}
+
+func TestTree(t *testing.T) {
+	chunks := [][32]byte{{0x01}, {0x02}, {0x03}}
+	tree, err := merkle.NewTree(chunks, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), tree.Depth())
+	require.Equal(t, 3, tree.Len())
+	require.Equal(t, hash(hash(chunks[0], chunks[1]), hash(chunks[2], [32]byte{})), tree.Root())
+
+	proof, err := tree.Proof(2)
+	require.NoError(t, err)
+	require.Equal(t, [][32]byte{{}, hash(chunks[0], chunks[1])}, proof)
+	require.True(t, merkle.VerifyProof(tree.Root(), chunks[2], proof, 4+2))
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[1], proof, 4+2))
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], proof, 4+3))
+	require.False(t, merkle.VerifyProof(tree.Root(), chunks[2], proof[:1], 4+2))
+
+	_, err = tree.Proof(3)
+	require.EqualError(t, err, "index out of range")
+}
+
+func TestTreeEmpty(t *testing.T) {
+	tree, err := merkle.NewTree(nil, 3)
+	require.NoError(t, err)
+	zero1 := hash([32]byte{}, [32]byte{})
+	zero2 := hash(zero1, zero1)
+	require.Equal(t, hash(zero2, zero2), tree.Root())
+}
+
+func TestTreeTooSmall(t *testing.T) {
+	_, err := merkle.NewTree(make([][32]byte, 5), 2)
+	require.EqualError(t, err, "5 chunks do not fit in tree of depth 2")
+
+	_, err = merkle.NewTree(nil, 65)
+	require.EqualError(t, err, "depth 65 exceeds maximum of 64")
+}
+
+func TestGeneralizedIndices(t *testing.T) {
+	require.Equal(t, uint64(0), merkle.GeneralizedIndexDepth(1))
+	require.Equal(t, uint64(5), merkle.GeneralizedIndexDepth(52))
+	require.Equal(t, uint64(52), merkle.BeaconStateFinalizedCheckpoint.GeneralizedIndex())
+	// This is FINALIZED_ROOT_INDEX in the light client specification.
+	require.Equal(t, uint64(105), merkle.ConcatGeneralizedIndices(merkle.BeaconStateFinalizedCheckpoint.GeneralizedIndex(), 3))
+	require.Equal(t, uint64(86)<<40+5, merkle.ValidatorGeneralizedIndex(5))
+	require.Equal(t, uint64(88)<<38+1, merkle.BalanceGeneralizedIndex(5))
+}
@@ -0,0 +1,20 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spectests runs the official consensus spec test vectors against the phase0 types and
+// state transition.
+//
+// The tests read the consensus-spec-tests directory layout from the path given in the
+// ETH2_SPEC_TESTS_DIR environment variable, and are skipped if it is not set.  Only the
+// mainnet preset is run, as the phase0 types use mainnet-sized vectors.
+package spectests
@@ -0,0 +1,85 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// epochProcessingSteps maps the epoch processing handlers to the state transition functions.
+// Both the combined final_updates handler and its individual steps, as used by later releases
+// of the tests, are supported.
+var epochProcessingSteps = map[string]func(s *transition.Service, state *spec.BeaconState) error{
+	"justification_and_finalization": (*transition.Service).ProcessJustificationAndFinalization,
+	"rewards_and_penalties":          (*transition.Service).ProcessRewardsAndPenalties,
+	"registry_updates": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessRegistryUpdates(state)
+		return nil
+	},
+	"slashings": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessSlashings(state)
+		return nil
+	},
+	"final_updates": (*transition.Service).ProcessFinalUpdates,
+	"eth1_data_reset": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessETH1DataReset(state)
+		return nil
+	},
+	"effective_balance_updates": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessEffectiveBalanceUpdates(state)
+		return nil
+	},
+	"slashings_reset": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessSlashingsReset(state)
+		return nil
+	},
+	"randao_mixes_reset":      (*transition.Service).ProcessRANDAOMixesReset,
+	"historical_roots_update": (*transition.Service).ProcessHistoricalRootsUpdate,
+	"participation_record_updates": func(s *transition.Service, state *spec.BeaconState) error {
+		s.ProcessParticipationRecordUpdates(state)
+		return nil
+	},
+}
+
+func TestEpochProcessing(t *testing.T) {
+	baseDir := filepath.Join(phase0Dir(t), "epoch_processing")
+	s := newService(t, false)
+
+	for _, handler := range subDirs(t, baseDir) {
+		t.Run(handler, func(t *testing.T) {
+			step, exists := epochProcessingSteps[handler]
+			if !exists {
+				t.Skipf("epoch processing step %s not supported", handler)
+			}
+			testCases(t, filepath.Join(baseDir, handler), func(t *testing.T, caseDir string) {
+				state := readState(t, caseDir, "pre")
+				require.NotNil(t, state)
+				post := readState(t, caseDir, "post")
+
+				err := step(s, state)
+				if post == nil {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				requireState(t, post, state)
+			})
+		})
+	}
+}
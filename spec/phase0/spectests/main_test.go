@@ -0,0 +1,191 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/transition"
+	"github.com/goccy/go-yaml"
+	"github.com/golang/snappy"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	os.Exit(m.Run())
+}
+
+// phase0Dir returns the directory containing the mainnet phase0 tests, skipping the test if it is not available.
+func phase0Dir(t *testing.T) string {
+	base := os.Getenv("ETH2_SPEC_TESTS_DIR")
+	if base == "" {
+		t.Skip("ETH2_SPEC_TESTS_DIR not supplied, not running spec tests")
+	}
+	dir := filepath.Join(base, "tests", "mainnet", "phase0")
+	if _, err := os.Stat(dir); err != nil {
+		t.Skipf("%s not available", dir)
+	}
+	return dir
+}
+
+// subDirs returns the names of the directories within the given directory.
+func subDirs(t *testing.T, dir string) []string {
+	entries, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	res := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			res = append(res, entry.Name())
+		}
+	}
+	return res
+}
+
+// testCases calls the supplied function for each case of each suite in the given handler directory.
+func testCases(t *testing.T, handlerDir string, run func(t *testing.T, caseDir string)) {
+	for _, suite := range subDirs(t, handlerDir) {
+		for _, testCase := range subDirs(t, filepath.Join(handlerDir, suite)) {
+			caseDir := filepath.Join(handlerDir, suite, testCase)
+			t.Run(suite+"/"+testCase, func(t *testing.T) {
+				run(t, caseDir)
+			})
+		}
+	}
+}
+
+// readSSZ reads an SSZ-encoded file from the case directory, given its name without extension.
+// Snappy-compressed files are used if present, otherwise uncompressed files as found in older
+// releases of the tests.  The boolean return is false if neither file exists.
+func readSSZ(t *testing.T, caseDir string, name string) ([]byte, bool) {
+	compressed, err := ioutil.ReadFile(filepath.Join(caseDir, name+".ssz_snappy"))
+	if err == nil {
+		data, err := snappy.Decode(nil, compressed)
+		require.NoError(t, err)
+		return data, true
+	}
+	require.True(t, os.IsNotExist(err), err)
+
+	data, err := ioutil.ReadFile(filepath.Join(caseDir, name+".ssz"))
+	if os.IsNotExist(err) {
+		return nil, false
+	}
+	require.NoError(t, err)
+	return data, true
+}
+
+// readState reads a state from the case directory, given its name without extension.
+// The state is nil if the file does not exist.
+func readState(t *testing.T, caseDir string, name string) *spec.BeaconState {
+	data, exists := readSSZ(t, caseDir, name)
+	if !exists {
+		return nil
+	}
+	state := &spec.BeaconState{}
+	require.NoError(t, state.UnmarshalSSZ(data))
+	return state
+}
+
+// caseMeta is the optional metadata for a test case.
+type caseMeta struct {
+	// BLSSetting is 0 or 1 if signatures should be verified, and 2 if they should be ignored.
+	BLSSetting int `yaml:"bls_setting"`
+}
+
+// readMeta reads the metadata for a test case, if present.
+func readMeta(t *testing.T, caseDir string) *caseMeta {
+	meta := &caseMeta{}
+	data, err := ioutil.ReadFile(filepath.Join(caseDir, "meta.yaml"))
+	if os.IsNotExist(err) {
+		return meta
+	}
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, meta))
+	return meta
+}
+
+// requireState checks that the state matches the expected post state.
+func requireState(t *testing.T, expected *spec.BeaconState, actual *spec.BeaconState) {
+	expectedRoot, err := expected.HashTreeRoot()
+	require.NoError(t, err)
+	actualRoot, err := actual.HashTreeRoot()
+	require.NoError(t, err)
+	if expectedRoot != actualRoot {
+		// Provide a field-level diff.
+		require.Equal(t, expected, actual)
+	}
+}
+
+// mainnetSpec is a spec provider with the mainnet preset values used by the state transition.
+type mainnetSpec map[string]interface{}
+
+func (m mainnetSpec) Spec(ctx context.Context) (map[string]interface{}, error) {
+	return m, nil
+}
+
+func newMainnetSpec() mainnetSpec {
+	return mainnetSpec{
+		"SLOTS_PER_EPOCH":                     uint64(32),
+		"SLOTS_PER_HISTORICAL_ROOT":           uint64(8192),
+		"EPOCHS_PER_HISTORICAL_VECTOR":        uint64(65536),
+		"EPOCHS_PER_SLASHINGS_VECTOR":         uint64(8192),
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":       uint64(64),
+		"MIN_ATTESTATION_INCLUSION_DELAY":     uint64(1),
+		"MIN_SEED_LOOKAHEAD":                  uint64(1),
+		"MAX_SEED_LOOKAHEAD":                  uint64(4),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": uint64(256),
+		"SHARD_COMMITTEE_PERIOD":              uint64(256),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":    uint64(4),
+		"MAX_EFFECTIVE_BALANCE":               uint64(32000000000),
+		"EFFECTIVE_BALANCE_INCREMENT":         uint64(1000000000),
+		"EJECTION_BALANCE":                    uint64(16000000000),
+		"HYSTERESIS_QUOTIENT":                 uint64(4),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":      uint64(1),
+		"HYSTERESIS_UPWARD_MULTIPLIER":        uint64(5),
+		"MIN_PER_EPOCH_CHURN_LIMIT":           uint64(4),
+		"CHURN_LIMIT_QUOTIENT":                uint64(65536),
+		"BASE_REWARD_FACTOR":                  uint64(64),
+		"WHISTLEBLOWER_REWARD_QUOTIENT":       uint64(512),
+		"PROPOSER_REWARD_QUOTIENT":            uint64(8),
+		"INACTIVITY_PENALTY_QUOTIENT":         uint64(67108864),
+		"MIN_SLASHING_PENALTY_QUOTIENT":       uint64(128),
+		"PROPORTIONAL_SLASHING_MULTIPLIER":    uint64(1),
+		"MAX_DEPOSITS":                        uint64(16),
+		"SHUFFLE_ROUND_COUNT":                 uint64(90),
+		"TARGET_COMMITTEE_SIZE":               uint64(128),
+		"MAX_COMMITTEES_PER_SLOT":             uint64(64),
+		"DOMAIN_BEACON_PROPOSER":              spec.DomainType{0x00, 0x00, 0x00, 0x00},
+		"DOMAIN_BEACON_ATTESTER":              spec.DomainType{0x01, 0x00, 0x00, 0x00},
+		"DOMAIN_RANDAO":                       spec.DomainType{0x02, 0x00, 0x00, 0x00},
+		"DOMAIN_DEPOSIT":                      spec.DomainType{0x03, 0x00, 0x00, 0x00},
+		"DOMAIN_VOLUNTARY_EXIT":               spec.DomainType{0x04, 0x00, 0x00, 0x00},
+		"GENESIS_FORK_VERSION":                spec.Version{0x00, 0x00, 0x00, 0x00},
+	}
+}
+
+// newService creates a state transition service for the mainnet preset.
+func newService(t *testing.T, verifySignatures bool) *transition.Service {
+	s, err := transition.New(context.Background(),
+		transition.WithSpecProvider(newMainnetSpec()),
+		transition.WithSignatureVerification(verifySignatures),
+	)
+	require.NoError(t, err)
+	return s
+}
@@ -0,0 +1,128 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// operation defines how to run the cases for an operation handler.
+type operation struct {
+	// file is the name of the file holding the operation, without extension.
+	file string
+	// process applies the SSZ-encoded operation to the state.
+	process func(s *transition.Service, state *spec.BeaconState, data []byte) error
+}
+
+var operations = map[string]operation{
+	"attestation": {
+		file: "attestation",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			attestation := &spec.Attestation{}
+			if err := attestation.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessAttestation(state, attestation)
+		},
+	},
+	"attester_slashing": {
+		file: "attester_slashing",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			attesterSlashing := &spec.AttesterSlashing{}
+			if err := attesterSlashing.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessAttesterSlashing(state, attesterSlashing)
+		},
+	},
+	"block_header": {
+		file: "block",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			block := &spec.BeaconBlock{}
+			if err := block.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessBlockHeader(state, block)
+		},
+	},
+	"deposit": {
+		file: "deposit",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			deposit := &spec.Deposit{}
+			if err := deposit.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessDeposit(state, deposit)
+		},
+	},
+	"proposer_slashing": {
+		file: "proposer_slashing",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			proposerSlashing := &spec.ProposerSlashing{}
+			if err := proposerSlashing.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessProposerSlashing(state, proposerSlashing)
+		},
+	},
+	"voluntary_exit": {
+		file: "voluntary_exit",
+		process: func(s *transition.Service, state *spec.BeaconState, data []byte) error {
+			voluntaryExit := &spec.SignedVoluntaryExit{}
+			if err := voluntaryExit.UnmarshalSSZ(data); err != nil {
+				return err
+			}
+			return s.ProcessVoluntaryExit(state, voluntaryExit)
+		},
+	},
+}
+
+func TestOperations(t *testing.T) {
+	baseDir := filepath.Join(phase0Dir(t), "operations")
+	services := map[bool]*transition.Service{
+		true:  newService(t, true),
+		false: newService(t, false),
+	}
+
+	for _, handler := range subDirs(t, baseDir) {
+		t.Run(handler, func(t *testing.T) {
+			op, exists := operations[handler]
+			if !exists {
+				t.Skipf("operation %s not supported", handler)
+			}
+			testCases(t, filepath.Join(baseDir, handler), func(t *testing.T, caseDir string) {
+				state := readState(t, caseDir, "pre")
+				require.NotNil(t, state)
+				post := readState(t, caseDir, "post")
+				data, exists := readSSZ(t, caseDir, op.file)
+				require.True(t, exists)
+
+				s := services[readMeta(t, caseDir).BLSSetting != 2]
+				err := op.process(s, state, data)
+				if post == nil {
+					// No post state means the operation is invalid.
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				requireState(t, post, state)
+			})
+		})
+	}
+}
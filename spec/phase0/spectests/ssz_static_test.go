@@ -0,0 +1,109 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spectests_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/require"
+)
+
+// sszObject is a type that can be encoded and decoded with SSZ and YAML.
+type sszObject interface {
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	HashTreeRoot() ([32]byte, error)
+	UnmarshalYAML(input []byte) error
+}
+
+// sszStaticTypes maps the type names used by the spec tests to the types.
+var sszStaticTypes = map[string]func() sszObject{
+	"AggregateAndProof":       func() sszObject { return &spec.AggregateAndProof{} },
+	"Attestation":             func() sszObject { return &spec.Attestation{} },
+	"AttestationData":         func() sszObject { return &spec.AttestationData{} },
+	"AttesterSlashing":        func() sszObject { return &spec.AttesterSlashing{} },
+	"BeaconBlock":             func() sszObject { return &spec.BeaconBlock{} },
+	"BeaconBlockBody":         func() sszObject { return &spec.BeaconBlockBody{} },
+	"BeaconBlockHeader":       func() sszObject { return &spec.BeaconBlockHeader{} },
+	"BeaconState":             func() sszObject { return &spec.BeaconState{} },
+	"Checkpoint":              func() sszObject { return &spec.Checkpoint{} },
+	"Deposit":                 func() sszObject { return &spec.Deposit{} },
+	"DepositData":             func() sszObject { return &spec.DepositData{} },
+	"DepositMessage":          func() sszObject { return &spec.DepositMessage{} },
+	"Eth1Data":                func() sszObject { return &spec.ETH1Data{} },
+	"Fork":                    func() sszObject { return &spec.Fork{} },
+	"ForkData":                func() sszObject { return &spec.ForkData{} },
+	"IndexedAttestation":      func() sszObject { return &spec.IndexedAttestation{} },
+	"PendingAttestation":      func() sszObject { return &spec.PendingAttestation{} },
+	"ProposerSlashing":        func() sszObject { return &spec.ProposerSlashing{} },
+	"SignedAggregateAndProof": func() sszObject { return &spec.SignedAggregateAndProof{} },
+	"SignedBeaconBlock":       func() sszObject { return &spec.SignedBeaconBlock{} },
+	"SignedBeaconBlockHeader": func() sszObject { return &spec.SignedBeaconBlockHeader{} },
+	"SignedVoluntaryExit":     func() sszObject { return &spec.SignedVoluntaryExit{} },
+	"SigningData":             func() sszObject { return &spec.SigningData{} },
+	"Validator":               func() sszObject { return &spec.Validator{} },
+	"VoluntaryExit":           func() sszObject { return &spec.VoluntaryExit{} },
+}
+
+// sszStaticRoots is the layout of roots.yaml.
+type sszStaticRoots struct {
+	Root string `yaml:"root"`
+}
+
+func TestSSZStatic(t *testing.T) {
+	baseDir := filepath.Join(phase0Dir(t), "ssz_static")
+
+	for _, typeName := range subDirs(t, baseDir) {
+		t.Run(typeName, func(t *testing.T) {
+			newObject, exists := sszStaticTypes[typeName]
+			if !exists {
+				t.Skipf("type %s not supported", typeName)
+			}
+			testCases(t, filepath.Join(baseDir, typeName), func(t *testing.T, caseDir string) {
+				serialized, exists := readSSZ(t, caseDir, "serialized")
+				require.True(t, exists)
+				rootsYAML, err := ioutil.ReadFile(filepath.Join(caseDir, "roots.yaml"))
+				require.NoError(t, err)
+				var roots sszStaticRoots
+				require.NoError(t, yaml.Unmarshal(rootsYAML, &roots))
+
+				// SSZ roundtrip.
+				object := newObject()
+				require.NoError(t, object.UnmarshalSSZ(serialized))
+				remarshalled, err := object.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, serialized, remarshalled)
+
+				// Hash tree root.
+				root, err := object.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, roots.Root, fmt.Sprintf("%#x", root))
+
+				// YAML decoding gives the same object.
+				valueYAML, err := ioutil.ReadFile(filepath.Join(caseDir, "value.yaml"))
+				require.NoError(t, err)
+				yamlObject := newObject()
+				require.NoError(t, yamlObject.UnmarshalYAML(valueYAML))
+				yamlSerialized, err := yamlObject.MarshalSSZ()
+				require.NoError(t, err)
+				require.Equal(t, serialized, yamlSerialized)
+			})
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/verify"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+)
+
+// currentEpoch returns the current epoch of the state.
+func (s *Service) currentEpoch(state *spec.BeaconState) spec.Epoch {
+	return s.helpers.CurrentEpoch(state)
+}
+
+// previousEpoch returns the previous epoch of the state.
+// This is get_previous_epoch in the Ethereum 2 specification.
+func (s *Service) previousEpoch(state *spec.BeaconState) spec.Epoch {
+	currentEpoch := s.currentEpoch(state)
+	if currentEpoch == genesisEpoch {
+		return genesisEpoch
+	}
+	return currentEpoch - 1
+}
+
+// blockRootAtSlot returns the block root at a recent slot.
+// This is get_block_root_at_slot in the Ethereum 2 specification.
+func (s *Service) blockRootAtSlot(state *spec.BeaconState, slot spec.Slot) (spec.Root, error) {
+	if uint64(slot) >= state.Slot || state.Slot > uint64(slot)+s.slotsPerHistoricalRoot {
+		return spec.Root{}, fmt.Errorf("slot %d out of range for state at slot %d", slot, state.Slot)
+	}
+	var root spec.Root
+	copy(root[:], state.BlockRoots[uint64(slot)%s.slotsPerHistoricalRoot])
+	return root, nil
+}
+
+// blockRoot returns the block root at the start of a recent epoch.
+// This is get_block_root in the Ethereum 2 specification.
+func (s *Service) blockRoot(state *spec.BeaconState, epoch spec.Epoch) (spec.Root, error) {
+	return s.blockRootAtSlot(state, s.helpers.EpochStartSlot(epoch))
+}
+
+// totalBalance returns the combined effective balance of the given validators, with a minimum of one increment.
+// This is get_total_balance in the Ethereum 2 specification.
+func (s *Service) totalBalance(state *spec.BeaconState, indices []spec.ValidatorIndex) uint64 {
+	total := uint64(0)
+	for _, index := range indices {
+		total += uint64(state.Validators[index].EffectiveBalance)
+	}
+	if total < s.effectiveBalanceIncrement {
+		return s.effectiveBalanceIncrement
+	}
+	return total
+}
+
+// totalActiveBalance returns the combined effective balance of the active validators.
+// This is get_total_active_balance in the Ethereum 2 specification.
+func (s *Service) totalActiveBalance(state *spec.BeaconState) uint64 {
+	return s.totalBalance(state, s.helpers.ActiveValidatorIndices(state, s.currentEpoch(state)))
+}
+
+// validatorChurnLimit returns the number of validators that can enter or exit in an epoch.
+// This is get_validator_churn_limit in the Ethereum 2 specification.
+func (s *Service) validatorChurnLimit(state *spec.BeaconState) uint64 {
+	limit := uint64(len(s.helpers.ActiveValidatorIndices(state, s.currentEpoch(state)))) / s.churnLimitQuotient
+	if limit < s.minPerEpochChurnLimit {
+		return s.minPerEpochChurnLimit
+	}
+	return limit
+}
+
+// computeActivationExitEpoch returns the epoch at which activations and exits initiated in the given epoch take effect.
+// This is compute_activation_exit_epoch in the Ethereum 2 specification.
+func (s *Service) computeActivationExitEpoch(epoch spec.Epoch) spec.Epoch {
+	return epoch + 1 + spec.Epoch(s.maxSeedLookahead)
+}
+
+// isSlashableValidator returns true if the validator can be slashed at the given epoch.
+// This is is_slashable_validator in the Ethereum 2 specification.
+func isSlashableValidator(validator *spec.Validator, epoch spec.Epoch) bool {
+	return !validator.Slashed && validator.ActivationEpoch <= epoch && epoch < validator.WithdrawableEpoch
+}
+
+// increaseBalance increases the balance of a validator.
+// This is increase_balance in the Ethereum 2 specification.
+func increaseBalance(state *spec.BeaconState, index spec.ValidatorIndex, delta uint64) {
+	state.Balances[index] += delta
+}
+
+// decreaseBalance decreases the balance of a validator, with a floor of 0.
+// This is decrease_balance in the Ethereum 2 specification.
+func decreaseBalance(state *spec.BeaconState, index spec.ValidatorIndex, delta uint64) {
+	if delta > state.Balances[index] {
+		state.Balances[index] = 0
+	} else {
+		state.Balances[index] -= delta
+	}
+}
+
+// initiateValidatorExit starts the exit process for a validator.
+// This is initiate_validator_exit in the Ethereum 2 specification.
+func (s *Service) initiateValidatorExit(state *spec.BeaconState, index spec.ValidatorIndex) {
+	validator := state.Validators[index]
+	if validator.ExitEpoch != farFutureEpoch {
+		return
+	}
+
+	exitQueueEpoch := s.computeActivationExitEpoch(s.currentEpoch(state))
+	for _, v := range state.Validators {
+		if v.ExitEpoch != farFutureEpoch && v.ExitEpoch > exitQueueEpoch {
+			exitQueueEpoch = v.ExitEpoch
+		}
+	}
+	exitQueueChurn := uint64(0)
+	for _, v := range state.Validators {
+		if v.ExitEpoch == exitQueueEpoch {
+			exitQueueChurn++
+		}
+	}
+	if exitQueueChurn >= s.validatorChurnLimit(state) {
+		exitQueueEpoch++
+	}
+
+	validator.ExitEpoch = exitQueueEpoch
+	validator.WithdrawableEpoch = validator.ExitEpoch + spec.Epoch(s.minValidatorWithdrawabilityDelay)
+}
+
+// slashValidator slashes a validator, rewarding the proposer of the current block.
+// This is slash_validator in the Ethereum 2 specification, without a separate whistleblower.
+func (s *Service) slashValidator(state *spec.BeaconState, index spec.ValidatorIndex) error {
+	epoch := s.currentEpoch(state)
+	s.initiateValidatorExit(state, index)
+	validator := state.Validators[index]
+	validator.Slashed = true
+	if withdrawableEpoch := epoch + spec.Epoch(s.epochsPerSlashingsVector); withdrawableEpoch > validator.WithdrawableEpoch {
+		validator.WithdrawableEpoch = withdrawableEpoch
+	}
+	state.Slashings[uint64(epoch)%s.epochsPerSlashingsVector] += uint64(validator.EffectiveBalance)
+	decreaseBalance(state, index, uint64(validator.EffectiveBalance)/s.minSlashingPenaltyQuotient)
+
+	proposerIndex, err := s.helpers.BeaconProposerIndex(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain proposer index")
+	}
+	whistleblowerReward := uint64(validator.EffectiveBalance) / s.whistleblowerRewardQuotient
+	proposerReward := whistleblowerReward / s.proposerRewardQuotient
+	increaseBalance(state, proposerIndex, proposerReward)
+	// The proposer is also the whistleblower.
+	increaseBalance(state, proposerIndex, whistleblowerReward-proposerReward)
+
+	return nil
+}
+
+// attestingIndices returns the indices of the validators that attested, in committee order.
+// This is get_attesting_indices in the Ethereum 2 specification.
+func (s *Service) attestingIndices(state *spec.BeaconState, data *spec.AttestationData, aggregationBits bitfield.Bitlist) ([]spec.ValidatorIndex, error) {
+	committee, err := s.helpers.BeaconCommittee(state, data.Slot, data.Index)
+	if err != nil {
+		return nil, err
+	}
+	if aggregationBits.Len() != uint64(len(committee)) {
+		return nil, fmt.Errorf("aggregation bits length %d does not match committee length %d", aggregationBits.Len(), len(committee))
+	}
+	res := make([]spec.ValidatorIndex, 0, len(committee))
+	for i := range committee {
+		if aggregationBits.BitAt(uint64(i)) {
+			res = append(res, committee[i])
+		}
+	}
+	return res, nil
+}
+
+// indexedAttestation converts an attestation to an indexed attestation.
+// This is get_indexed_attestation in the Ethereum 2 specification.
+func (s *Service) indexedAttestation(state *spec.BeaconState, attestation *spec.Attestation) (*spec.IndexedAttestation, error) {
+	indices, err := s.attestingIndices(state, attestation.Data, attestation.AggregationBits)
+	if err != nil {
+		return nil, err
+	}
+	attestingIndices := make([]uint64, len(indices))
+	for i := range indices {
+		attestingIndices[i] = uint64(indices[i])
+	}
+	sort.Slice(attestingIndices, func(i, j int) bool { return attestingIndices[i] < attestingIndices[j] })
+	return &spec.IndexedAttestation{
+		AttestingIndices: attestingIndices,
+		Data:             attestation.Data,
+		Signature:        attestation.Signature,
+	}, nil
+}
+
+// validateIndexedAttestation checks that an indexed attestation is well-formed and correctly signed.
+// This is is_valid_indexed_attestation in the Ethereum 2 specification.
+func (s *Service) validateIndexedAttestation(state *spec.BeaconState, indexedAttestation *spec.IndexedAttestation) error {
+	indices := indexedAttestation.AttestingIndices
+	if len(indices) == 0 {
+		return errors.New("no attesting indices")
+	}
+	for i := range indices {
+		if i > 0 && indices[i] <= indices[i-1] {
+			return errors.New("attesting indices not sorted and unique")
+		}
+		if indices[i] >= uint64(len(state.Validators)) {
+			return fmt.Errorf("attesting index %d unknown", indices[i])
+		}
+	}
+
+	if !s.verifySignatures {
+		return nil
+	}
+	pubKeys := make([]spec.BLSPubKey, len(indices))
+	for i := range indices {
+		pubKeys[i] = state.Validators[indices[i]].PublicKey
+	}
+	dataRoot, err := objectRoot(indexedAttestation.Data)
+	if err != nil {
+		return err
+	}
+	root, err := s.signingRoot(state, dataRoot, s.beaconAttesterDomain, indexedAttestation.Data.Target.Epoch)
+	if err != nil {
+		return err
+	}
+	verified, err := verify.VerifyAggregateSignature(pubKeys, root, indexedAttestation.Signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+	if !verified {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// domain returns the domain for the given domain type at the given epoch.
+// This is get_domain in the Ethereum 2 specification.
+func (s *Service) domain(state *spec.BeaconState, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
+	forkVersion := state.Fork.CurrentVersion
+	if epoch < state.Fork.Epoch {
+		forkVersion = state.Fork.PreviousVersion
+	}
+	var genesisValidatorsRoot spec.Root
+	copy(genesisValidatorsRoot[:], state.GenesisValidatorsRoot)
+	return verify.ComputeDomain(domainType, forkVersion, genesisValidatorsRoot)
+}
+
+// objectRoot returns the hash tree root of an object.
+func objectRoot(object ssz.HashRoot) (spec.Root, error) {
+	root, err := object.HashTreeRoot()
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to calculate object root")
+	}
+	return root, nil
+}
+
+// signingRoot returns the signing root of an object root for the given domain type at the given epoch.
+func (s *Service) signingRoot(state *spec.BeaconState, objectRoot spec.Root, domainType spec.DomainType, epoch spec.Epoch) (spec.Root, error) {
+	domain, err := s.domain(state, domainType, epoch)
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to calculate domain")
+	}
+	return verify.ComputeSigningRoot(objectRoot, domain)
+}
+
+// verifySignature verifies a signature by a single validator, if signature verification is enabled.
+func (s *Service) verifySignature(state *spec.BeaconState, index spec.ValidatorIndex, object ssz.HashRoot, domainType spec.DomainType, epoch spec.Epoch, signature spec.BLSSignature) error {
+	if !s.verifySignatures {
+		return nil
+	}
+	objectRoot, err := objectRoot(object)
+	if err != nil {
+		return err
+	}
+	return s.verifyRootSignature(state, index, objectRoot, domainType, epoch, signature)
+}
+
+// verifyRootSignature verifies a signature of an object root by a single validator.
+func (s *Service) verifyRootSignature(state *spec.BeaconState, index spec.ValidatorIndex, objectRoot spec.Root, domainType spec.DomainType, epoch spec.Epoch, signature spec.BLSSignature) error {
+	root, err := s.signingRoot(state, objectRoot, domainType, epoch)
+	if err != nil {
+		return err
+	}
+	verified, err := verify.VerifySignature(state.Validators[index].PublicKey, root, signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+	if !verified {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// checkpointsEqual returns true if the two checkpoints are the same.
+func checkpointsEqual(a *spec.Checkpoint, b *spec.Checkpoint) bool {
+	return a.Epoch == b.Epoch && bytes.Equal(a.Root[:], b.Root[:])
+}
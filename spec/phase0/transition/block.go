@@ -0,0 +1,189 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ProcessBlock applies a block to a state that has been advanced to the block's slot.
+// This is process_block in the Ethereum 2 specification.
+// The state is updated in place, so if an error is returned the state may have been partially
+// updated and should be discarded.
+func (s *Service) ProcessBlock(state *spec.BeaconState, block *spec.BeaconBlock) error {
+	if block == nil || block.Body == nil {
+		return errors.New("no block supplied")
+	}
+	if block.Body.ETH1Data == nil {
+		return errors.New("no eth1 data supplied")
+	}
+	if err := s.ProcessBlockHeader(state, block); err != nil {
+		return errors.Wrap(err, "failed to process block header")
+	}
+	if err := s.ProcessRANDAO(state, block.Body); err != nil {
+		return errors.Wrap(err, "failed to process RANDAO")
+	}
+	s.ProcessETH1Data(state, block.Body)
+	if err := s.ProcessOperations(state, block.Body); err != nil {
+		return errors.Wrap(err, "failed to process operations")
+	}
+	return nil
+}
+
+// ProcessBlockHeader validates a block against the state and updates the latest block header.
+// This is process_block_header in the Ethereum 2 specification.
+func (s *Service) ProcessBlockHeader(state *spec.BeaconState, block *spec.BeaconBlock) error {
+	if uint64(block.Slot) != state.Slot {
+		return fmt.Errorf("block slot %d does not match state slot %d", block.Slot, state.Slot)
+	}
+	if block.Slot <= state.LatestBlockHeader.Slot {
+		return fmt.Errorf("block slot %d not after latest block header slot %d", block.Slot, state.LatestBlockHeader.Slot)
+	}
+	proposerIndex, err := s.helpers.BeaconProposerIndex(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain proposer index")
+	}
+	if block.ProposerIndex != proposerIndex {
+		return fmt.Errorf("block proposer index %d does not match expected proposer index %d", block.ProposerIndex, proposerIndex)
+	}
+	parentRoot, err := state.LatestBlockHeader.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate latest block header root")
+	}
+	if block.ParentRoot != parentRoot {
+		return fmt.Errorf("block parent root %#x does not match latest block header root %#x", block.ParentRoot, parentRoot)
+	}
+	bodyRoot, err := block.Body.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate block body root")
+	}
+
+	state.LatestBlockHeader = &spec.BeaconBlockHeader{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentRoot,
+		// State root is filled in when the next slot is processed.
+		StateRoot: spec.Root{},
+		BodyRoot:  bodyRoot,
+	}
+
+	if state.Validators[proposerIndex].Slashed {
+		return fmt.Errorf("proposer %d is slashed", proposerIndex)
+	}
+
+	return nil
+}
+
+// ProcessRANDAO verifies the RANDAO reveal in the block body and mixes it in to the state.
+// This is process_randao in the Ethereum 2 specification.
+func (s *Service) ProcessRANDAO(state *spec.BeaconState, body *spec.BeaconBlockBody) error {
+	epoch := s.currentEpoch(state)
+
+	if s.verifySignatures {
+		proposerIndex, err := s.helpers.BeaconProposerIndex(state)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain proposer index")
+		}
+		var epochRoot spec.Root
+		binary.LittleEndian.PutUint64(epochRoot[:], uint64(epoch))
+		if err := s.verifyRootSignature(state, proposerIndex, epochRoot, s.randaoDomain, epoch, body.RANDAOReveal); err != nil {
+			return errors.Wrap(err, "invalid RANDAO reveal")
+		}
+	}
+
+	mix, err := s.helpers.RANDAOMix(state, epoch)
+	if err != nil {
+		return err
+	}
+	revealHash := sha256.Sum256(body.RANDAOReveal[:])
+	newMix := make([]byte, 32)
+	for i := range newMix {
+		newMix[i] = mix[i] ^ revealHash[i]
+	}
+	state.RANDAOMixes[uint64(epoch)%s.epochsPerHistoricalVector] = newMix
+
+	return nil
+}
+
+// ProcessETH1Data records the block's vote for Ethereum 1 data, updating the state's data if it has a majority.
+// This is process_eth1_data in the Ethereum 2 specification.
+func (s *Service) ProcessETH1Data(state *spec.BeaconState, body *spec.BeaconBlockBody) {
+	state.ETH1DataVotes = append(state.ETH1DataVotes, body.ETH1Data)
+
+	votes := uint64(0)
+	for _, vote := range state.ETH1DataVotes {
+		if eth1DataEqual(vote, body.ETH1Data) {
+			votes++
+		}
+	}
+	if votes*2 > s.epochsPerETH1VotingPeriod*s.slotsPerEpoch {
+		state.ETH1Data = body.ETH1Data
+	}
+}
+
+// eth1DataEqual returns true if the two sets of Ethereum 1 data are the same.
+func eth1DataEqual(a *spec.ETH1Data, b *spec.ETH1Data) bool {
+	return a.DepositRoot == b.DepositRoot &&
+		a.DepositCount == b.DepositCount &&
+		bytes.Equal(a.BlockHash, b.BlockHash)
+}
+
+// ProcessOperations applies the operations in the block body to the state.
+// This is process_operations in the Ethereum 2 specification.
+func (s *Service) ProcessOperations(state *spec.BeaconState, body *spec.BeaconBlockBody) error {
+	if state.ETH1DepositIndex > state.ETH1Data.DepositCount {
+		return fmt.Errorf("eth1 deposit index %d exceeds deposit count %d", state.ETH1DepositIndex, state.ETH1Data.DepositCount)
+	}
+	expectedDeposits := state.ETH1Data.DepositCount - state.ETH1DepositIndex
+	if expectedDeposits > s.maxDeposits {
+		expectedDeposits = s.maxDeposits
+	}
+	if uint64(len(body.Deposits)) != expectedDeposits {
+		return fmt.Errorf("block contains %d deposits; expected %d", len(body.Deposits), expectedDeposits)
+	}
+
+	for i, proposerSlashing := range body.ProposerSlashings {
+		if err := s.ProcessProposerSlashing(state, proposerSlashing); err != nil {
+			return errors.Wrapf(err, "invalid proposer slashing %d", i)
+		}
+	}
+	for i, attesterSlashing := range body.AttesterSlashings {
+		if err := s.ProcessAttesterSlashing(state, attesterSlashing); err != nil {
+			return errors.Wrapf(err, "invalid attester slashing %d", i)
+		}
+	}
+	for i, attestation := range body.Attestations {
+		if err := s.ProcessAttestation(state, attestation); err != nil {
+			return errors.Wrapf(err, "invalid attestation %d", i)
+		}
+	}
+	for i, deposit := range body.Deposits {
+		if err := s.ProcessDeposit(state, deposit); err != nil {
+			return errors.Wrapf(err, "invalid deposit %d", i)
+		}
+	}
+	for i, voluntaryExit := range body.VoluntaryExits {
+		if err := s.ProcessVoluntaryExit(state, voluntaryExit); err != nil {
+			return errors.Wrapf(err, "invalid voluntary exit %d", i)
+		}
+	}
+
+	return nil
+}
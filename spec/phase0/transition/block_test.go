@@ -0,0 +1,135 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessBlock(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	genesis := chain.genesisState()
+	block := chain.block(genesis, 1).Message
+
+	tests := []struct {
+		name  string
+		block func() *spec.BeaconBlock
+		err   string
+	}{
+		{
+			name: "Nil",
+			block: func() *spec.BeaconBlock {
+				return nil
+			},
+			err: "no block supplied",
+		},
+		{
+			name: "ETH1DataMissing",
+			block: func() *spec.BeaconBlock {
+				body := *block.Body
+				body.ETH1Data = nil
+				return &spec.BeaconBlock{Slot: block.Slot, Body: &body}
+			},
+			err: "no eth1 data supplied",
+		},
+		{
+			name: "SlotMismatch",
+			block: func() *spec.BeaconBlock {
+				res := *block
+				res.Slot = 2
+				return &res
+			},
+			err: "failed to process block header: block slot 2 does not match state slot 1",
+		},
+		{
+			name: "ParentRootMismatch",
+			block: func() *spec.BeaconBlock {
+				res := *block
+				res.ParentRoot = spec.Root{0x01}
+				return &res
+			},
+			err: "does not match latest block header root",
+		},
+		{
+			name: "UnexpectedDeposit",
+			block: func() *spec.BeaconBlock {
+				body := *block.Body
+				deposit := &spec.Deposit{
+					Proof: make([][]byte, 33),
+					Data:  depositData(t, 5000, 32000000000),
+				}
+				for i := range deposit.Proof {
+					deposit.Proof[i] = make([]byte, 32)
+				}
+				body.Deposits = []*spec.Deposit{deposit}
+				res := *block
+				res.Body = &body
+				return &res
+			},
+			err: "failed to process operations: block contains 1 deposits; expected 0",
+		},
+		{
+			name: "Good",
+			block: func() *spec.BeaconBlock {
+				return block
+			},
+		},
+	}
+
+	s := newService(t, false)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, genesis)
+			require.NoError(t, s.ProcessSlots(state, 1))
+			err := s.ProcessBlock(state, test.block())
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, block.Slot, state.LatestBlockHeader.Slot)
+				require.Equal(t, spec.Root{}, state.LatestBlockHeader.StateRoot)
+				stateRoot, err := state.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, block.StateRoot, spec.Root(stateRoot))
+			}
+		})
+	}
+}
+
+func TestProcessETH1Data(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	vote := &spec.ETH1Data{
+		DepositRoot:  spec.Root{0x01},
+		DepositCount: 100,
+		BlockHash:    filledBytes(32, 0x04),
+	}
+	body := emptyBody()
+	body.ETH1Data = vote
+
+	// A majority of the 32 slots in the voting period is required.
+	for i := 0; i < 16; i++ {
+		s.ProcessETH1Data(state, body)
+		require.NotEqual(t, vote, state.ETH1Data)
+	}
+	s.ProcessETH1Data(state, body)
+	require.Equal(t, vote, state.ETH1Data)
+	require.Len(t, state.ETH1DataVotes, 17)
+}
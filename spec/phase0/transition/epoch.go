@@ -0,0 +1,518 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"fmt"
+	"sort"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/pkg/errors"
+)
+
+// ProcessEpoch carries out the end-of-epoch processing of the state.
+// This is process_epoch in the Ethereum 2 specification.
+// The state is updated in place, so if an error is returned the state may have been partially
+// updated and should be discarded.
+func (s *Service) ProcessEpoch(state *spec.BeaconState) error {
+	if err := s.ProcessJustificationAndFinalization(state); err != nil {
+		return errors.Wrap(err, "failed to process justification and finalization")
+	}
+	if err := s.ProcessRewardsAndPenalties(state); err != nil {
+		return errors.Wrap(err, "failed to process rewards and penalties")
+	}
+	s.ProcessRegistryUpdates(state)
+	s.ProcessSlashings(state)
+	if err := s.ProcessFinalUpdates(state); err != nil {
+		return errors.Wrap(err, "failed to process final updates")
+	}
+
+	return nil
+}
+
+// epochAttestations holds the pending attestations for an epoch alongside their attesting indices.
+type epochAttestations struct {
+	attestations []*spec.PendingAttestation
+	indices      [][]spec.ValidatorIndex
+}
+
+// matchingSourceAttestations returns the pending attestations for the given epoch.
+// This is get_matching_source_attestations in the Ethereum 2 specification.
+func (s *Service) matchingSourceAttestations(state *spec.BeaconState, epoch spec.Epoch) (*epochAttestations, error) {
+	var attestations []*spec.PendingAttestation
+	switch epoch {
+	case s.currentEpoch(state):
+		attestations = state.CurrentEpochAttestations
+	case s.previousEpoch(state):
+		attestations = state.PreviousEpochAttestations
+	default:
+		return nil, fmt.Errorf("epoch %d is neither previous nor current epoch", epoch)
+	}
+
+	res := &epochAttestations{
+		attestations: attestations,
+		indices:      make([][]spec.ValidatorIndex, len(attestations)),
+	}
+	for i, attestation := range attestations {
+		indices, err := s.attestingIndices(state, attestation.Data, attestation.AggregationBits)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to obtain attesting indices for pending attestation %d", i)
+		}
+		res.indices[i] = indices
+	}
+
+	return res, nil
+}
+
+// filter returns the subset of attestations that match the supplied function.
+func (e *epochAttestations) filter(match func(*spec.PendingAttestation) bool) *epochAttestations {
+	res := &epochAttestations{}
+	for i, attestation := range e.attestations {
+		if match(attestation) {
+			res.attestations = append(res.attestations, attestation)
+			res.indices = append(res.indices, e.indices[i])
+		}
+	}
+	return res
+}
+
+// matchingTargetAttestations returns the subset of source attestations that voted for the epoch's block root.
+// This is get_matching_target_attestations in the Ethereum 2 specification.
+func (s *Service) matchingTargetAttestations(state *spec.BeaconState, source *epochAttestations, epoch spec.Epoch) (*epochAttestations, error) {
+	root, err := s.blockRoot(state, epoch)
+	if err != nil {
+		return nil, err
+	}
+	return source.filter(func(attestation *spec.PendingAttestation) bool {
+		return attestation.Data.Target.Root == root
+	}), nil
+}
+
+// matchingHeadAttestations returns the subset of target attestations that voted for the correct head.
+// This is get_matching_head_attestations in the Ethereum 2 specification.
+func (s *Service) matchingHeadAttestations(state *spec.BeaconState, target *epochAttestations) (*epochAttestations, error) {
+	var err error
+	res := target.filter(func(attestation *spec.PendingAttestation) bool {
+		if err != nil {
+			return false
+		}
+		var root spec.Root
+		root, err = s.blockRootAtSlot(state, attestation.Data.Slot)
+		return attestation.Data.BeaconBlockRoot == root
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// unslashedAttestingIndices returns the sorted indices of unslashed validators that attested.
+// This is get_unslashed_attesting_indices in the Ethereum 2 specification.
+func unslashedAttestingIndices(state *spec.BeaconState, attestations *epochAttestations) []spec.ValidatorIndex {
+	seen := make(map[spec.ValidatorIndex]bool)
+	res := make([]spec.ValidatorIndex, 0)
+	for _, indices := range attestations.indices {
+		for _, index := range indices {
+			if seen[index] || state.Validators[index].Slashed {
+				continue
+			}
+			seen[index] = true
+			res = append(res, index)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res
+}
+
+// ProcessJustificationAndFinalization updates the justified and finalized checkpoints.
+// This is process_justification_and_finalization in the Ethereum 2 specification.
+func (s *Service) ProcessJustificationAndFinalization(state *spec.BeaconState) error {
+	currentEpoch := s.currentEpoch(state)
+	if currentEpoch <= genesisEpoch+1 {
+		return nil
+	}
+	previousEpoch := s.previousEpoch(state)
+
+	oldPreviousJustifiedCheckpoint := copyCheckpoint(state.PreviousJustifiedCheckpoint)
+	oldCurrentJustifiedCheckpoint := copyCheckpoint(state.CurrentJustifiedCheckpoint)
+
+	// Process justifications.
+	state.PreviousJustifiedCheckpoint = copyCheckpoint(state.CurrentJustifiedCheckpoint)
+	bits := byte(0)
+	if len(state.JustificationBits) > 0 {
+		bits = state.JustificationBits[0]
+	}
+	bits = (bits << 1) & 0x0f
+	totalActiveBalance := s.totalActiveBalance(state)
+
+	for i, epoch := range []spec.Epoch{previousEpoch, currentEpoch} {
+		source, err := s.matchingSourceAttestations(state, epoch)
+		if err != nil {
+			return err
+		}
+		target, err := s.matchingTargetAttestations(state, source, epoch)
+		if err != nil {
+			return err
+		}
+		if s.totalBalance(state, unslashedAttestingIndices(state, target))*3 >= totalActiveBalance*2 {
+			root, err := s.blockRoot(state, epoch)
+			if err != nil {
+				return err
+			}
+			state.CurrentJustifiedCheckpoint = &spec.Checkpoint{
+				Epoch: epoch,
+				Root:  root,
+			}
+			// Bit 1 is the previous epoch, bit 0 the current epoch.
+			bits |= 1 << (1 - i)
+		}
+	}
+	state.JustificationBits = []byte{bits}
+
+	// Process finalizations.
+	// The 2nd/3rd/4th most recent epochs are justified, the 2nd using the 4th as source.
+	if bits&0x0e == 0x0e && oldPreviousJustifiedCheckpoint.Epoch+3 == currentEpoch {
+		state.FinalizedCheckpoint = oldPreviousJustifiedCheckpoint
+	}
+	// The 2nd/3rd most recent epochs are justified, the 2nd using the 3rd as source.
+	if bits&0x06 == 0x06 && oldPreviousJustifiedCheckpoint.Epoch+2 == currentEpoch {
+		state.FinalizedCheckpoint = oldPreviousJustifiedCheckpoint
+	}
+	// The 1st/2nd/3rd most recent epochs are justified, the 1st using the 3rd as source.
+	if bits&0x07 == 0x07 && oldCurrentJustifiedCheckpoint.Epoch+2 == currentEpoch {
+		state.FinalizedCheckpoint = oldCurrentJustifiedCheckpoint
+	}
+	// The 1st/2nd most recent epochs are justified, the 1st using the 2nd as source.
+	if bits&0x03 == 0x03 && oldCurrentJustifiedCheckpoint.Epoch+1 == currentEpoch {
+		state.FinalizedCheckpoint = oldCurrentJustifiedCheckpoint
+	}
+
+	return nil
+}
+
+// copyCheckpoint returns a copy of a checkpoint.
+func copyCheckpoint(checkpoint *spec.Checkpoint) *spec.Checkpoint {
+	return &spec.Checkpoint{
+		Epoch: checkpoint.Epoch,
+		Root:  checkpoint.Root,
+	}
+}
+
+// ProcessRewardsAndPenalties applies attestation rewards and penalties for the previous epoch.
+// This is process_rewards_and_penalties in the Ethereum 2 specification.
+func (s *Service) ProcessRewardsAndPenalties(state *spec.BeaconState) error {
+	if s.currentEpoch(state) == genesisEpoch {
+		return nil
+	}
+
+	rewards, penalties, err := s.attestationDeltas(state)
+	if err != nil {
+		return err
+	}
+	for i := range state.Validators {
+		increaseBalance(state, spec.ValidatorIndex(i), rewards[i])
+		decreaseBalance(state, spec.ValidatorIndex(i), penalties[i])
+	}
+
+	return nil
+}
+
+// attestationDeltas returns the rewards and penalties for each validator for the previous epoch.
+// This is get_attestation_deltas in the Ethereum 2 specification.
+func (s *Service) attestationDeltas(state *spec.BeaconState) ([]uint64, []uint64, error) {
+	rewards := make([]uint64, len(state.Validators))
+	penalties := make([]uint64, len(state.Validators))
+
+	previousEpoch := s.previousEpoch(state)
+	source, err := s.matchingSourceAttestations(state, previousEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := s.matchingTargetAttestations(state, source, previousEpoch)
+	if err != nil {
+		return nil, nil, err
+	}
+	head, err := s.matchingHeadAttestations(state, target)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalBalance := s.totalActiveBalance(state)
+	sqrtTotalBalance := integerSquareRoot(totalBalance)
+	baseReward := func(index spec.ValidatorIndex) uint64 {
+		return uint64(state.Validators[index].EffectiveBalance) * s.baseRewardFactor / sqrtTotalBalance / baseRewardsPerEpoch
+	}
+	eligible := s.eligibleValidatorIndices(state)
+	finalityDelay := uint64(previousEpoch - state.FinalizedCheckpoint.Epoch)
+	inInactivityLeak := finalityDelay > s.minEpochsToInactivityPenalty
+
+	// Source, target and head components.
+	for _, attestations := range []*epochAttestations{source, target, head} {
+		attesting := make(map[spec.ValidatorIndex]bool)
+		unslashed := unslashedAttestingIndices(state, attestations)
+		for _, index := range unslashed {
+			attesting[index] = true
+		}
+		attestingBalance := s.totalBalance(state, unslashed)
+		for _, index := range eligible {
+			if !attesting[index] {
+				penalties[index] += baseReward(index)
+				continue
+			}
+			if inInactivityLeak {
+				// Optimal participation receives the full base reward compensation here.
+				rewards[index] += baseReward(index)
+			} else {
+				rewardNumerator := baseReward(index) * (attestingBalance / s.effectiveBalanceIncrement)
+				rewards[index] += rewardNumerator / (totalBalance / s.effectiveBalanceIncrement)
+			}
+		}
+	}
+
+	// Inclusion delay component; each attester is rewarded for their earliest included attestation.
+	earliest := make(map[spec.ValidatorIndex]*spec.PendingAttestation)
+	for i, attestation := range source.attestations {
+		for _, index := range source.indices[i] {
+			if existing, exists := earliest[index]; !exists || attestation.InclusionDelay < existing.InclusionDelay {
+				earliest[index] = attestation
+			}
+		}
+	}
+	for _, index := range unslashedAttestingIndices(state, source) {
+		attestation := earliest[index]
+		proposerReward := baseReward(index) / s.proposerRewardQuotient
+		rewards[attestation.ProposerIndex] += proposerReward
+		maxAttesterReward := baseReward(index) - proposerReward
+		rewards[index] += maxAttesterReward / uint64(attestation.InclusionDelay)
+	}
+
+	// Inactivity penalty component.
+	if inInactivityLeak {
+		targetAttesting := make(map[spec.ValidatorIndex]bool)
+		for _, index := range unslashedAttestingIndices(state, target) {
+			targetAttesting[index] = true
+		}
+		for _, index := range eligible {
+			// Cancel out the rewards for optimal participation, leaving only the proposer reward.
+			penalties[index] += baseRewardsPerEpoch*baseReward(index) - baseReward(index)/s.proposerRewardQuotient
+			if !targetAttesting[index] {
+				penalties[index] += uint64(state.Validators[index].EffectiveBalance) * finalityDelay / s.inactivityPenaltyQuotient
+			}
+		}
+	}
+
+	return rewards, penalties, nil
+}
+
+// eligibleValidatorIndices returns the indices of validators eligible for rewards and penalties in the previous epoch.
+func (s *Service) eligibleValidatorIndices(state *spec.BeaconState) []spec.ValidatorIndex {
+	previousEpoch := s.previousEpoch(state)
+	res := make([]spec.ValidatorIndex, 0, len(state.Validators))
+	for i, validator := range state.Validators {
+		if helpers.IsActiveValidator(validator, previousEpoch) ||
+			(validator.Slashed && previousEpoch+1 < validator.WithdrawableEpoch) {
+			res = append(res, spec.ValidatorIndex(i))
+		}
+	}
+	return res
+}
+
+// integerSquareRoot returns the largest integer x such that x*x <= n.
+// This is integer_squareroot in the Ethereum 2 specification.
+func integerSquareRoot(n uint64) uint64 {
+	if n == 0xffffffffffffffff {
+		return 0xffffffff
+	}
+	x := n
+	y := (x + 1) / 2
+	for y < x {
+		x = y
+		y = (x + n/x) / 2
+	}
+	return x
+}
+
+// ProcessRegistryUpdates processes activation eligibility, ejections and the activation queue.
+// This is process_registry_updates in the Ethereum 2 specification.
+func (s *Service) ProcessRegistryUpdates(state *spec.BeaconState) {
+	currentEpoch := s.currentEpoch(state)
+	for i, validator := range state.Validators {
+		if validator.ActivationEligibilityEpoch == farFutureEpoch && uint64(validator.EffectiveBalance) == s.maxEffectiveBalance {
+			validator.ActivationEligibilityEpoch = currentEpoch + 1
+		}
+		if helpers.IsActiveValidator(validator, currentEpoch) && uint64(validator.EffectiveBalance) <= s.ejectionBalance {
+			s.initiateValidatorExit(state, spec.ValidatorIndex(i))
+		}
+	}
+
+	// Queue validators eligible for activation and not yet dequeued for activation.
+	queue := make([]spec.ValidatorIndex, 0)
+	for i, validator := range state.Validators {
+		if validator.ActivationEligibilityEpoch <= state.FinalizedCheckpoint.Epoch && validator.ActivationEpoch == farFutureEpoch {
+			queue = append(queue, spec.ValidatorIndex(i))
+		}
+	}
+	// Order by the sequence of activation eligibility, breaking ties by index.
+	sort.Slice(queue, func(i, j int) bool {
+		epochI := state.Validators[queue[i]].ActivationEligibilityEpoch
+		epochJ := state.Validators[queue[j]].ActivationEligibilityEpoch
+		if epochI != epochJ {
+			return epochI < epochJ
+		}
+		return queue[i] < queue[j]
+	})
+	churnLimit := s.validatorChurnLimit(state)
+	if uint64(len(queue)) > churnLimit {
+		queue = queue[:churnLimit]
+	}
+	for _, index := range queue {
+		state.Validators[index].ActivationEpoch = s.computeActivationExitEpoch(currentEpoch)
+	}
+}
+
+// ProcessSlashings applies the correlated slashing penalty to validators halfway to withdrawal.
+// This is process_slashings in the Ethereum 2 specification.
+func (s *Service) ProcessSlashings(state *spec.BeaconState) {
+	epoch := s.currentEpoch(state)
+	totalBalance := s.totalActiveBalance(state)
+	slashings := uint64(0)
+	for _, slashing := range state.Slashings {
+		slashings += slashing
+	}
+	adjustedTotalSlashingBalance := slashings * s.proportionalSlashingMultiplier
+	if adjustedTotalSlashingBalance > totalBalance {
+		adjustedTotalSlashingBalance = totalBalance
+	}
+
+	for i, validator := range state.Validators {
+		if validator.Slashed && epoch+spec.Epoch(s.epochsPerSlashingsVector/2) == validator.WithdrawableEpoch {
+			// Factored out from the penalty numerator to avoid uint64 overflow.
+			penaltyNumerator := uint64(validator.EffectiveBalance) / s.effectiveBalanceIncrement * adjustedTotalSlashingBalance
+			penalty := penaltyNumerator / totalBalance * s.effectiveBalanceIncrement
+			decreaseBalance(state, spec.ValidatorIndex(i), penalty)
+		}
+	}
+}
+
+// ProcessFinalUpdates carries out the housekeeping at the end of an epoch.
+// This is process_final_updates in the Ethereum 2 specification.
+func (s *Service) ProcessFinalUpdates(state *spec.BeaconState) error {
+	s.ProcessETH1DataReset(state)
+	s.ProcessEffectiveBalanceUpdates(state)
+	s.ProcessSlashingsReset(state)
+	if err := s.ProcessRANDAOMixesReset(state); err != nil {
+		return err
+	}
+	if err := s.ProcessHistoricalRootsUpdate(state); err != nil {
+		return err
+	}
+	s.ProcessParticipationRecordUpdates(state)
+
+	return nil
+}
+
+// ProcessETH1DataReset clears the Ethereum 1 data votes at the end of a voting period.
+func (s *Service) ProcessETH1DataReset(state *spec.BeaconState) {
+	if uint64(s.currentEpoch(state)+1)%s.epochsPerETH1VotingPeriod == 0 {
+		state.ETH1DataVotes = make([]*spec.ETH1Data, 0)
+	}
+}
+
+// ProcessEffectiveBalanceUpdates updates effective balances, with hysteresis.
+func (s *Service) ProcessEffectiveBalanceUpdates(state *spec.BeaconState) {
+	hysteresisIncrement := s.effectiveBalanceIncrement / s.hysteresisQuotient
+	downwardThreshold := hysteresisIncrement * s.hysteresisDownwardMultiplier
+	upwardThreshold := hysteresisIncrement * s.hysteresisUpwardMultiplier
+	for i, validator := range state.Validators {
+		balance := state.Balances[i]
+		effectiveBalance := uint64(validator.EffectiveBalance)
+		if balance+downwardThreshold < effectiveBalance || effectiveBalance+upwardThreshold < balance {
+			effectiveBalance = balance - balance%s.effectiveBalanceIncrement
+			if effectiveBalance > s.maxEffectiveBalance {
+				effectiveBalance = s.maxEffectiveBalance
+			}
+			validator.EffectiveBalance = spec.Gwei(effectiveBalance)
+		}
+	}
+}
+
+// ProcessSlashingsReset clears the slashings total for the next epoch.
+func (s *Service) ProcessSlashingsReset(state *spec.BeaconState) {
+	state.Slashings[uint64(s.currentEpoch(state)+1)%s.epochsPerSlashingsVector] = 0
+}
+
+// ProcessRANDAOMixesReset carries the current RANDAO mix over to the next epoch.
+func (s *Service) ProcessRANDAOMixesReset(state *spec.BeaconState) error {
+	currentEpoch := s.currentEpoch(state)
+	mix, err := s.helpers.RANDAOMix(state, currentEpoch)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain RANDAO mix")
+	}
+	state.RANDAOMixes[uint64(currentEpoch+1)%s.epochsPerHistoricalVector] = append([]byte{}, mix...)
+	return nil
+}
+
+// ProcessHistoricalRootsUpdate adds a historical batch root to the state when the block and state roots wrap.
+func (s *Service) ProcessHistoricalRootsUpdate(state *spec.BeaconState) error {
+	if uint64(s.currentEpoch(state)+1)%(s.slotsPerHistoricalRoot/s.slotsPerEpoch) == 0 {
+		root, err := historicalBatchRoot(state)
+		if err != nil {
+			return err
+		}
+		state.HistoricalRoots = append(state.HistoricalRoots, root[:])
+	}
+	return nil
+}
+
+// ProcessParticipationRecordUpdates rotates the current and previous epoch attestations.
+func (s *Service) ProcessParticipationRecordUpdates(state *spec.BeaconState) {
+	state.PreviousEpochAttestations = state.CurrentEpochAttestations
+	state.CurrentEpochAttestations = make([]*spec.PendingAttestation, 0)
+}
+
+// historicalBatchRoot returns the hash tree root of the state's block and state roots as a historical batch.
+func historicalBatchRoot(state *spec.BeaconState) ([32]byte, error) {
+	blockRoots, err := rootsVectorRoot(state.BlockRoots)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to calculate block roots root")
+	}
+	stateRoots, err := rootsVectorRoot(state.StateRoots)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to calculate state roots root")
+	}
+	tree, err := merkle.NewTree([][32]byte{blockRoots, stateRoots}, 1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return tree.Root(), nil
+}
+
+// rootsVectorRoot returns the hash tree root of a vector of roots.
+func rootsVectorRoot(roots [][]byte) ([32]byte, error) {
+	chunks := make([][32]byte, len(roots))
+	for i := range roots {
+		copy(chunks[i][:], roots[i])
+	}
+	depth := uint64(0)
+	for uint64(1)<<depth < uint64(len(roots)) {
+		depth++
+	}
+	tree, err := merkle.NewTree(chunks, depth)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return tree.Root(), nil
+}
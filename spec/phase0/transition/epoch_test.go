@@ -0,0 +1,149 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessJustificationAndFinalization(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	// No justification is possible in the first two epochs.
+	state.Slot = 15
+	require.NoError(t, s.ProcessJustificationAndFinalization(state))
+	require.Equal(t, []byte{0x00}, []byte(state.JustificationBits))
+
+	// Without attestations nothing is justified, but the bits are shifted.
+	state.Slot = 23
+	state.JustificationBits = []byte{0x0b}
+	require.NoError(t, s.ProcessJustificationAndFinalization(state))
+	require.Equal(t, []byte{0x06}, []byte(state.JustificationBits))
+	require.Equal(t, spec.Epoch(0), state.CurrentJustifiedCheckpoint.Epoch)
+	require.Equal(t, spec.Epoch(0), state.FinalizedCheckpoint.Epoch)
+}
+
+func TestProcessRewardsAndPenalties(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	// No rewards or penalties in the genesis epoch.
+	require.NoError(t, s.ProcessRewardsAndPenalties(state))
+	for _, balance := range state.Balances {
+		require.Equal(t, uint64(32000000000), balance)
+	}
+
+	// With no attestations every validator is penalised for source, target and head.
+	state.Slot = 15
+	require.NoError(t, s.ProcessRewardsAndPenalties(state))
+	// Base reward is 32e9 * 64 / isqrt(64 * 32e9) / 4.
+	baseReward := uint64(32000000000) * 64 / 1431083 / 4
+	for _, balance := range state.Balances {
+		require.Equal(t, uint64(32000000000)-3*baseReward, balance)
+	}
+}
+
+func TestProcessRegistryUpdates(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	state.Slot = 16
+	state.FinalizedCheckpoint.Epoch = 1
+	// Validator 1 has a low effective balance and will be ejected.
+	state.Validators[1].EffectiveBalance = 16000000000
+	// Validators 2 to 7 are pending activation, and eligible as of finality.
+	for i := 2; i < 8; i++ {
+		state.Validators[i].ActivationEligibilityEpoch = 1
+		state.Validators[i].ActivationEpoch = 0xffffffffffffffff
+	}
+	// Validator 7 became eligible earlier, so is at the front of the queue.
+	state.Validators[7].ActivationEligibilityEpoch = 0
+	// Validator 8 has a new deposit.
+	state.Validators[8].ActivationEligibilityEpoch = 0xffffffffffffffff
+	state.Validators[8].ActivationEpoch = 0xffffffffffffffff
+
+	s.ProcessRegistryUpdates(state)
+
+	require.Equal(t, spec.Epoch(7), state.Validators[1].ExitEpoch)
+	// Churn limit is 4.
+	for _, index := range []int{7, 2, 3, 4} {
+		require.Equal(t, spec.Epoch(7), state.Validators[index].ActivationEpoch, "validator %d", index)
+	}
+	for _, index := range []int{5, 6} {
+		require.Equal(t, spec.Epoch(0xffffffffffffffff), state.Validators[index].ActivationEpoch, "validator %d", index)
+	}
+	require.Equal(t, spec.Epoch(3), state.Validators[8].ActivationEligibilityEpoch)
+	require.Equal(t, spec.Epoch(0xffffffffffffffff), state.Validators[8].ActivationEpoch)
+}
+
+func TestProcessSlashings(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	state.Slot = 16
+	state.Validators[1].Slashed = true
+	state.Validators[1].WithdrawableEpoch = 2 + 4096
+	state.Validators[2].Slashed = true
+	state.Validators[2].WithdrawableEpoch = 2 + 8192
+	state.Slashings[0] = 64000000000
+
+	s.ProcessSlashings(state)
+
+	// Validator 1 is penalised in proportion to the total slashed.
+	require.Equal(t, uint64(31000000000), state.Balances[1])
+	// Validator 2 is not yet halfway to withdrawal.
+	require.Equal(t, uint64(32000000000), state.Balances[2])
+}
+
+func TestProcessFinalUpdates(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	state.Slot = 31
+	state.ETH1DataVotes = append(state.ETH1DataVotes, state.ETH1Data)
+	state.Slashings[4] = 32000000000
+	// Balance drops below the downward threshold.
+	state.Balances[1] = 31700000000
+	// Balance drops, but not below the downward threshold.
+	state.Balances[2] = 31800000000
+	// Balance rises above the upward threshold, but is capped.
+	state.Balances[3] = 33300000000
+	state.CurrentEpochAttestations = append(state.CurrentEpochAttestations, &spec.PendingAttestation{})
+
+	require.NoError(t, s.ProcessFinalUpdates(state))
+
+	require.Len(t, state.ETH1DataVotes, 0)
+	require.Equal(t, uint64(0), state.Slashings[4])
+	require.Equal(t, spec.Gwei(31000000000), state.Validators[1].EffectiveBalance)
+	require.Equal(t, spec.Gwei(32000000000), state.Validators[2].EffectiveBalance)
+	require.Equal(t, spec.Gwei(32000000000), state.Validators[3].EffectiveBalance)
+	require.Equal(t, state.RANDAOMixes[3], state.RANDAOMixes[4])
+	require.Len(t, state.PreviousEpochAttestations, 1)
+	require.Len(t, state.CurrentEpochAttestations, 0)
+	require.Len(t, state.HistoricalRoots, 0)
+
+	// Historical roots are accumulated every SLOTS_PER_HISTORICAL_ROOT slots.
+	state.Slot = 8191
+	require.NoError(t, s.ProcessFinalUpdates(state))
+	require.Len(t, state.HistoricalRoots, 1)
+}
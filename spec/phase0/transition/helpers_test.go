@@ -0,0 +1,363 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/attestantio/go-eth2-client/spec/phase0/transition"
+	"github.com/attestantio/go-eth2-client/verify"
+	bls "github.com/kilic/bls12-381"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	proposerDomain      = spec.DomainType{0x00, 0x00, 0x00, 0x00}
+	attesterDomain      = spec.DomainType{0x01, 0x00, 0x00, 0x00}
+	randaoDomain        = spec.DomainType{0x02, 0x00, 0x00, 0x00}
+	depositDomain       = spec.DomainType{0x03, 0x00, 0x00, 0x00}
+	voluntaryExitDomain = spec.DomainType{0x04, 0x00, 0x00, 0x00}
+	genesisForkVersion  = spec.Version{0x00, 0x00, 0x00, 0x01}
+)
+
+// testSpec is a spec provider using small epochs and committees with mainnet-sized state vectors.
+type testSpec map[string]interface{}
+
+func (m testSpec) Spec(ctx context.Context) (map[string]interface{}, error) {
+	return m, nil
+}
+
+func newTestSpec() testSpec {
+	return testSpec{
+		"SLOTS_PER_EPOCH":                     uint64(8),
+		"SLOTS_PER_HISTORICAL_ROOT":           uint64(8192),
+		"EPOCHS_PER_HISTORICAL_VECTOR":        uint64(65536),
+		"EPOCHS_PER_SLASHINGS_VECTOR":         uint64(8192),
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":       uint64(4),
+		"MIN_ATTESTATION_INCLUSION_DELAY":     uint64(1),
+		"MIN_SEED_LOOKAHEAD":                  uint64(1),
+		"MAX_SEED_LOOKAHEAD":                  uint64(4),
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": uint64(256),
+		"SHARD_COMMITTEE_PERIOD":              uint64(64),
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":    uint64(4),
+		"MAX_EFFECTIVE_BALANCE":               uint64(32000000000),
+		"EFFECTIVE_BALANCE_INCREMENT":         uint64(1000000000),
+		"EJECTION_BALANCE":                    uint64(16000000000),
+		"HYSTERESIS_QUOTIENT":                 uint64(4),
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":      uint64(1),
+		"HYSTERESIS_UPWARD_MULTIPLIER":        uint64(5),
+		"MIN_PER_EPOCH_CHURN_LIMIT":           uint64(4),
+		"CHURN_LIMIT_QUOTIENT":                uint64(65536),
+		"BASE_REWARD_FACTOR":                  uint64(64),
+		"WHISTLEBLOWER_REWARD_QUOTIENT":       uint64(512),
+		"PROPOSER_REWARD_QUOTIENT":            uint64(8),
+		"INACTIVITY_PENALTY_QUOTIENT":         uint64(67108864),
+		"MIN_SLASHING_PENALTY_QUOTIENT":       uint64(128),
+		"PROPORTIONAL_SLASHING_MULTIPLIER":    uint64(1),
+		"MAX_DEPOSITS":                        uint64(16),
+		"SHUFFLE_ROUND_COUNT":                 uint64(10),
+		"TARGET_COMMITTEE_SIZE":               uint64(4),
+		"MAX_COMMITTEES_PER_SLOT":             uint64(4),
+		"DOMAIN_BEACON_PROPOSER":              proposerDomain,
+		"DOMAIN_BEACON_ATTESTER":              attesterDomain,
+		"DOMAIN_RANDAO":                       randaoDomain,
+		"DOMAIN_DEPOSIT":                      depositDomain,
+		"DOMAIN_VOLUNTARY_EXIT":               voluntaryExitDomain,
+		"GENESIS_FORK_VERSION":                genesisForkVersion,
+	}
+}
+
+// testChain holds the keys for a set of validators, and builds blocks for them.
+type testChain struct {
+	t          testing.TB
+	secretKeys []*big.Int
+	pubKeys    []spec.BLSPubKey
+	helpers    *helpers.Service
+	// builder processes blocks to calculate their state roots.
+	builder *transition.Service
+	// sign is true if blocks and attestations should be signed.
+	sign bool
+}
+
+func newTestChain(t testing.TB, validators int, sign bool) *testChain {
+	helpersSvc, err := helpers.New(context.Background(),
+		helpers.WithLogLevel(zerolog.Disabled),
+		helpers.WithSpecProvider(newTestSpec()),
+	)
+	require.NoError(t, err)
+	builder, err := transition.New(context.Background(),
+		transition.WithLogLevel(zerolog.Disabled),
+		transition.WithSpecProvider(newTestSpec()),
+		transition.WithSignatureVerification(false),
+		transition.WithStateRootVerification(false),
+	)
+	require.NoError(t, err)
+
+	c := &testChain{
+		t:          t,
+		secretKeys: make([]*big.Int, validators),
+		pubKeys:    make([]spec.BLSPubKey, validators),
+		helpers:    helpersSvc,
+		builder:    builder,
+		sign:       sign,
+	}
+	for i := 0; i < validators; i++ {
+		c.secretKeys[i], c.pubKeys[i] = testKey(1000 + i)
+	}
+	return c
+}
+
+// testKey returns a secret key and its public key.
+func testKey(secret int) (*big.Int, spec.BLSPubKey) {
+	g1 := bls.NewG1()
+	secretKey := big.NewInt(int64(secret))
+	pubKey := g1.New()
+	g1.MulScalarBig(pubKey, g1.One(), secretKey)
+	var compressed spec.BLSPubKey
+	copy(compressed[:], g1.ToCompressed(pubKey))
+	return secretKey, compressed
+}
+
+// signRoot signs a signing root with the given secret keys, aggregating the result.
+func signRoot(signingRoot spec.Root, secretKeys ...*big.Int) spec.BLSSignature {
+	g2 := bls.NewG2()
+	msg, err := g2.HashToCurve(signingRoot[:], []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+	if err != nil {
+		panic(err)
+	}
+	aggregate := g2.Zero()
+	for _, secretKey := range secretKeys {
+		sig := g2.New()
+		g2.MulScalarBig(sig, msg, secretKey)
+		g2.Add(aggregate, aggregate, sig)
+	}
+	var res spec.BLSSignature
+	copy(res[:], g2.ToCompressed(aggregate))
+	return res
+}
+
+// signObjectRoot signs an object root for the given domain type and epoch by the given validators.
+func (c *testChain) signObjectRoot(state *spec.BeaconState, root spec.Root, domainType spec.DomainType, epoch spec.Epoch, indices ...spec.ValidatorIndex) spec.BLSSignature {
+	if !c.sign {
+		return spec.BLSSignature{}
+	}
+	var genesisValidatorsRoot spec.Root
+	copy(genesisValidatorsRoot[:], state.GenesisValidatorsRoot)
+	domain, err := verify.ComputeDomain(domainType, state.Fork.CurrentVersion, genesisValidatorsRoot)
+	require.NoError(c.t, err)
+	signingRoot, err := verify.ComputeSigningRoot(root, domain)
+	require.NoError(c.t, err)
+	secretKeys := make([]*big.Int, len(indices))
+	for i := range indices {
+		secretKeys[i] = c.secretKeys[indices[i]]
+	}
+	return signRoot(signingRoot, secretKeys...)
+}
+
+// signObject signs an object for the given domain type and epoch by the given validators.
+func (c *testChain) signObject(state *spec.BeaconState, object interface{ HashTreeRoot() ([32]byte, error) }, domainType spec.DomainType, epoch spec.Epoch, indices ...spec.ValidatorIndex) spec.BLSSignature {
+	root, err := object.HashTreeRoot()
+	require.NoError(c.t, err)
+	return c.signObjectRoot(state, root, domainType, epoch, indices...)
+}
+
+// genesisState returns a state at slot 0 with all validators active.
+func (c *testChain) genesisState() *spec.BeaconState {
+	state := &spec.BeaconState{
+		GenesisTime:           1606824000,
+		GenesisValidatorsRoot: filledBytes(32, 0x01),
+		Fork: &spec.Fork{
+			PreviousVersion: genesisForkVersion,
+			CurrentVersion:  genesisForkVersion,
+		},
+		BlockRoots:      make([][]byte, 8192),
+		StateRoots:      make([][]byte, 8192),
+		HistoricalRoots: make([][]byte, 0),
+		ETH1Data: &spec.ETH1Data{
+			DepositCount: uint64(len(c.pubKeys)),
+			BlockHash:    filledBytes(32, 0x02),
+		},
+		ETH1DataVotes:               make([]*spec.ETH1Data, 0),
+		ETH1DepositIndex:            uint64(len(c.pubKeys)),
+		Validators:                  make([]*spec.Validator, len(c.pubKeys)),
+		Balances:                    make([]uint64, len(c.pubKeys)),
+		RANDAOMixes:                 make([][]byte, 65536),
+		Slashings:                   make([]uint64, 8192),
+		PreviousEpochAttestations:   make([]*spec.PendingAttestation, 0),
+		CurrentEpochAttestations:    make([]*spec.PendingAttestation, 0),
+		JustificationBits:           bitfield.Bitvector4{0x00},
+		PreviousJustifiedCheckpoint: &spec.Checkpoint{},
+		CurrentJustifiedCheckpoint:  &spec.Checkpoint{},
+		FinalizedCheckpoint:         &spec.Checkpoint{},
+	}
+	bodyRoot, err := emptyBody().HashTreeRoot()
+	require.NoError(c.t, err)
+	state.LatestBlockHeader = &spec.BeaconBlockHeader{BodyRoot: bodyRoot}
+	for i := range state.BlockRoots {
+		state.BlockRoots[i] = make([]byte, 32)
+		state.StateRoots[i] = make([]byte, 32)
+	}
+	for i := range state.RANDAOMixes {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, uint64(i))
+		mix := sha256.Sum256(data)
+		state.RANDAOMixes[i] = mix[:]
+	}
+	for i := range state.Validators {
+		state.Validators[i] = &spec.Validator{
+			PublicKey:                  c.pubKeys[i],
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           32000000000,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  0xffffffffffffffff,
+			WithdrawableEpoch:          0xffffffffffffffff,
+		}
+		state.Balances[i] = 32000000000
+	}
+	return state
+}
+
+// emptyBody returns a block body with no operations.
+func emptyBody() *spec.BeaconBlockBody {
+	return &spec.BeaconBlockBody{
+		ETH1Data: &spec.ETH1Data{
+			BlockHash: make([]byte, 32),
+		},
+		Graffiti: make([]byte, 32),
+	}
+}
+
+// copyState returns a deep copy of the state.
+func copyState(t testing.TB, state *spec.BeaconState) *spec.BeaconState {
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+	res := &spec.BeaconState{}
+	require.NoError(t, res.UnmarshalSSZ(data))
+	return res
+}
+
+// block builds a signed block at the given slot on top of the state, containing attestations
+// from all committees of the previous slot.  The supplied state is not altered.
+func (c *testChain) block(state *spec.BeaconState, slot spec.Slot) *spec.SignedBeaconBlock {
+	state = copyState(c.t, state)
+	require.NoError(c.t, c.builder.ProcessSlots(state, slot))
+
+	proposerIndex, err := c.helpers.BeaconProposerIndex(state)
+	require.NoError(c.t, err)
+	parentRoot, err := state.LatestBlockHeader.HashTreeRoot()
+	require.NoError(c.t, err)
+	epoch := c.helpers.EpochAtSlot(slot)
+
+	body := emptyBody()
+	body.ETH1Data = state.ETH1Data
+	var epochRoot spec.Root
+	binary.LittleEndian.PutUint64(epochRoot[:], uint64(epoch))
+	body.RANDAOReveal = c.signObjectRoot(state, epochRoot, randaoDomain, epoch, proposerIndex)
+	if slot > 0 {
+		body.Attestations = c.attestations(state, slot-1)
+	}
+
+	block := &spec.BeaconBlock{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    parentRoot,
+		Body:          body,
+	}
+	require.NoError(c.t, c.builder.ProcessBlock(state, block))
+	block.StateRoot, err = state.HashTreeRoot()
+	require.NoError(c.t, err)
+
+	return &spec.SignedBeaconBlock{
+		Message:   block,
+		Signature: c.signObject(state, block, proposerDomain, epoch, proposerIndex),
+	}
+}
+
+// attestations returns fully-populated attestations for each committee at the given slot.
+// The state must be at a later slot.
+func (c *testChain) attestations(state *spec.BeaconState, slot spec.Slot) []*spec.Attestation {
+	epoch := c.helpers.EpochAtSlot(slot)
+	source := state.CurrentJustifiedCheckpoint
+	if epoch < c.helpers.CurrentEpoch(state) {
+		source = state.PreviousJustifiedCheckpoint
+	}
+	var targetRoot spec.Root
+	copy(targetRoot[:], state.BlockRoots[uint64(c.helpers.EpochStartSlot(epoch))%8192])
+	var headRoot spec.Root
+	copy(headRoot[:], state.BlockRoots[uint64(slot)%8192])
+
+	res := make([]*spec.Attestation, 0)
+	for index := uint64(0); index < c.helpers.CommitteeCountPerSlot(state, epoch); index++ {
+		committee, err := c.helpers.BeaconCommittee(state, slot, spec.CommitteeIndex(index))
+		require.NoError(c.t, err)
+		attestation := &spec.Attestation{
+			AggregationBits: bitfield.NewBitlist(uint64(len(committee))),
+			Data: &spec.AttestationData{
+				Slot:            slot,
+				Index:           spec.CommitteeIndex(index),
+				BeaconBlockRoot: headRoot,
+				Source: &spec.Checkpoint{
+					Epoch: source.Epoch,
+					Root:  source.Root,
+				},
+				Target: &spec.Checkpoint{
+					Epoch: epoch,
+					Root:  targetRoot,
+				},
+			},
+		}
+		for i := range committee {
+			attestation.AggregationBits.SetBitAt(uint64(i), true)
+		}
+		attestation.Signature = c.signObject(state, attestation.Data, attesterDomain, epoch, committee...)
+		res = append(res, attestation)
+	}
+	return res
+}
+
+// newService creates a transition service with the given signature verification.
+func newService(t testing.TB, verifySignatures bool) *transition.Service {
+	s, err := transition.New(context.Background(),
+		transition.WithLogLevel(zerolog.Disabled),
+		transition.WithSpecProvider(newTestSpec()),
+		transition.WithSignatureVerification(verifySignatures),
+	)
+	require.NoError(t, err)
+	return s
+}
+
+// committee returns the committee at the given slot and index.
+func (c *testChain) committee(state *spec.BeaconState, slot spec.Slot, index spec.CommitteeIndex) []spec.ValidatorIndex {
+	committee, err := c.helpers.BeaconCommittee(state, slot, index)
+	require.NoError(c.t, err)
+	return committee
+}
+
+// filledBytes returns a byte slice of the given length filled with the given value.
+func filledBytes(length int, value byte) []byte {
+	res := make([]byte, length)
+	for i := range res {
+		res[i] = value
+	}
+	return res
+}
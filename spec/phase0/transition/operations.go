@@ -0,0 +1,314 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/attestantio/go-eth2-client/verify"
+	"github.com/pkg/errors"
+)
+
+// ProcessProposerSlashing applies a proposer slashing to the state.
+// This is process_proposer_slashing in the Ethereum 2 specification.
+func (s *Service) ProcessProposerSlashing(state *spec.BeaconState, proposerSlashing *spec.ProposerSlashing) error {
+	if proposerSlashing.SignedHeader1 == nil || proposerSlashing.SignedHeader1.Message == nil ||
+		proposerSlashing.SignedHeader2 == nil || proposerSlashing.SignedHeader2.Message == nil {
+		return errors.New("missing header")
+	}
+	header1 := proposerSlashing.SignedHeader1.Message
+	header2 := proposerSlashing.SignedHeader2.Message
+
+	if header1.Slot != header2.Slot {
+		return errors.New("header slots do not match")
+	}
+	if header1.ProposerIndex != header2.ProposerIndex {
+		return errors.New("header proposer indices do not match")
+	}
+	header1Root, err := objectRoot(header1)
+	if err != nil {
+		return err
+	}
+	header2Root, err := objectRoot(header2)
+	if err != nil {
+		return err
+	}
+	if header1Root == header2Root {
+		return errors.New("headers are the same")
+	}
+	if uint64(header1.ProposerIndex) >= uint64(len(state.Validators)) {
+		return fmt.Errorf("proposer index %d unknown", header1.ProposerIndex)
+	}
+	if !isSlashableValidator(state.Validators[header1.ProposerIndex], s.currentEpoch(state)) {
+		return fmt.Errorf("proposer %d is not slashable", header1.ProposerIndex)
+	}
+	for i, signedHeader := range []*spec.SignedBeaconBlockHeader{proposerSlashing.SignedHeader1, proposerSlashing.SignedHeader2} {
+		if err := s.verifySignature(state,
+			signedHeader.Message.ProposerIndex,
+			signedHeader.Message,
+			s.beaconProposerDomain,
+			s.helpers.EpochAtSlot(signedHeader.Message.Slot),
+			signedHeader.Signature,
+		); err != nil {
+			return errors.Wrapf(err, "header %d", i+1)
+		}
+	}
+
+	return s.slashValidator(state, header1.ProposerIndex)
+}
+
+// ProcessAttesterSlashing applies an attester slashing to the state.
+// This is process_attester_slashing in the Ethereum 2 specification.
+func (s *Service) ProcessAttesterSlashing(state *spec.BeaconState, attesterSlashing *spec.AttesterSlashing) error {
+	attestation1 := attesterSlashing.Attestation1
+	attestation2 := attesterSlashing.Attestation2
+	if attestation1 == nil || attestation1.Data == nil || attestation2 == nil || attestation2.Data == nil {
+		return errors.New("missing attestation")
+	}
+
+	slashable, err := isSlashableAttestationData(attestation1.Data, attestation2.Data)
+	if err != nil {
+		return err
+	}
+	if !slashable {
+		return errors.New("attestations are not slashable")
+	}
+	if err := s.validateIndexedAttestation(state, attestation1); err != nil {
+		return errors.Wrap(err, "attestation 1")
+	}
+	if err := s.validateIndexedAttestation(state, attestation2); err != nil {
+		return errors.Wrap(err, "attestation 2")
+	}
+
+	// Attesting indices are sorted, so the intersection is also sorted.
+	slashedAny := false
+	epoch := s.currentEpoch(state)
+	indices2 := make(map[uint64]bool, len(attestation2.AttestingIndices))
+	for _, index := range attestation2.AttestingIndices {
+		indices2[index] = true
+	}
+	for _, index := range attestation1.AttestingIndices {
+		if !indices2[index] {
+			continue
+		}
+		if isSlashableValidator(state.Validators[index], epoch) {
+			if err := s.slashValidator(state, spec.ValidatorIndex(index)); err != nil {
+				return err
+			}
+			slashedAny = true
+		}
+	}
+	if !slashedAny {
+		return errors.New("no validators slashed")
+	}
+
+	return nil
+}
+
+// isSlashableAttestationData returns true if the two attestations are a double vote or a surround vote.
+// This is is_slashable_attestation_data in the Ethereum 2 specification.
+func isSlashableAttestationData(data1 *spec.AttestationData, data2 *spec.AttestationData) (bool, error) {
+	root1, err := objectRoot(data1)
+	if err != nil {
+		return false, err
+	}
+	root2, err := objectRoot(data2)
+	if err != nil {
+		return false, err
+	}
+
+	// Double vote.
+	if root1 != root2 && data1.Target.Epoch == data2.Target.Epoch {
+		return true, nil
+	}
+	// Surround vote.
+	return data1.Source.Epoch < data2.Source.Epoch && data2.Target.Epoch < data1.Target.Epoch, nil
+}
+
+// ProcessAttestation validates an attestation and adds it to the state's pending attestations.
+// This is process_attestation in the Ethereum 2 specification.
+func (s *Service) ProcessAttestation(state *spec.BeaconState, attestation *spec.Attestation) error {
+	data := attestation.Data
+	if data == nil || data.Source == nil || data.Target == nil {
+		return errors.New("missing attestation data")
+	}
+
+	currentEpoch := s.currentEpoch(state)
+	previousEpoch := s.previousEpoch(state)
+	if data.Target.Epoch != currentEpoch && data.Target.Epoch != previousEpoch {
+		return fmt.Errorf("target epoch %d is neither previous epoch %d nor current epoch %d", data.Target.Epoch, previousEpoch, currentEpoch)
+	}
+	if data.Target.Epoch != s.helpers.EpochAtSlot(data.Slot) {
+		return fmt.Errorf("target epoch %d does not match slot %d", data.Target.Epoch, data.Slot)
+	}
+	if uint64(data.Slot)+s.minAttestationInclusionDelay > state.Slot {
+		return fmt.Errorf("attestation for slot %d included too early", data.Slot)
+	}
+	if state.Slot > uint64(data.Slot)+s.slotsPerEpoch {
+		return fmt.Errorf("attestation for slot %d included too late", data.Slot)
+	}
+	if uint64(data.Index) >= s.helpers.CommitteeCountPerSlot(state, data.Target.Epoch) {
+		return fmt.Errorf("committee index %d out of range", data.Index)
+	}
+
+	if data.Target.Epoch == currentEpoch {
+		if !checkpointsEqual(data.Source, state.CurrentJustifiedCheckpoint) {
+			return errors.New("source does not match current justified checkpoint")
+		}
+	} else {
+		if !checkpointsEqual(data.Source, state.PreviousJustifiedCheckpoint) {
+			return errors.New("source does not match previous justified checkpoint")
+		}
+	}
+
+	// The attestation must be valid before it is added to the state.
+	indexedAttestation, err := s.indexedAttestation(state, attestation)
+	if err != nil {
+		return err
+	}
+	if err := s.validateIndexedAttestation(state, indexedAttestation); err != nil {
+		return err
+	}
+
+	proposerIndex, err := s.helpers.BeaconProposerIndex(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain proposer index")
+	}
+	pendingAttestation := &spec.PendingAttestation{
+		AggregationBits: attestation.AggregationBits,
+		Data:            data,
+		InclusionDelay:  spec.Slot(state.Slot) - data.Slot,
+		ProposerIndex:   proposerIndex,
+	}
+	if data.Target.Epoch == currentEpoch {
+		state.CurrentEpochAttestations = append(state.CurrentEpochAttestations, pendingAttestation)
+	} else {
+		state.PreviousEpochAttestations = append(state.PreviousEpochAttestations, pendingAttestation)
+	}
+
+	return nil
+}
+
+// ProcessDeposit verifies a deposit against the Ethereum 1 deposit root and applies it to the state.
+// A deposit with an invalid signature for a new validator is valid, but has no effect.
+// This is process_deposit in the Ethereum 2 specification.
+func (s *Service) ProcessDeposit(state *spec.BeaconState, deposit *spec.Deposit) error {
+	if deposit.Data == nil {
+		return errors.New("missing deposit data")
+	}
+	if len(deposit.Proof) != depositContractTreeDepth+1 {
+		return fmt.Errorf("deposit proof has %d entries; expected %d", len(deposit.Proof), depositContractTreeDepth+1)
+	}
+	leaf, err := objectRoot(deposit.Data)
+	if err != nil {
+		return err
+	}
+	branch := make([][32]byte, len(deposit.Proof))
+	for i := range deposit.Proof {
+		copy(branch[i][:], deposit.Proof[i])
+	}
+	// The proof includes the mixed-in length of the deposit list.
+	index := uint64(1)<<(depositContractTreeDepth+1) + state.ETH1DepositIndex
+	if !merkle.VerifyProof(state.ETH1Data.DepositRoot, leaf, branch, index) {
+		return errors.New("invalid deposit proof")
+	}
+
+	state.ETH1DepositIndex++
+
+	for i, validator := range state.Validators {
+		if validator.PublicKey == deposit.Data.PublicKey {
+			increaseBalance(state, spec.ValidatorIndex(i), uint64(deposit.Data.Amount))
+			return nil
+		}
+	}
+
+	// New validator; the deposit is only applied if its signature is valid.
+	depositMessage := &spec.DepositMessage{
+		PublicKey:             deposit.Data.PublicKey,
+		WithdrawalCredentials: deposit.Data.WithdrawalCredentials,
+		Amount:                deposit.Data.Amount,
+	}
+	messageRoot, err := objectRoot(depositMessage)
+	if err != nil {
+		return err
+	}
+	// Deposits are valid across forks, so use the genesis fork version and no validators root.
+	domain, err := verify.ComputeDomain(s.depositDomain, s.genesisForkVersion, spec.Root{})
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate domain")
+	}
+	signingRoot, err := verify.ComputeSigningRoot(messageRoot, domain)
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate signing root")
+	}
+	verified, err := verify.VerifySignature(deposit.Data.PublicKey, signingRoot, deposit.Data.Signature)
+	if err != nil || !verified {
+		s.log.Debug().Str("pubkey", fmt.Sprintf("%#x", deposit.Data.PublicKey)).Msg("Deposit signature invalid; ignoring")
+		return nil
+	}
+
+	amount := uint64(deposit.Data.Amount)
+	effectiveBalance := amount - amount%s.effectiveBalanceIncrement
+	if effectiveBalance > s.maxEffectiveBalance {
+		effectiveBalance = s.maxEffectiveBalance
+	}
+	state.Validators = append(state.Validators, &spec.Validator{
+		PublicKey:                  deposit.Data.PublicKey,
+		WithdrawalCredentials:      deposit.Data.WithdrawalCredentials,
+		EffectiveBalance:           spec.Gwei(effectiveBalance),
+		ActivationEligibilityEpoch: farFutureEpoch,
+		ActivationEpoch:            farFutureEpoch,
+		ExitEpoch:                  farFutureEpoch,
+		WithdrawableEpoch:          farFutureEpoch,
+	})
+	state.Balances = append(state.Balances, amount)
+
+	return nil
+}
+
+// ProcessVoluntaryExit applies a voluntary exit to the state.
+// This is process_voluntary_exit in the Ethereum 2 specification.
+func (s *Service) ProcessVoluntaryExit(state *spec.BeaconState, signedVoluntaryExit *spec.SignedVoluntaryExit) error {
+	voluntaryExit := signedVoluntaryExit.Message
+	if voluntaryExit == nil {
+		return errors.New("missing voluntary exit")
+	}
+	if uint64(voluntaryExit.ValidatorIndex) >= uint64(len(state.Validators)) {
+		return fmt.Errorf("validator index %d unknown", voluntaryExit.ValidatorIndex)
+	}
+	validator := state.Validators[voluntaryExit.ValidatorIndex]
+	currentEpoch := s.currentEpoch(state)
+
+	if !helpers.IsActiveValidator(validator, currentEpoch) {
+		return errors.New("validator is not active")
+	}
+	if validator.ExitEpoch != farFutureEpoch {
+		return errors.New("validator has already initiated exit")
+	}
+	if currentEpoch < voluntaryExit.Epoch {
+		return fmt.Errorf("exit epoch %d is in the future", voluntaryExit.Epoch)
+	}
+	if currentEpoch < validator.ActivationEpoch+spec.Epoch(s.shardCommitteePeriod) {
+		return errors.New("validator has not been active long enough")
+	}
+	if err := s.verifySignature(state, voluntaryExit.ValidatorIndex, voluntaryExit, s.voluntaryExitDomain, voluntaryExit.Epoch, signedVoluntaryExit.Signature); err != nil {
+		return err
+	}
+
+	s.initiateValidatorExit(state, voluntaryExit.ValidatorIndex)
+	return nil
+}
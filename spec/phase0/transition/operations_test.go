@@ -0,0 +1,573 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/attestantio/go-eth2-client/verify"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessProposerSlashing(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	genesis := chain.genesisState()
+
+	signedHeader := func(proposerIndex spec.ValidatorIndex, slot spec.Slot, bodyRoot spec.Root) *spec.SignedBeaconBlockHeader {
+		header := &spec.BeaconBlockHeader{
+			Slot:          slot,
+			ProposerIndex: proposerIndex,
+			BodyRoot:      bodyRoot,
+		}
+		return &spec.SignedBeaconBlockHeader{
+			Message:   header,
+			Signature: chain.signObject(genesis, header, proposerDomain, 0, proposerIndex),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		slashing *spec.ProposerSlashing
+		slashed  bool
+		err      string
+	}{
+		{
+			name:     "HeaderMissing",
+			slashing: &spec.ProposerSlashing{SignedHeader1: signedHeader(5, 0, spec.Root{0x01})},
+			err:      "missing header",
+		},
+		{
+			name: "SlotMismatch",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: signedHeader(5, 0, spec.Root{0x01}),
+				SignedHeader2: signedHeader(5, 1, spec.Root{0x02}),
+			},
+			err: "header slots do not match",
+		},
+		{
+			name: "ProposerMismatch",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: signedHeader(5, 0, spec.Root{0x01}),
+				SignedHeader2: signedHeader(6, 0, spec.Root{0x02}),
+			},
+			err: "header proposer indices do not match",
+		},
+		{
+			name: "SameHeaders",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: signedHeader(5, 0, spec.Root{0x01}),
+				SignedHeader2: signedHeader(5, 0, spec.Root{0x01}),
+			},
+			err: "headers are the same",
+		},
+		{
+			name: "ProposerUnknown",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: &spec.SignedBeaconBlockHeader{Message: &spec.BeaconBlockHeader{ProposerIndex: 100, BodyRoot: spec.Root{0x01}}},
+				SignedHeader2: &spec.SignedBeaconBlockHeader{Message: &spec.BeaconBlockHeader{ProposerIndex: 100, BodyRoot: spec.Root{0x02}}},
+			},
+			err: "proposer index 100 unknown",
+		},
+		{
+			name: "BadSignature",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: signedHeader(5, 0, spec.Root{0x01}),
+				SignedHeader2: &spec.SignedBeaconBlockHeader{
+					Message:   &spec.BeaconBlockHeader{ProposerIndex: 5, BodyRoot: spec.Root{0x02}},
+					Signature: signedHeader(5, 0, spec.Root{0x01}).Signature,
+				},
+			},
+			err: "header 2: invalid signature",
+		},
+		{
+			name: "Good",
+			slashing: &spec.ProposerSlashing{
+				SignedHeader1: signedHeader(5, 0, spec.Root{0x01}),
+				SignedHeader2: signedHeader(5, 0, spec.Root{0x02}),
+			},
+			slashed: true,
+		},
+	}
+
+	s := newService(t, true)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, genesis)
+			err := s.ProcessProposerSlashing(state, test.slashing)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				validator := state.Validators[5]
+				require.Equal(t, test.slashed, validator.Slashed)
+				require.Equal(t, spec.Epoch(5), validator.ExitEpoch)
+				require.Equal(t, spec.Epoch(8192), validator.WithdrawableEpoch)
+				require.Equal(t, uint64(32000000000), state.Slashings[0])
+				// Penalised 1/128 of effective balance.
+				require.Equal(t, uint64(31750000000), state.Balances[5])
+				// Slashing the same validator again fails.
+				require.EqualError(t, s.ProcessProposerSlashing(state, test.slashing), "proposer 5 is not slashable")
+			}
+		})
+	}
+}
+
+func TestProcessAttesterSlashing(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	genesis := chain.genesisState()
+
+	indexedAttestation := func(indices []uint64, blockRoot spec.Root, sourceEpoch spec.Epoch, targetEpoch spec.Epoch) *spec.IndexedAttestation {
+		data := &spec.AttestationData{
+			BeaconBlockRoot: blockRoot,
+			Source:          &spec.Checkpoint{Epoch: sourceEpoch},
+			Target:          &spec.Checkpoint{Epoch: targetEpoch},
+		}
+		validatorIndices := make([]spec.ValidatorIndex, len(indices))
+		for i := range indices {
+			validatorIndices[i] = spec.ValidatorIndex(indices[i])
+		}
+		return &spec.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        chain.signObject(genesis, data, attesterDomain, targetEpoch, validatorIndices...),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		slashing *spec.AttesterSlashing
+		slashed  []spec.ValidatorIndex
+		err      string
+	}{
+		{
+			name:     "AttestationMissing",
+			slashing: &spec.AttesterSlashing{Attestation1: indexedAttestation([]uint64{1}, spec.Root{0x01}, 0, 0)},
+			err:      "missing attestation",
+		},
+		{
+			name: "NotSlashable",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{1, 2}, spec.Root{0x01}, 0, 0),
+				Attestation2: indexedAttestation([]uint64{1, 2}, spec.Root{0x01}, 0, 0),
+			},
+			err: "attestations are not slashable",
+		},
+		{
+			name: "Unsorted",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{2, 1}, spec.Root{0x01}, 0, 0),
+				Attestation2: indexedAttestation([]uint64{1, 2}, spec.Root{0x02}, 0, 0),
+			},
+			err: "attestation 1: attesting indices not sorted and unique",
+		},
+		{
+			name: "BadSignature",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{1, 2}, spec.Root{0x01}, 0, 0),
+				Attestation2: &spec.IndexedAttestation{
+					AttestingIndices: []uint64{1, 2},
+					Data: &spec.AttestationData{
+						BeaconBlockRoot: spec.Root{0x02},
+						Source:          &spec.Checkpoint{},
+						Target:          &spec.Checkpoint{},
+					},
+					Signature: indexedAttestation([]uint64{1, 2}, spec.Root{0x01}, 0, 0).Signature,
+				},
+			},
+			err: "attestation 2: invalid signature",
+		},
+		{
+			name: "NoIntersection",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{1, 2}, spec.Root{0x01}, 0, 0),
+				Attestation2: indexedAttestation([]uint64{3, 4}, spec.Root{0x02}, 0, 0),
+			},
+			err: "no validators slashed",
+		},
+		{
+			name: "DoubleVote",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{1, 2, 3}, spec.Root{0x01}, 0, 0),
+				Attestation2: indexedAttestation([]uint64{2, 3, 4}, spec.Root{0x02}, 0, 0),
+			},
+			slashed: []spec.ValidatorIndex{2, 3},
+		},
+		{
+			name: "SurroundVote",
+			slashing: &spec.AttesterSlashing{
+				Attestation1: indexedAttestation([]uint64{1, 2, 3}, spec.Root{0x01}, 0, 3),
+				Attestation2: indexedAttestation([]uint64{3, 4}, spec.Root{0x01}, 1, 2),
+			},
+			slashed: []spec.ValidatorIndex{3},
+		},
+	}
+
+	s := newService(t, true)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, genesis)
+			err := s.ProcessAttesterSlashing(state, test.slashing)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				slashed := make([]spec.ValidatorIndex, 0)
+				for i, validator := range state.Validators {
+					if validator.Slashed {
+						slashed = append(slashed, spec.ValidatorIndex(i))
+					}
+				}
+				require.Equal(t, test.slashed, slashed)
+			}
+		})
+	}
+}
+
+func TestProcessAttestation(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	state := chain.genesisState()
+	s := newService(t, true)
+	require.NoError(t, s.StateTransition(state, chain.block(state, 1)))
+	require.NoError(t, s.ProcessSlots(state, 2))
+	attestation := chain.attestations(state, 1)[0]
+
+	tests := []struct {
+		name        string
+		attestation func() *spec.Attestation
+		err         string
+	}{
+		{
+			name: "DataMissing",
+			attestation: func() *spec.Attestation {
+				return &spec.Attestation{}
+			},
+			err: "missing attestation data",
+		},
+		{
+			name: "TargetEpochFuture",
+			attestation: func() *spec.Attestation {
+				data := *attestation.Data
+				data.Target = &spec.Checkpoint{Epoch: 1}
+				return &spec.Attestation{AggregationBits: attestation.AggregationBits, Data: &data}
+			},
+			err: "target epoch 1 is neither previous epoch 0 nor current epoch 0",
+		},
+		{
+			name: "TooEarly",
+			attestation: func() *spec.Attestation {
+				return chain.attestations(state, 2)[0]
+			},
+			err: "attestation for slot 2 included too early",
+		},
+		{
+			name: "CommitteeIndexTooHigh",
+			attestation: func() *spec.Attestation {
+				data := *attestation.Data
+				data.Index = 2
+				return &spec.Attestation{AggregationBits: attestation.AggregationBits, Data: &data}
+			},
+			err: "committee index 2 out of range",
+		},
+		{
+			name: "SourceMismatch",
+			attestation: func() *spec.Attestation {
+				data := *attestation.Data
+				data.Source = &spec.Checkpoint{Root: spec.Root{0x01}}
+				return &spec.Attestation{AggregationBits: attestation.AggregationBits, Data: &data}
+			},
+			err: "source does not match current justified checkpoint",
+		},
+		{
+			name: "AggregationBitsLengthMismatch",
+			attestation: func() *spec.Attestation {
+				return &spec.Attestation{AggregationBits: bitfield.NewBitlist(3), Data: attestation.Data}
+			},
+			err: "aggregation bits length 3 does not match committee length 4",
+		},
+		{
+			name: "BadSignature",
+			attestation: func() *spec.Attestation {
+				return &spec.Attestation{AggregationBits: attestation.AggregationBits, Data: attestation.Data, Signature: chain.attestations(state, 1)[1].Signature}
+			},
+			err: "invalid signature",
+		},
+		{
+			name: "Good",
+			attestation: func() *spec.Attestation {
+				return attestation
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, state)
+			pendingAttestations := len(state.CurrentEpochAttestations)
+			err := s.ProcessAttestation(state, test.attestation())
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				// An invalid attestation is not added to the state.
+				require.Len(t, state.CurrentEpochAttestations, pendingAttestations)
+			} else {
+				require.NoError(t, err)
+				pendingAttestation := state.CurrentEpochAttestations[len(state.CurrentEpochAttestations)-1]
+				require.Equal(t, attestation.Data, pendingAttestation.Data)
+				require.Equal(t, spec.Slot(1), pendingAttestation.InclusionDelay)
+			}
+		})
+	}
+}
+
+// depositState returns a copy of the state with a deposit root covering the given deposit data,
+// and the deposit containing the proof.
+func depositState(t *testing.T, state *spec.BeaconState, data *spec.DepositData) (*spec.BeaconState, *spec.Deposit) {
+	state = copyState(t, state)
+
+	// Existing deposits are filled with arbitrary leaves.
+	chunks := make([][32]byte, state.ETH1DepositIndex+1)
+	for i := range chunks {
+		chunks[i][0] = byte(i)
+	}
+	leaf, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	chunks[state.ETH1DepositIndex] = leaf
+	tree, err := merkle.NewTree(chunks, 32)
+	require.NoError(t, err)
+	branch, err := tree.Proof(state.ETH1DepositIndex)
+	require.NoError(t, err)
+
+	var lengthChunk [32]byte
+	binary.LittleEndian.PutUint64(lengthChunk[:], uint64(len(chunks)))
+	treeRoot := tree.Root()
+	state.ETH1Data.DepositRoot = sha256.Sum256(append(treeRoot[:], lengthChunk[:]...))
+	state.ETH1Data.DepositCount = uint64(len(chunks))
+
+	deposit := &spec.Deposit{
+		Proof: make([][]byte, 0, 33),
+		Data:  data,
+	}
+	for i := range branch {
+		deposit.Proof = append(deposit.Proof, append([]byte{}, branch[i][:]...))
+	}
+	deposit.Proof = append(deposit.Proof, lengthChunk[:])
+	return state, deposit
+}
+
+// depositData returns signed deposit data for the given key.
+func depositData(t *testing.T, secret int, amount spec.Gwei) *spec.DepositData {
+	secretKey, pubKey := testKey(secret)
+	message := &spec.DepositMessage{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: filledBytes(32, 0x03),
+		Amount:                amount,
+	}
+	messageRoot, err := message.HashTreeRoot()
+	require.NoError(t, err)
+	domain, err := verify.ComputeDomain(depositDomain, genesisForkVersion, spec.Root{})
+	require.NoError(t, err)
+	signingRoot, err := verify.ComputeSigningRoot(messageRoot, domain)
+	require.NoError(t, err)
+	return &spec.DepositData{
+		PublicKey:             pubKey,
+		WithdrawalCredentials: message.WithdrawalCredentials,
+		Amount:                amount,
+		Signature:             signRoot(signingRoot, secretKey),
+	}
+}
+
+func TestProcessDeposit(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	genesis := chain.genesisState()
+
+	badSignature := depositData(t, 5000, 32000000000)
+	badSignature.Signature = depositData(t, 5001, 32000000000).Signature
+	topUp := depositData(t, 1000, 1000000000)
+	topUp.Signature = spec.BLSSignature{}
+
+	tests := []struct {
+		name       string
+		data       *spec.DepositData
+		deposit    func(*spec.Deposit) *spec.Deposit
+		validators int
+		balance    uint64
+		err        string
+	}{
+		{
+			name: "DataMissing",
+			data: depositData(t, 5000, 32000000000),
+			deposit: func(deposit *spec.Deposit) *spec.Deposit {
+				return &spec.Deposit{Proof: deposit.Proof}
+			},
+			err: "missing deposit data",
+		},
+		{
+			name: "ProofShort",
+			data: depositData(t, 5000, 32000000000),
+			deposit: func(deposit *spec.Deposit) *spec.Deposit {
+				return &spec.Deposit{Proof: deposit.Proof[1:], Data: deposit.Data}
+			},
+			err: "deposit proof has 32 entries; expected 33",
+		},
+		{
+			name: "ProofInvalid",
+			data: depositData(t, 5000, 32000000000),
+			deposit: func(deposit *spec.Deposit) *spec.Deposit {
+				return &spec.Deposit{Proof: deposit.Proof, Data: depositData(t, 5000, 31000000000)}
+			},
+			err: "invalid deposit proof",
+		},
+		{
+			name:       "NewValidator",
+			data:       depositData(t, 5000, 32000000000),
+			validators: 65,
+			balance:    32000000000,
+		},
+		{
+			name:       "NewValidatorBadSignature",
+			data:       badSignature,
+			validators: 64,
+		},
+		{
+			name:       "TopUp",
+			data:       topUp,
+			validators: 64,
+			balance:    33000000000,
+		},
+	}
+
+	s := newService(t, false)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, deposit := depositState(t, genesis, test.data)
+			if test.deposit != nil {
+				deposit = test.deposit(deposit)
+			}
+			err := s.ProcessDeposit(state, deposit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint64(65), state.ETH1DepositIndex)
+				require.Len(t, state.Validators, test.validators)
+				require.Len(t, state.Balances, test.validators)
+				for i, validator := range state.Validators {
+					if validator.PublicKey == test.data.PublicKey {
+						require.Equal(t, test.balance, state.Balances[i])
+						require.Equal(t, spec.Gwei(32000000000), validator.EffectiveBalance)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestProcessVoluntaryExit(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	genesis := chain.genesisState()
+	// Move the state on to the point where validators can exit.
+	genesis.Slot = 65 * 8
+
+	signedExit := func(index spec.ValidatorIndex, epoch spec.Epoch) *spec.SignedVoluntaryExit {
+		exit := &spec.VoluntaryExit{
+			Epoch:          epoch,
+			ValidatorIndex: index,
+		}
+		return &spec.SignedVoluntaryExit{
+			Message:   exit,
+			Signature: chain.signObject(genesis, exit, voluntaryExitDomain, epoch, index),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		state func(*spec.BeaconState)
+		exit  *spec.SignedVoluntaryExit
+		err   string
+	}{
+		{
+			name: "ExitMissing",
+			exit: &spec.SignedVoluntaryExit{},
+			err:  "missing voluntary exit",
+		},
+		{
+			name: "ValidatorUnknown",
+			exit: &spec.SignedVoluntaryExit{Message: &spec.VoluntaryExit{Epoch: 65, ValidatorIndex: 100}},
+			err:  "validator index 100 unknown",
+		},
+		{
+			name: "ValidatorInactive",
+			state: func(state *spec.BeaconState) {
+				state.Validators[5].ActivationEpoch = 100
+			},
+			exit: signedExit(5, 65),
+			err:  "validator is not active",
+		},
+		{
+			name: "AlreadyExiting",
+			state: func(state *spec.BeaconState) {
+				state.Validators[5].ExitEpoch = 70
+			},
+			exit: signedExit(5, 65),
+			err:  "validator has already initiated exit",
+		},
+		{
+			name: "EpochFuture",
+			exit: signedExit(5, 66),
+			err:  "exit epoch 66 is in the future",
+		},
+		{
+			name: "TooYoung",
+			state: func(state *spec.BeaconState) {
+				state.Validators[5].ActivationEpoch = 2
+			},
+			exit: signedExit(5, 65),
+			err:  "validator has not been active long enough",
+		},
+		{
+			name: "BadSignature",
+			exit: &spec.SignedVoluntaryExit{
+				Message:   signedExit(5, 65).Message,
+				Signature: signedExit(6, 65).Signature,
+			},
+			err: "invalid signature",
+		},
+		{
+			name: "Good",
+			exit: signedExit(5, 65),
+		},
+	}
+
+	s := newService(t, true)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, genesis)
+			if test.state != nil {
+				test.state(state)
+			}
+			err := s.ProcessVoluntaryExit(state, test.exit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, spec.Epoch(70), state.Validators[5].ExitEpoch)
+				require.Equal(t, spec.Epoch(326), state.Validators[5].WithdrawableEpoch)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel           zerolog.Level
+	logger             zerolog.Logger
+	specProvider       eth2client.SpecProvider
+	verifySignatures   bool
+	verifyStateRoots   bool
+	shufflingCacheSize int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithSpecProvider sets the provider of the chain specification.
+func WithSpecProvider(provider eth2client.SpecProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.specProvider = provider
+	})
+}
+
+// WithSignatureVerification sets whether signatures in blocks are verified.
+// Deposit signatures are always verified, as their validity alters the resultant state.
+func WithSignatureVerification(verify bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.verifySignatures = verify
+	})
+}
+
+// WithStateRootVerification sets whether the state root in a block is checked against the post-state.
+func WithStateRootVerification(verify bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.verifyStateRoots = verify
+	})
+}
+
+// WithShufflingCacheSize sets the number of shufflings to keep in the cache.
+func WithShufflingCacheSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.shufflingCacheSize = size
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:           zerolog.GlobalLevel(),
+		logger:             zerologger.Logger,
+		verifySignatures:   true,
+		verifyStateRoots:   true,
+		shufflingCacheSize: 8,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.specProvider == nil {
+		return nil, errors.New("no spec provider specified")
+	}
+	if parameters.shufflingCacheSize < 1 {
+		return nil, errors.New("shuffling cache size must be at least 1")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/helpers"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Constants of the Ethereum 2 specification that are not configurable.
+const (
+	farFutureEpoch           = spec.Epoch(0xffffffffffffffff)
+	genesisEpoch             = spec.Epoch(0)
+	baseRewardsPerEpoch      = 4
+	depositContractTreeDepth = 32
+)
+
+// Service applies the phase 0 state transition function to beacon states.
+//
+// All functions operate on the supplied state in place.  If a function returns
+// an error the state may have been partially updated, so callers that need to
+// retain the pre-state, for example to try alternative blocks, should apply
+// the transition to a copy.
+type Service struct {
+	log              zerolog.Logger
+	helpers          *helpers.Service
+	verifySignatures bool
+	verifyStateRoots bool
+
+	slotsPerEpoch                    uint64
+	slotsPerHistoricalRoot           uint64
+	epochsPerHistoricalVector        uint64
+	epochsPerSlashingsVector         uint64
+	epochsPerETH1VotingPeriod        uint64
+	minAttestationInclusionDelay     uint64
+	maxSeedLookahead                 uint64
+	minValidatorWithdrawabilityDelay uint64
+	shardCommitteePeriod             uint64
+	minEpochsToInactivityPenalty     uint64
+	maxEffectiveBalance              uint64
+	effectiveBalanceIncrement        uint64
+	ejectionBalance                  uint64
+	hysteresisQuotient               uint64
+	hysteresisDownwardMultiplier     uint64
+	hysteresisUpwardMultiplier       uint64
+	minPerEpochChurnLimit            uint64
+	churnLimitQuotient               uint64
+	baseRewardFactor                 uint64
+	whistleblowerRewardQuotient      uint64
+	proposerRewardQuotient           uint64
+	inactivityPenaltyQuotient        uint64
+	minSlashingPenaltyQuotient       uint64
+	proportionalSlashingMultiplier   uint64
+	maxDeposits                      uint64
+
+	beaconProposerDomain spec.DomainType
+	beaconAttesterDomain spec.DomainType
+	randaoDomain         spec.DomainType
+	depositDomain        spec.DomainType
+	voluntaryExitDomain  spec.DomainType
+	genesisForkVersion   spec.Version
+}
+
+// New creates a new state transition service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "transition").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	helpersSvc, err := helpers.New(ctx,
+		helpers.WithLogger(parameters.logger),
+		helpers.WithLogLevel(parameters.logLevel),
+		helpers.WithSpecProvider(parameters.specProvider),
+		helpers.WithShufflingCacheSize(parameters.shufflingCacheSize),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create helpers service")
+	}
+
+	chainSpec, err := parameters.specProvider.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	s := &Service{
+		log:              log,
+		helpers:          helpersSvc,
+		verifySignatures: parameters.verifySignatures,
+		verifyStateRoots: parameters.verifyStateRoots,
+	}
+	for k, v := range map[string]*uint64{
+		"SLOTS_PER_EPOCH":                     &s.slotsPerEpoch,
+		"SLOTS_PER_HISTORICAL_ROOT":           &s.slotsPerHistoricalRoot,
+		"EPOCHS_PER_HISTORICAL_VECTOR":        &s.epochsPerHistoricalVector,
+		"EPOCHS_PER_SLASHINGS_VECTOR":         &s.epochsPerSlashingsVector,
+		"EPOCHS_PER_ETH1_VOTING_PERIOD":       &s.epochsPerETH1VotingPeriod,
+		"MIN_ATTESTATION_INCLUSION_DELAY":     &s.minAttestationInclusionDelay,
+		"MAX_SEED_LOOKAHEAD":                  &s.maxSeedLookahead,
+		"MIN_VALIDATOR_WITHDRAWABILITY_DELAY": &s.minValidatorWithdrawabilityDelay,
+		"SHARD_COMMITTEE_PERIOD":              &s.shardCommitteePeriod,
+		"MIN_EPOCHS_TO_INACTIVITY_PENALTY":    &s.minEpochsToInactivityPenalty,
+		"MAX_EFFECTIVE_BALANCE":               &s.maxEffectiveBalance,
+		"EFFECTIVE_BALANCE_INCREMENT":         &s.effectiveBalanceIncrement,
+		"EJECTION_BALANCE":                    &s.ejectionBalance,
+		"HYSTERESIS_QUOTIENT":                 &s.hysteresisQuotient,
+		"HYSTERESIS_DOWNWARD_MULTIPLIER":      &s.hysteresisDownwardMultiplier,
+		"HYSTERESIS_UPWARD_MULTIPLIER":        &s.hysteresisUpwardMultiplier,
+		"MIN_PER_EPOCH_CHURN_LIMIT":           &s.minPerEpochChurnLimit,
+		"CHURN_LIMIT_QUOTIENT":                &s.churnLimitQuotient,
+		"BASE_REWARD_FACTOR":                  &s.baseRewardFactor,
+		"WHISTLEBLOWER_REWARD_QUOTIENT":       &s.whistleblowerRewardQuotient,
+		"PROPOSER_REWARD_QUOTIENT":            &s.proposerRewardQuotient,
+		"INACTIVITY_PENALTY_QUOTIENT":         &s.inactivityPenaltyQuotient,
+		"MIN_SLASHING_PENALTY_QUOTIENT":       &s.minSlashingPenaltyQuotient,
+		"PROPORTIONAL_SLASHING_MULTIPLIER":    &s.proportionalSlashingMultiplier,
+		"MAX_DEPOSITS":                        &s.maxDeposits,
+	} {
+		if *v, err = api.SpecUint64(chainSpec, k); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range map[string]*spec.DomainType{
+		"DOMAIN_BEACON_PROPOSER": &s.beaconProposerDomain,
+		"DOMAIN_BEACON_ATTESTER": &s.beaconAttesterDomain,
+		"DOMAIN_RANDAO":          &s.randaoDomain,
+		"DOMAIN_DEPOSIT":         &s.depositDomain,
+		"DOMAIN_VOLUNTARY_EXIT":  &s.voluntaryExitDomain,
+	} {
+		if *v, err = api.SpecDomainType(chainSpec, k); err != nil {
+			return nil, err
+		}
+	}
+	if s.genesisForkVersion, err = api.SpecVersion(chainSpec, "GENESIS_FORK_VERSION"); err != nil {
+		return nil, err
+	}
+
+	for k, v := range map[string]uint64{
+		"SLOTS_PER_EPOCH":               s.slotsPerEpoch,
+		"EFFECTIVE_BALANCE_INCREMENT":   s.effectiveBalanceIncrement,
+		"HYSTERESIS_QUOTIENT":           s.hysteresisQuotient,
+		"CHURN_LIMIT_QUOTIENT":          s.churnLimitQuotient,
+		"PROPOSER_REWARD_QUOTIENT":      s.proposerRewardQuotient,
+		"INACTIVITY_PENALTY_QUOTIENT":   s.inactivityPenaltyQuotient,
+		"EPOCHS_PER_SLASHINGS_VECTOR":   s.epochsPerSlashingsVector,
+		"EPOCHS_PER_HISTORICAL_VECTOR":  s.epochsPerHistoricalVector,
+		"EPOCHS_PER_ETH1_VOTING_PERIOD": s.epochsPerETH1VotingPeriod,
+		"WHISTLEBLOWER_REWARD_QUOTIENT": s.whistleblowerRewardQuotient,
+		"MIN_SLASHING_PENALTY_QUOTIENT": s.minSlashingPenaltyQuotient,
+	} {
+		if v == 0 {
+			return nil, fmt.Errorf("%s cannot be 0", k)
+		}
+	}
+	if bits.OnesCount64(s.slotsPerHistoricalRoot) != 1 {
+		return nil, errors.New("SLOTS_PER_HISTORICAL_ROOT must be a power of 2")
+	}
+	if s.slotsPerHistoricalRoot%s.slotsPerEpoch != 0 {
+		return nil, errors.New("SLOTS_PER_HISTORICAL_ROOT must be a multiple of SLOTS_PER_EPOCH")
+	}
+
+	return s, nil
+}
@@ -0,0 +1,141 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0/transition"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	withSpec := func(key string, value interface{}) testSpec {
+		res := newTestSpec()
+		if value == nil {
+			delete(res, key)
+		} else {
+			res[key] = value
+		}
+		return res
+	}
+
+	tests := []struct {
+		name   string
+		params []transition.Parameter
+		err    string
+	}{
+		{
+			name: "SpecProviderMissing",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no spec provider specified",
+		},
+		{
+			name: "ShufflingCacheSizeZero",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(newTestSpec()),
+				transition.WithShufflingCacheSize(0),
+			},
+			err: "problem with parameters: shuffling cache size must be at least 1",
+		},
+		{
+			name: "SlotsPerEpochMissing",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("SLOTS_PER_EPOCH", nil)),
+			},
+			err: "failed to create helpers service: SLOTS_PER_EPOCH not found in spec",
+		},
+		{
+			name: "MaxDepositsMissing",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("MAX_DEPOSITS", nil)),
+			},
+			err: "MAX_DEPOSITS not found in spec",
+		},
+		{
+			name: "MaxDepositsInvalid",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("MAX_DEPOSITS", "sixteen")),
+			},
+			err: "invalid value for MAX_DEPOSITS",
+		},
+		{
+			name: "ChurnLimitQuotientZero",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("CHURN_LIMIT_QUOTIENT", uint64(0))),
+			},
+			err: "CHURN_LIMIT_QUOTIENT cannot be 0",
+		},
+		{
+			name: "SlotsPerHistoricalRootNotPowerOf2",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("SLOTS_PER_HISTORICAL_ROOT", uint64(8000))),
+			},
+			err: "SLOTS_PER_HISTORICAL_ROOT must be a power of 2",
+		},
+		{
+			name: "DomainRANDAOMissing",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("DOMAIN_RANDAO", nil)),
+			},
+			err: "DOMAIN_RANDAO not found in spec",
+		},
+		{
+			name: "GenesisForkVersionInvalid",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("GENESIS_FORK_VERSION", []byte{0x00, 0x01})),
+			},
+			err: "invalid value for GENESIS_FORK_VERSION",
+		},
+		{
+			name: "Good",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(newTestSpec()),
+			},
+		},
+		{
+			name: "DelayAsDuration",
+			params: []transition.Parameter{
+				transition.WithLogLevel(zerolog.Disabled),
+				transition.WithSpecProvider(withSpec("MIN_VALIDATOR_WITHDRAWABILITY_DELAY", 256*time.Second)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := transition.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition
+
+import (
+	"fmt"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/spec/phase0/merkle"
+	"github.com/pkg/errors"
+)
+
+// StateTransition applies a signed block to the state, processing any empty slots before the block.
+// If signature verification is enabled the proposer's signature is verified, and if state root
+// verification is enabled the state root in the block is checked against the resultant state.
+// This is state_transition in the Ethereum 2 specification.
+// The state is updated in place, so if an error is returned the state may have been partially
+// transitioned and should be discarded.
+func (s *Service) StateTransition(state *spec.BeaconState, signedBlock *spec.SignedBeaconBlock) error {
+	if signedBlock == nil || signedBlock.Message == nil {
+		return errors.New("no block supplied")
+	}
+	block := signedBlock.Message
+
+	if err := s.ProcessSlots(state, block.Slot); err != nil {
+		return err
+	}
+
+	if uint64(block.ProposerIndex) >= uint64(len(state.Validators)) {
+		return fmt.Errorf("proposer index %d unknown", block.ProposerIndex)
+	}
+	if err := s.verifySignature(state, block.ProposerIndex, block, s.beaconProposerDomain, s.currentEpoch(state), signedBlock.Signature); err != nil {
+		return errors.Wrap(err, "invalid block signature")
+	}
+
+	if err := s.ProcessBlock(state, block); err != nil {
+		return err
+	}
+
+	if s.verifyStateRoots {
+		stateRoot, err := state.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate state root")
+		}
+		if stateRoot != block.StateRoot {
+			return fmt.Errorf("block state root %#x does not match calculated state root %#x", block.StateRoot, stateRoot)
+		}
+	}
+
+	return nil
+}
+
+// ProcessSlots advances the state to the given slot, processing epoch transitions as they occur.
+// This is process_slots in the Ethereum 2 specification.
+// The state is updated in place, so if an error is returned the state may have been partially
+// advanced and should be discarded.
+func (s *Service) ProcessSlots(state *spec.BeaconState, slot spec.Slot) error {
+	if state == nil {
+		return errors.New("no state supplied")
+	}
+	if state.Slot >= uint64(slot) {
+		return fmt.Errorf("state at slot %d cannot be advanced to slot %d", state.Slot, slot)
+	}
+
+	// The state root is required every slot.  Slot processing alters only single
+	// entries of the root vectors alongside small fields, so a cached tree avoids
+	// rehashing the entire state each slot.  Epoch processing alters too much of the
+	// state to track, so the tree is rebuilt after it.
+	var tree *merkle.BeaconStateTree
+	for state.Slot < uint64(slot) {
+		if tree == nil {
+			var err error
+			tree, err = merkle.NewBeaconStateTree(state)
+			if err != nil {
+				return errors.Wrap(err, "failed to build state tree")
+			}
+		}
+		if err := s.processSlot(state, tree); err != nil {
+			return err
+		}
+		if (state.Slot+1)%s.slotsPerEpoch == 0 {
+			if err := s.ProcessEpoch(state); err != nil {
+				return errors.Wrapf(err, "failed to process epoch at slot %d", state.Slot)
+			}
+			tree = nil
+		}
+		state.Slot++
+	}
+
+	return nil
+}
+
+// processSlot caches the state and block roots of the previous slot.
+// The tree must reflect the state; it is kept up to date with the changes made here.
+// This is process_slot in the Ethereum 2 specification.
+func (s *Service) processSlot(state *spec.BeaconState, tree *merkle.BeaconStateTree) error {
+	previousStateRoot, err := tree.Root()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate state root")
+	}
+	index := state.Slot % s.slotsPerHistoricalRoot
+	state.StateRoots[index] = previousStateRoot[:]
+	if err := tree.MarkEntryDirty(merkle.BeaconStateStateRoots, index); err != nil {
+		return err
+	}
+
+	if state.LatestBlockHeader.StateRoot == (spec.Root{}) {
+		state.LatestBlockHeader.StateRoot = previousStateRoot
+	}
+
+	previousBlockRoot, err := state.LatestBlockHeader.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate block root")
+	}
+	state.BlockRoots[index] = previousBlockRoot[:]
+
+	return tree.MarkEntryDirty(merkle.BeaconStateBlockRoots, index)
+}
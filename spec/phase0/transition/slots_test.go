@@ -0,0 +1,228 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transition_test
+
+import (
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateTransition(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	genesis := chain.genesisState()
+	block1 := chain.block(genesis, 1)
+
+	tests := []struct {
+		name  string
+		block func() *spec.SignedBeaconBlock
+		err   string
+	}{
+		{
+			name: "Nil",
+			block: func() *spec.SignedBeaconBlock {
+				return nil
+			},
+			err: "no block supplied",
+		},
+		{
+			name: "Good",
+			block: func() *spec.SignedBeaconBlock {
+				return block1
+			},
+		},
+		{
+			name: "BadSignature",
+			block: func() *spec.SignedBeaconBlock {
+				block := *block1
+				block.Signature = chain.signObjectRoot(genesis, spec.Root{0x01}, proposerDomain, 0, block1.Message.ProposerIndex)
+				return &block
+			},
+			err: "invalid block signature: invalid signature",
+		},
+		{
+			name: "BadStateRoot",
+			block: func() *spec.SignedBeaconBlock {
+				message := *block1.Message
+				message.StateRoot = spec.Root{0x01}
+				return &spec.SignedBeaconBlock{
+					Message:   &message,
+					Signature: chain.signObject(genesis, &message, proposerDomain, 0, message.ProposerIndex),
+				}
+			},
+			err: "does not match calculated state root",
+		},
+		{
+			name: "BadRANDAOReveal",
+			block: func() *spec.SignedBeaconBlock {
+				body := *block1.Message.Body
+				body.RANDAOReveal = block1.Signature
+				message := *block1.Message
+				message.Body = &body
+				return &spec.SignedBeaconBlock{
+					Message:   &message,
+					Signature: chain.signObject(genesis, &message, proposerDomain, 0, message.ProposerIndex),
+				}
+			},
+			err: "failed to process RANDAO: invalid RANDAO reveal: invalid signature",
+		},
+		{
+			name: "WrongProposer",
+			block: func() *spec.SignedBeaconBlock {
+				message := *block1.Message
+				message.ProposerIndex = (message.ProposerIndex + 1) % 64
+				return &spec.SignedBeaconBlock{
+					Message:   &message,
+					Signature: chain.signObject(genesis, &message, proposerDomain, 0, message.ProposerIndex),
+				}
+			},
+			err: "does not match expected proposer index",
+		},
+	}
+
+	s := newService(t, true)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := copyState(t, genesis)
+			err := s.StateTransition(state, test.block())
+			if test.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), state.Slot)
+			}
+		})
+	}
+}
+
+func TestStateTransitionAttestations(t *testing.T) {
+	chain := newTestChain(t, 64, true)
+	state := chain.genesisState()
+	s := newService(t, true)
+
+	for slot := spec.Slot(1); slot <= 2; slot++ {
+		require.NoError(t, s.StateTransition(state, chain.block(state, slot)))
+	}
+	// Each block contains an attestation from each committee at the previous slot.
+	require.Len(t, state.CurrentEpochAttestations, 4)
+	for i, attestation := range state.CurrentEpochAttestations {
+		require.Equal(t, spec.Slot(i/2), attestation.Data.Slot)
+		require.Equal(t, spec.Slot(1), attestation.InclusionDelay)
+	}
+}
+
+func TestStateTransitionFinality(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	state := chain.genesisState()
+	s := newService(t, false)
+
+	totalBalance := func() uint64 {
+		total := uint64(0)
+		for _, balance := range state.Balances {
+			total += balance
+		}
+		return total
+	}
+	genesisBalance := totalBalance()
+
+	// Four epochs of blocks with full participation; epochs 1 to 3 are justified.
+	for slot := spec.Slot(1); slot <= 32; slot++ {
+		require.NoError(t, s.StateTransition(state, chain.block(state, slot)))
+	}
+
+	require.Equal(t, spec.Epoch(2), state.PreviousJustifiedCheckpoint.Epoch)
+	require.Equal(t, spec.Epoch(3), state.CurrentJustifiedCheckpoint.Epoch)
+	require.Equal(t, spec.Epoch(2), state.FinalizedCheckpoint.Epoch)
+	require.Equal(t, state.BlockRoots[16], state.FinalizedCheckpoint.Root[:])
+	require.Equal(t, []byte{0x07}, []byte(state.JustificationBits))
+	require.Greater(t, totalBalance(), genesisBalance)
+}
+
+func TestProcessSlots(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	genesis := chain.genesisState()
+	s := newService(t, false)
+
+	tests := []struct {
+		name  string
+		state *spec.BeaconState
+		slot  spec.Slot
+		err   string
+	}{
+		{
+			name: "Nil",
+			slot: 1,
+			err:  "no state supplied",
+		},
+		{
+			name:  "SameSlot",
+			state: copyState(t, genesis),
+			slot:  0,
+			err:   "state at slot 0 cannot be advanced to slot 0",
+		},
+		{
+			name:  "Good",
+			state: copyState(t, genesis),
+			slot:  3,
+		},
+		{
+			name:  "EpochBoundary",
+			state: copyState(t, genesis),
+			slot:  9,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := s.ProcessSlots(test.state, test.slot)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, uint64(test.slot), test.state.Slot)
+				// The genesis state root is cached.
+				genesisRoot, err := genesis.HashTreeRoot()
+				require.NoError(t, err)
+				require.Equal(t, genesisRoot[:], test.state.StateRoots[0])
+				require.Equal(t, spec.Root(genesisRoot), test.state.LatestBlockHeader.StateRoot)
+			}
+		})
+	}
+}
+
+func TestProcessSlotsCachedRoots(t *testing.T) {
+	chain := newTestChain(t, 64, false)
+	genesis := chain.genesisState()
+	s := newService(t, false)
+
+	// Advancing in a single call uses a cached tree across slots; advancing one slot
+	// at a time rehashes the state in full each slot.  The results must match.
+	cached := copyState(t, genesis)
+	require.NoError(t, s.ProcessSlots(cached, 11))
+
+	stepped := copyState(t, genesis)
+	for slot := spec.Slot(1); slot <= 11; slot++ {
+		require.NoError(t, s.ProcessSlots(stepped, slot))
+	}
+
+	require.Equal(t, stepped.StateRoots[:11], cached.StateRoots[:11])
+	require.Equal(t, stepped.BlockRoots[:11], cached.BlockRoots[:11])
+	cachedRoot, err := cached.HashTreeRoot()
+	require.NoError(t, err)
+	steppedRoot, err := stepped.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, steppedRoot, cachedRoot)
+}
@@ -21,5 +21,8 @@ import (
 
 // AggregateAndProofDomain provides the aggregate and proof domain of the chain.
 func (s *Service) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "AggregateAndProofDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_AGGREGATE_AND_PROOF"].(spec.DomainType), nil
 }
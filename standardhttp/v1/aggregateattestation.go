@@ -21,6 +21,7 @@ import (
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type aggregateAttestationDataJSON struct {
@@ -30,6 +31,9 @@ type aggregateAttestationDataJSON struct {
 // AggregateAttestation fetches the aggregate attestation given an attestation.
 // N.B if an aggregate attestation for the attestation is not available this will return nil without an error.
 func (s *Service) AggregateAttestation(ctx context.Context, slot spec.Slot, attestationDataRoot spec.Root) (*spec.Attestation, error) {
+	ctx, span := s.startSpan(ctx, "AggregateAttestation", attribute.Int64("slot", int64(slot)))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/aggregate_attestation?slot=%d&attestation_data_root=%#x", slot, attestationDataRoot))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request aggregate attestation")
@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type attestationDataJSON struct {
@@ -28,12 +30,18 @@ type attestationDataJSON struct {
 
 // AttestationData obtains attestation data for a slot.
 func (s *Service) AttestationData(ctx context.Context, slot spec.Slot, committeeIndex spec.CommitteeIndex) (*spec.AttestationData, error) {
+	ctx, span := s.startSpan(ctx, "AttestationData",
+		attribute.Int64("slot", int64(slot)),
+		attribute.Int64("committee_index", int64(committeeIndex)),
+	)
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/attestation_data?slot=%d&committee_index=%d", slot, committeeIndex))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation data")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attestation data")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain attestation data")
 	}
 
 	var attestationDataJSON attestationDataJSON
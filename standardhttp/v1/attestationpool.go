@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type attestationPoolJSON struct {
@@ -28,12 +30,15 @@ type attestationPoolJSON struct {
 
 // AttestationPool obtains the attestation pool for a given slot.
 func (s *Service) AttestationPool(ctx context.Context, slot spec.Slot) ([]*spec.Attestation, error) {
+	ctx, span := s.startSpan(ctx, "AttestationPool", attribute.Int64("slot", int64(slot)))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/pool/attestations?slot=%d", slot))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request attestation pool")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attestation pool")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain attestation pool")
 	}
 
 	var attestationPoolJSON attestationPoolJSON
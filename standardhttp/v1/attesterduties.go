@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type attesterDutiesJSON struct {
@@ -31,6 +33,12 @@ type attesterDutiesJSON struct {
 
 // AttesterDuties obtains attester duties.
 func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.AttesterDuty, error) {
+	ctx, span := s.startSpan(ctx, "AttesterDuties",
+		attribute.Int64("epoch", int64(epoch)),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	// Try a POST request.
 	var reqBodyReader bytes.Buffer
 	if _, err := reqBodyReader.WriteString(`[`); err != nil {
@@ -64,7 +72,7 @@ func (s *Service) AttesterDuties(ctx context.Context, epoch spec.Epoch, validato
 		return nil, errors.Wrap(err, "failed to request attester duties")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain attester duties")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain attester duties")
 	}
 
 	var resp attesterDutiesJSON
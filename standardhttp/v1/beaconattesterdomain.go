@@ -21,5 +21,8 @@ import (
 
 // BeaconAttesterDomain provides the beacon attester domain of the chain.
 func (s *Service) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "BeaconAttesterDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_BEACON_ATTESTER"].(spec.DomainType), nil
 }
@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconBlockHeaderJSON struct {
@@ -28,12 +30,15 @@ type beaconBlockHeaderJSON struct {
 
 // BeaconBlockHeader provides the block header of a given block ID.
 func (s *Service) BeaconBlockHeader(ctx context.Context, blockID string) (*api.BeaconBlockHeader, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockHeader", attribute.String("block_id", blockID))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/headers/%s", blockID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request beacon block header")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon block header")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon block header")
 	}
 
 	var resp beaconBlockHeaderJSON
@@ -19,8 +19,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconBlockProposalJSON struct {
@@ -29,6 +31,9 @@ type beaconBlockProposalJSON struct {
 
 // BeaconBlockProposal fetches a proposed beacon block for signing.
 func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randaoReveal spec.BLSSignature, graffiti []byte) (*spec.BeaconBlock, error) {
+	ctx, span := s.startSpan(ctx, "BeaconBlockProposal", attribute.Int64("slot", int64(slot)))
+	defer span.End()
+
 	// Graffiti should be 32 bytes.
 	fixedGraffiti := make([]byte, 32)
 	copy(fixedGraffiti, graffiti)
@@ -36,11 +41,11 @@ func (s *Service) BeaconBlockProposal(ctx context.Context, slot spec.Slot, randa
 	url := fmt.Sprintf("/eth/v1/validator/blocks/%d?randao_reveal=%#x&graffiti=%#x", slot, randaoReveal, fixedGraffiti)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
 		return nil, errors.Wrap(err, "failed to request beacon block proposal")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon block proposal")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon block proposal")
 	}
 
 	var resp beaconBlockProposalJSON
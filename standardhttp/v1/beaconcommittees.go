@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconCommitteesJSON struct {
@@ -28,14 +30,17 @@ type beaconCommitteesJSON struct {
 
 // BeaconCommittees fetches all beacon committees for the epoch at the given state.
 func (s *Service) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	ctx, span := s.startSpan(ctx, "BeaconCommittees", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/beacon/states/%s/committees", stateID)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
 		return nil, errors.Wrap(err, "failed to request beacon committees")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain beacon committees")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain beacon committees")
 	}
 
 	var resp beaconCommitteesJSON
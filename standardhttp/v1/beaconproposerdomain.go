@@ -21,5 +21,8 @@ import (
 
 // BeaconProposerDomain provides the beacon proposer domain of the chain.
 func (s *Service) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "BeaconProposerDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_BEACON_PROPOSER"].(spec.DomainType), nil
 }
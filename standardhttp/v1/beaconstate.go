@@ -20,6 +20,7 @@ import (
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type beaconStateJSON struct {
@@ -29,10 +30,13 @@ type beaconStateJSON struct {
 // BeaconState fetches a beacon state.
 // N.B if the requested beacon state is not available this will return nil without an error.
 func (s *Service) BeaconState(ctx context.Context, stateID string) (*spec.BeaconState, error) {
+	ctx, span := s.startSpan(ctx, "BeaconState", attribute.String("state_id", stateID))
+	defer span.End()
+
 	url := fmt.Sprintf("/eth/v1/debug/beacon/states/%s", stateID)
 	respBodyReader, err := s.get(ctx, url)
 	if err != nil {
-		log.Trace().Str("url", url).Err(err).Msg("Request failed")
+		s.log.Trace().Str("url", url).Err(err).Msg("Request failed")
 		return nil, errors.Wrap(err, "failed to request beacon state")
 	}
 	if respBodyReader == nil {
@@ -0,0 +1,66 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// ResolveBlockID resolves a block ID to the slot, epoch and root of the block.
+func (s *Service) ResolveBlockID(ctx context.Context, blockID api.BlockID) (*api.ResolvedBlockID, error) {
+	ctx, span := s.startSpan(ctx, "ResolveBlockID", attribute.String("block_id", blockID.String()))
+	defer span.End()
+
+	if !blockID.IsValid() {
+		return nil, errors.New("no block ID specified")
+	}
+
+	headerID := blockID.String()
+	if blockID.IsJustified() {
+		// The standard API does not support justified block IDs, so use the justified checkpoint root.
+		finality, err := s.Finality(ctx, "head")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain finality")
+		}
+		if finality.Justified == nil {
+			return nil, errors.New("justified checkpoint not available")
+		}
+		headerID = fmt.Sprintf("%#x", finality.Justified.Root)
+	}
+
+	header, err := s.BeaconBlockHeader(ctx, headerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block header")
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return nil, errors.Wrapf(client.ErrNotFound, "block %s not available", blockID)
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	return &api.ResolvedBlockID{
+		Slot:  header.Header.Message.Slot,
+		Epoch: spec.Epoch(uint64(header.Header.Message.Slot) / slotsPerEpoch),
+		Root:  header.Root,
+	}, nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/pkg/errors"
+)
+
+// ChainSpec provides the typed spec information of the chain.
+func (s *Service) ChainSpec(ctx context.Context) (*api.ChainSpec, error) {
+	ctx, span := s.startSpan(ctx, "ChainSpec")
+	defer span.End()
+
+	values, err := s.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain spec")
+	}
+
+	chainSpec, err := api.NewChainSpec(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse spec")
+	}
+
+	return chainSpec, nil
+}
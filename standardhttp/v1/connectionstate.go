@@ -0,0 +1,91 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+
+	client "github.com/attestantio/go-eth2-client"
+)
+
+// ConnectionState provides the state of the connection to the beacon node.
+// The connection is degraded if the node is returning server errors or an event stream is not connected.
+func (s *Service) ConnectionState() client.ConnectionState {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	switch {
+	case s.ctx.Err() != nil:
+		return client.ConnectionStateDisconnected
+	case s.nodeState == client.ConnectionStateConnected && s.disconnectedStreams > 0:
+		return client.ConnectionStateDegraded
+	default:
+		return s.nodeState
+	}
+}
+
+// requestCompleted updates the state of the connection from the result of a request to the beacon node.
+// A status code of 0 means that there was no response.
+func (s *Service) requestCompleted(ctx context.Context, statusCode int) {
+	if statusCode == 0 && ctx.Err() != nil {
+		// The request was cancelled by the caller, which says nothing about the node.
+		return
+	}
+	state := client.ConnectionStateConnected
+	switch {
+	case statusCode == 0:
+		state = client.ConnectionStateDisconnected
+	case statusCode >= 500:
+		state = client.ConnectionStateDegraded
+	}
+
+	s.stateMu.Lock()
+	s.nodeState = state
+	s.connectionStateChanged()
+	s.stateMu.Unlock()
+}
+
+// disconnectedStreamsChanged updates the state of the connection as event streams connect and disconnect.
+func (s *Service) disconnectedStreamsChanged(delta int) {
+	s.stateMu.Lock()
+	s.disconnectedStreams += delta
+	s.connectionStateChanged()
+	s.stateMu.Unlock()
+}
+
+// connectionStateChanged signals that the state of the connection may have changed.  stateMu must be held.
+func (s *Service) connectionStateChanged() {
+	if s.connectionStateCh == nil || s.closed {
+		return
+	}
+	select {
+	case s.connectionStateCh <- struct{}{}:
+	default:
+		// A signal is already pending.
+	}
+}
+
+// notifyConnectionState calls the handler when the state of the connection changes, until the service is closed.
+// Changes that happen while the handler is running are coalesced, so the handler always sees the latest state.
+func (s *Service) notifyConnectionState(handler client.ConnectionStateHandlerFunc) {
+	notified := client.ConnectionStateDisconnected
+	for range s.connectionStateCh {
+		if state := s.ConnectionState(); state != notified {
+			handler(state)
+			notified = state
+		}
+	}
+	if notified != client.ConnectionStateDisconnected {
+		handler(client.ConnectionStateDisconnected)
+	}
+}
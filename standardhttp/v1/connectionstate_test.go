@@ -0,0 +1,131 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/fakenode"
+	"github.com/attestantio/go-eth2-client/mock"
+	v1 "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// nextState waits for the next connection state passed to a handler.
+func nextState(t *testing.T, states <-chan client.ConnectionState) client.ConnectionState {
+	select {
+	case state := <-states:
+		return state
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no connection state received")
+		return client.ConnectionStateDisconnected
+	}
+}
+
+func TestConnectionState(t *testing.T) {
+	ctx := context.Background()
+
+	mockService, err := mock.New(ctx, mock.WithGenesisTime(time.Now().Add(-20*time.Minute)))
+	require.NoError(t, err)
+	server, err := fakenode.New(ctx, fakenode.WithService(mockService))
+	require.NoError(t, err)
+	defer server.Close()
+
+	states := make(chan client.ConnectionState, 16)
+	service, err := v1.New(ctx,
+		v1.WithAddress(server.Address()),
+		v1.WithTimeout(time.Second),
+		v1.WithConnectionStateHandler(func(state client.ConnectionState) {
+			states <- state
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+	require.Equal(t, client.ConnectionStateConnected, service.ConnectionState())
+
+	// Server errors degrade the connection.
+	server.InjectFault("/eth/v1/beacon/states", fakenode.Syncing())
+	_, err = service.Fork(ctx, "head")
+	require.Error(t, err)
+	require.Equal(t, client.ConnectionStateDegraded, nextState(t, states))
+	require.Equal(t, client.ConnectionStateDegraded, service.ConnectionState())
+
+	server.ClearFaults()
+	_, err = service.Fork(ctx, "head")
+	require.NoError(t, err)
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+
+	// Cancelled requests do not alter the state.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = service.Fork(cancelledCtx, "head")
+	require.Error(t, err)
+	require.Equal(t, client.ConnectionStateConnected, service.ConnectionState())
+
+	// An unreachable node disconnects the connection.
+	server.Close()
+	_, err = service.Fork(ctx, "head")
+	require.Error(t, err)
+	require.Equal(t, client.ConnectionStateDisconnected, nextState(t, states))
+
+	// Closing the service does not notify the handler again, as the connection is already disconnected.
+	require.NoError(t, service.Close(ctx))
+	require.Equal(t, client.ConnectionStateDisconnected, service.ConnectionState())
+	require.Len(t, states, 0)
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	states := make(chan client.ConnectionState, 16)
+	service, err := v1.New(ctx,
+		v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		v1.WithTimeout(timeout),
+		v1.WithConnectionStateHandler(func(state client.ConnectionState) {
+			states <- state
+		}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+
+	// The connection is degraded until the events stream connects.
+	require.NoError(t, service.Events(ctx, []string{"head"}, func(*api.Event) {}))
+	require.Equal(t, client.ConnectionStateDegraded, service.ConnectionState())
+	require.Equal(t, client.ConnectionStateDegraded, nextState(t, states))
+	require.Equal(t, client.ConnectionStateConnected, nextState(t, states))
+
+	// The events stream keeps the service running.
+	waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	require.Error(t, service.Wait(waitCtx))
+
+	closeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	require.NoError(t, service.Close(closeCtx))
+	require.Equal(t, client.ConnectionStateDisconnected, service.ConnectionState())
+	require.Equal(t, client.ConnectionStateDisconnected, nextState(t, states))
+
+	// The service cannot be used once closed.
+	_, err = service.Fork(ctx, "head")
+	require.ErrorIs(t, err, client.ErrClosed)
+	require.ErrorIs(t, service.Events(ctx, []string{"head"}, func(*api.Event) {}), client.ErrClosed)
+
+	// Closing again is harmless.
+	require.NoError(t, service.Close(closeCtx))
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
@@ -27,7 +28,11 @@ type depositContractJSON struct {
 
 // DepositContract provides details of the Ethereum 1 deposit contract for the chain.
 func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, error) {
+	ctx, span := s.startSpan(ctx, "DepositContract")
+	defer span.End()
+
 	if s.depositContract != nil {
+		s.monitorCache("deposit_contract", true)
 		return s.depositContract, nil
 	}
 
@@ -35,8 +40,10 @@ func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, er
 	defer s.depositContractMutex.Unlock()
 	if s.depositContract != nil {
 		// Someone else fetched this whilst we were waiting for the lock.
+		s.monitorCache("deposit_contract", true)
 		return s.depositContract, nil
 	}
+	s.monitorCache("deposit_contract", false)
 
 	// Up to us to fetch the information.
 	respBodyReader, err := s.get(ctx, "/eth/v1/config/deposit_contract")
@@ -44,7 +51,7 @@ func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, er
 		return nil, errors.Wrap(err, "failed to request deposit contract")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain deposit contract")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain deposit contract")
 	}
 
 	var resp depositContractJSON
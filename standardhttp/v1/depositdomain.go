@@ -21,5 +21,8 @@ import (
 
 // DepositDomain provides the deposit domain of the chain.
 func (s *Service) DepositDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "DepositDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_DEPOSIT"].(spec.DomainType), nil
 }
@@ -28,10 +28,15 @@ import (
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/r3labs/sse/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 // Events feeds requested events with the given topics to the supplied handler.
 func (s *Service) Events(ctx context.Context, topics []string, handler client.EventHandlerFunc) error {
+	ctx, span := s.startSpan(ctx, "Events", attribute.StringSlice("topics", topics))
+	defer span.End()
+
 	if len(topics) == 0 {
 		return errors.New("no topics supplied")
 	}
@@ -48,33 +53,79 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 		return errors.Wrap(err, "invalid endpoint")
 	}
 	url := s.base.ResolveReference(reference).String()
-	log.Trace().Str("url", url).Msg("GET request to events stream")
+	s.log.Trace().Str("url", url).Msg("GET request to events stream")
 
-	client := sse.NewClient(url)
-	client.Connection.Transport = &http.Transport{
+	sseClient := sse.NewClient(url)
+	headers := http.Header{}
+	propagator.Inject(ctx, propagation.HeaderCarrier(headers))
+	for key := range headers {
+		sseClient.Headers[key] = headers.Get(key)
+	}
+	var transport http.RoundTripper = &http.Transport{
 		Dial: (&net.Dialer{
 			Timeout:   2 * time.Second,
 			KeepAlive: 2 * time.Second,
 		}).Dial,
 	}
+	if s.recorder != nil {
+		transport = s.recorder.RoundTripper(transport)
+	}
+	sseClient.Connection.Transport = transport
+	// The stream counts as disconnected until it first connects.
+	// The state is only altered from the stream's goroutine, as the client calls the functions below from there.
+	connected := false
+	setConnected := func(state bool) {
+		s.monitorStream(state)
+		if state == connected {
+			return
+		}
+		connected = state
+		if connected {
+			s.disconnectedStreamsChanged(-1)
+		} else {
+			s.disconnectedStreamsChanged(1)
+		}
+	}
+	sseClient.ResponseValidator = func(c *sse.Client, resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("could not connect to stream: status %d", resp.StatusCode)
+		}
+		setConnected(true)
+		return nil
+	}
+	sseClient.ReconnectNotify = func(err error, _ time.Duration) {
+		setConnected(false)
+	}
 
-	go func() {
+	// The stream stops when either the context is done or the service is closed.
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancelOnClose(ctx, cancel)
+	s.disconnectedStreamsChanged(1)
+	started := s.goBackground(func() {
+		defer cancel()
+		defer s.disconnectedStreamsChanged(-1)
 		for {
 			select {
 			case <-time.After(time.Second):
-				log.Trace().Msg("Connecting to events stream")
-				if err := client.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
+				s.log.Trace().Msg("Connecting to events stream")
+				if err := sseClient.SubscribeRawWithContext(ctx, func(msg *sse.Event) {
 					s.handleEvent(msg, handler)
 				}); err != nil {
-					log.Error().Err(err).Msg("Failed to subscribe to event stream")
+					s.log.Error().Err(err).Msg("Failed to subscribe to event stream")
 				}
-				log.Trace().Msg("Events stream disconnected")
+				setConnected(false)
+				s.log.Trace().Msg("Events stream disconnected")
 			case <-ctx.Done():
-				log.Debug().Msg("Context done")
+				s.log.Debug().Msg("Context done")
 				return
 			}
 		}
-	}()
+	})
+	if !started {
+		cancel()
+		s.disconnectedStreamsChanged(-1)
+		return client.ErrClosed
+	}
 
 	return nil
 }
@@ -82,11 +133,11 @@ func (s *Service) Events(ctx context.Context, topics []string, handler client.Ev
 // handleEvent parses an event and passes it on to the handler.
 func (s *Service) handleEvent(msg *sse.Event, handler client.EventHandlerFunc) {
 	if handler == nil {
-		log.Debug().Msg("No handler supplied; ignoring")
+		s.log.Debug().Msg("No handler supplied; ignoring")
 		return
 	}
 	if msg == nil {
-		log.Debug().Msg("No message supplied; ignoring")
+		s.log.Debug().Msg("No message supplied; ignoring")
 		return
 	}
 
@@ -98,51 +149,52 @@ func (s *Service) handleEvent(msg *sse.Event, handler client.EventHandlerFunc) {
 		headEvent := &api.HeadEvent{}
 		err := json.Unmarshal(msg.Data, headEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse head event")
+			s.log.Error().Err(err).Msg("Failed to parse head event")
 		}
 		event.Data = headEvent
 	case "block":
 		blockEvent := &api.BlockEvent{}
 		err := json.Unmarshal(msg.Data, blockEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse block event")
+			s.log.Error().Err(err).Msg("Failed to parse block event")
 		}
 		event.Data = blockEvent
 	case "attestation":
 		attestation := &spec.Attestation{}
 		err := json.Unmarshal(msg.Data, attestation)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse attestation")
+			s.log.Error().Err(err).Msg("Failed to parse attestation")
 		}
 		event.Data = attestation
 	case "voluntary_exit":
 		voluntaryExit := &spec.SignedVoluntaryExit{}
 		err := json.Unmarshal(msg.Data, voluntaryExit)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse voluntary exit")
+			s.log.Error().Err(err).Msg("Failed to parse voluntary exit")
 		}
 		event.Data = voluntaryExit
 	case "finalized_checkpoint":
 		finalizedCheckpointEvent := &api.FinalizedCheckpointEvent{}
 		err := json.Unmarshal(msg.Data, finalizedCheckpointEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse finalized checkpoint event")
+			s.log.Error().Err(err).Msg("Failed to parse finalized checkpoint event")
 		}
 		event.Data = finalizedCheckpointEvent
 	case "chain_reorg":
 		chainReorgEvent := &api.ChainReorgEvent{}
 		err := json.Unmarshal(msg.Data, chainReorgEvent)
 		if err != nil {
-			log.Error().Err(err).Msg("Failed to parse chain reorg event")
+			s.log.Error().Err(err).Msg("Failed to parse chain reorg event")
 		}
 		event.Data = chainReorgEvent
 	case "":
 		// A message with a blank event comes when the event stream shuts down.  Ignore it.
-		log.Debug().Msg("Received message with blank topic; ignoring")
+		s.log.Debug().Msg("Received message with blank topic; ignoring")
 		return
 	default:
-		log.Warn().Str("topic", string(msg.Event)).Msg("Received message with unhandled topic; ignoring")
+		s.log.Warn().Str("topic", string(msg.Event)).Msg("Received message with unhandled topic; ignoring")
 		return
 	}
+	s.monitorEvent(event.Topic)
 	handler(event)
 }
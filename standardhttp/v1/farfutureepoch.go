@@ -21,5 +21,8 @@ import (
 
 // FarFutureEpoch provides the values for FAR_FUTURE_EOPCH of the chain.
 func (s *Service) FarFutureEpoch(ctx context.Context) (spec.Epoch, error) {
+	ctx, span := s.startSpan(ctx, "FarFutureEpoch")
+	defer span.End()
+
 	return spec.Epoch(0xffffffffffffffff), nil
 }
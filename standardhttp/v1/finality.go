@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type finalityJSON struct {
@@ -28,6 +30,9 @@ type finalityJSON struct {
 
 // Finality provides the finality given a state ID.
 func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality, error) {
+	ctx, span := s.startSpan(ctx, "Finality", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -37,7 +42,7 @@ func (s *Service) Finality(ctx context.Context, stateID string) (*api.Finality,
 		return nil, errors.Wrap(err, "failed to request finality checkpoints")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain finality checkpoints")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain finality checkpoints")
 	}
 
 	var finalityJSON finalityJSON
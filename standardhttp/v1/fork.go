@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type forkJSON struct {
@@ -28,6 +30,9 @@ type forkJSON struct {
 
 // Fork fetches fork information for the given state.
 func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error) {
+	ctx, span := s.startSpan(ctx, "Fork", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -37,7 +42,7 @@ func (s *Service) Fork(ctx context.Context, stateID string) (*spec.Fork, error)
 		return nil, errors.Wrap(err, "failed to request fork")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain fork")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain fork")
 	}
 
 	var forkJSON forkJSON
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	client "github.com/attestantio/go-eth2-client"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 )
@@ -27,7 +28,11 @@ type forkScheduleJSON struct {
 
 // ForkSchedule provides details of past and future changes in the chain's fork version.
 func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
+	ctx, span := s.startSpan(ctx, "ForkSchedule")
+	defer span.End()
+
 	if s.forkSchedule != nil {
+		s.monitorCache("fork_schedule", true)
 		return s.forkSchedule, nil
 	}
 
@@ -35,8 +40,10 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
 	defer s.forkScheduleMutex.Unlock()
 	if s.forkSchedule != nil {
 		// Someone else fetched this whilst we were waiting for the lock.
+		s.monitorCache("fork_schedule", true)
 		return s.forkSchedule, nil
 	}
+	s.monitorCache("fork_schedule", false)
 
 	// Up to us to fetch the information.
 	respBodyReader, err := s.get(ctx, "/eth/v1/config/fork_schedule")
@@ -44,7 +51,7 @@ func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
 		return nil, errors.Wrap(err, "failed to request fork schedule")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain fork schedule")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain fork schedule")
 	}
 
 	var resp forkScheduleJSON
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
@@ -27,7 +28,11 @@ type genesisJSON struct {
 
 // Genesis provides the genesis information of the chain.
 func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
+	ctx, span := s.startSpan(ctx, "Genesis")
+	defer span.End()
+
 	if s.genesis != nil {
+		s.monitorCache("genesis", true)
 		return s.genesis, nil
 	}
 
@@ -35,8 +40,10 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 	defer s.genesisMutex.Unlock()
 	if s.genesis != nil {
 		// Someone else fetched this whilst we were waiting for the lock.
+		s.monitorCache("genesis", true)
 		return s.genesis, nil
 	}
+	s.monitorCache("genesis", false)
 
 	// Up to us to fetch the information.
 	respBodyReader, err := s.get(ctx, "/eth/v1/beacon/genesis")
@@ -44,7 +51,7 @@ func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
 		return nil, errors.Wrap(err, "failed to request genesis")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain genesis")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain genesis")
 	}
 
 	var resp genesisJSON
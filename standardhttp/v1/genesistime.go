@@ -22,6 +22,9 @@ import (
 
 // GenesisTime provides the genesis time of the chain.
 func (s *Service) GenesisTime(ctx context.Context) (time.Time, error) {
+	ctx, span := s.startSpan(ctx, "GenesisTime")
+	defer span.End()
+
 	genesis, err := s.Genesis(ctx)
 	if err != nil {
 		return time.Now(), errors.Wrap(err, "failed to obtain genesis")
@@ -25,7 +25,9 @@ import (
 	"strings"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func init() {
@@ -37,28 +39,51 @@ func init() {
 
 // get sends an HTTP get request and returns the body.
 // If the response from the server is a 404 this will return nil for both the reader and the error.
-func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
+func (s *Service) get(ctx context.Context, endpoint string) (res io.Reader, err error) {
+	ctx, span := s.startRequestSpan(ctx, http.MethodGet, endpoint)
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	// #nosec G404
-	log := log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Logger()
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
 	log.Trace().Str("endpoint", endpoint).Msg("GET request")
 
+	if s.ctx.Err() != nil {
+		return nil, client.ErrClosed
+	}
+
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	s.cancelOnClose(opCtx, cancel)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodGet, url.String(), nil)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to create GET request")
 	}
 	req.Header.Set("Accept", "application/json")
+	injectTraceContext(ctx, req)
+	started := time.Now()
+	statusCode := 0
+	defer func() {
+		s.monitorRequest(http.MethodGet, endpoint, statusCode, err, started)
+		s.requestCompleted(ctx, statusCode)
+	}()
 	resp, err := s.client.Do(req)
 	if err != nil {
+		timedOut := opCtx.Err() == context.DeadlineExceeded
 		cancel()
+		if timedOut {
+			return nil, errors.Wrap(client.ErrTimeout, "failed to call GET endpoint")
+		}
 		return nil, errors.Wrap(err, "failed to call GET endpoint")
 	}
+	statusCode = resp.StatusCode
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 
 	if resp.StatusCode == 404 {
 		// Nothing found.  This is not an error, so we return nil on both counts.
@@ -71,11 +96,12 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read GET response")
 	}
+	span.SetAttributes(attribute.Int("response_size", len(data)))
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("GET failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, client.NewAPIError(http.MethodGet, endpoint, resp.StatusCode, data)
 	}
 	cancel()
 
@@ -85,9 +111,14 @@ func (s *Service) get(ctx context.Context, endpoint string) (io.Reader, error) {
 }
 
 // post sends an HTTP post request and returns the body.
-func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io.Reader, error) {
+func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (res io.Reader, err error) {
+	ctx, span := s.startRequestSpan(ctx, http.MethodPost, endpoint)
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	// #nosec G404
-	log := log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Str("address", s.address).Logger()
+	log := s.log.With().Str("id", fmt.Sprintf("%02x", rand.Int31())).Logger()
 	if e := log.Trace(); e.Enabled() {
 		bodyBytes, err := ioutil.ReadAll(body)
 		if err != nil {
@@ -98,12 +129,17 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 		e.Str("endpoint", endpoint).Str("body", string(bodyBytes)).Msg("POST request")
 	}
 
+	if s.ctx.Err() != nil {
+		return nil, client.ErrClosed
+	}
+
 	url, err := url.Parse(fmt.Sprintf("%s%s", strings.TrimSuffix(s.base.String(), "/"), endpoint))
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid endpoint")
 	}
 
 	opCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	s.cancelOnClose(opCtx, cancel)
 	req, err := http.NewRequestWithContext(opCtx, http.MethodPost, url.String(), body)
 	if err != nil {
 		cancel()
@@ -111,22 +147,36 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 	}
 	req.Header.Set("Content-type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	injectTraceContext(ctx, req)
+	started := time.Now()
+	statusCode := 0
+	defer func() {
+		s.monitorRequest(http.MethodPost, endpoint, statusCode, err, started)
+		s.requestCompleted(ctx, statusCode)
+	}()
 	resp, err := s.client.Do(req)
 	if err != nil {
+		timedOut := opCtx.Err() == context.DeadlineExceeded
 		cancel()
+		if timedOut {
+			return nil, errors.Wrap(client.ErrTimeout, "failed to call POST endpoint")
+		}
 		return nil, errors.Wrap(err, "failed to call POST endpoint")
 	}
+	statusCode = resp.StatusCode
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
 
 	data, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		cancel()
 		return nil, errors.Wrap(err, "failed to read POST response")
 	}
+	span.SetAttributes(attribute.Int("response_size", len(data)))
 
 	statusFamily := resp.StatusCode / 100
 	if statusFamily != 2 {
 		cancel()
-		return nil, fmt.Errorf("POST failed with status %d: %s", resp.StatusCode, string(data))
+		return nil, client.NewAPIError(http.MethodPost, endpoint, resp.StatusCode, data)
 	}
 	cancel()
 
@@ -134,3 +184,13 @@ func (s *Service) post(ctx context.Context, endpoint string, body io.Reader) (io
 
 	return bytes.NewReader(data), nil
 }
+
+// batchError returns a batch error if the error contains per-item failures for a submission
+// of the given number of items, otherwise the error itself.
+func batchError(items int, err error) error {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || len(apiErr.Failures) == 0 {
+		return err
+	}
+	return client.NewBatchError(items, apiErr.Failures, err)
+}
@@ -0,0 +1,67 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		batch     bool
+		succeeded []int
+	}{
+		{
+			name: "Plain",
+			err:  errors.New("failed"),
+		},
+		{
+			name: "NoFailures",
+			err:  client.NewAPIError("POST", "/eth/v1/beacon/pool/attestations", 500, []byte(`{"code":500,"message":"internal error"}`)),
+		},
+		{
+			name:      "Failures",
+			err:       client.NewAPIError("POST", "/eth/v1/beacon/pool/attestations", 400, []byte(`{"code":400,"message":"some failed","failures":[{"index":1,"message":"invalid signature"}]}`)),
+			batch:     true,
+			succeeded: []int{0, 2},
+		},
+		{
+			name:      "Wrapped",
+			err:       pkgerrors.Wrap(client.NewAPIError("POST", "/eth/v1/beacon/pool/attestations", 400, []byte(`{"code":400,"message":"some failed","failures":[{"index":0,"message":"invalid signature"}]}`)), "failed to submit"),
+			batch:     true,
+			succeeded: []int{1, 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := batchError(3, test.err)
+			var batchErr *client.BatchError
+			if !test.batch {
+				require.False(t, errors.As(err, &batchErr))
+				require.Equal(t, test.err, err)
+				return
+			}
+			require.True(t, errors.As(err, &batchErr))
+			require.Equal(t, test.succeeded, batchErr.Succeeded())
+		})
+	}
+}
@@ -14,10 +14,13 @@
 package v1_test
 
 import (
+	"context"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/fakenode"
+	"github.com/attestantio/go-eth2-client/mock"
 	"github.com/rs/zerolog"
 )
 
@@ -26,7 +29,22 @@ var timeout = 60 * time.Second
 
 func TestMain(m *testing.M) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
-	if os.Getenv("HTTP_ADDRESS") != "" {
-		os.Exit(m.Run())
+	if os.Getenv("HTTP_ADDRESS") == "" {
+		// No live node supplied; run against a fake node backed by a mock chain a few hundred slots long.
+		service, err := mock.New(context.Background(),
+			mock.WithGenesisTime(time.Now().Add(-20*time.Minute)),
+			mock.WithNodeVersion("Lighthouse/v1.4.0-mock"),
+		)
+		if err != nil {
+			panic(err)
+		}
+		server, err := fakenode.New(context.Background(), fakenode.WithService(service))
+		if err != nil {
+			panic(err)
+		}
+		if err := os.Setenv("HTTP_ADDRESS", server.Address()); err != nil {
+			panic(err)
+		}
 	}
+	os.Exit(m.Run())
 }
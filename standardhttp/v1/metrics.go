@@ -0,0 +1,82 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/metrics"
+)
+
+// endpointIDRe matches the segments of an endpoint path that identify an item.
+var endpointIDRe = regexp.MustCompile(`^([0-9]+|0x[0-9a-fA-F]*)$`)
+
+// endpointLabel provides the label of an endpoint for metrics, replacing identifiers in its path with a placeholder
+// so that the number of labels is bounded.
+func endpointLabel(method string, endpoint string) string {
+	if pos := strings.Index(endpoint, "?"); pos != -1 {
+		endpoint = endpoint[:pos]
+	}
+	segments := strings.Split(endpoint, "/")
+	for i := range segments {
+		if endpointIDRe.MatchString(segments[i]) {
+			segments[i] = "{id}"
+		}
+	}
+	return method + " " + strings.Join(segments, "/")
+}
+
+// monitorRequest reports a completed request to the monitor, if present.
+// A status code of 0 means that there was no response.
+func (s *Service) monitorRequest(method string, endpoint string, statusCode int, err error, started time.Time) {
+	if s.monitor == nil {
+		return
+	}
+	status := ""
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	errorClass := metrics.ErrorClass(err)
+	if errorClass == metrics.ErrorClassNone && statusCode == 404 {
+		errorClass = metrics.ErrorClassNotFound
+	}
+	s.monitor.RequestCompleted(s.address, metrics.ProtocolHTTP, endpointLabel(method, endpoint), status, errorClass, time.Since(started))
+}
+
+// monitorStream reports the connection state of the events stream to the monitor, if present.
+func (s *Service) monitorStream(connected bool) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.StreamConnected(s.address, metrics.ProtocolHTTP, connected)
+}
+
+// monitorEvent reports a received event to the monitor, if present.
+func (s *Service) monitorEvent(topic string) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.EventReceived(s.address, metrics.ProtocolHTTP, topic)
+}
+
+// monitorCache reports a lookup of a cached value to the monitor, if present.
+func (s *Service) monitorCache(cache string, hit bool) {
+	if s.monitor == nil {
+		return
+	}
+	s.monitor.CacheAccessed(s.address, cache, hit)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointLabel(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		endpoint string
+		label    string
+	}{
+		{
+			name:     "Static",
+			method:   "GET",
+			endpoint: "/eth/v1/beacon/genesis",
+			label:    "GET /eth/v1/beacon/genesis",
+		},
+		{
+			name:     "NamedState",
+			method:   "GET",
+			endpoint: "/eth/v1/beacon/states/head/fork",
+			label:    "GET /eth/v1/beacon/states/head/fork",
+		},
+		{
+			name:     "Slot",
+			method:   "GET",
+			endpoint: "/eth/v1/beacon/headers/12345",
+			label:    "GET /eth/v1/beacon/headers/{id}",
+		},
+		{
+			name:     "Root",
+			method:   "GET",
+			endpoint: "/eth/v1/beacon/states/0x4d611d5b93fdab69013a7f0a2f961caca0c853f87cfe9595fe50038163079360/root",
+			label:    "GET /eth/v1/beacon/states/{id}/root",
+		},
+		{
+			name:     "Query",
+			method:   "GET",
+			endpoint: "/eth/v1/validator/attestation_data?slot=1&committee_index=2",
+			label:    "GET /eth/v1/validator/attestation_data",
+		},
+		{
+			name:     "Post",
+			method:   "POST",
+			endpoint: "/eth/v1/validator/duties/attester/10",
+			label:    "POST /eth/v1/validator/duties/attester/{id}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.label, endpointLabel(test.method, test.endpoint))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+// testMonitor records the notifications that it receives.
+type testMonitor struct {
+	mu       sync.Mutex
+	requests map[string]int
+	caches   map[string]int
+}
+
+func newTestMonitor() *testMonitor {
+	return &testMonitor{
+		requests: make(map[string]int),
+		caches:   make(map[string]int),
+	}
+}
+
+func (m *testMonitor) RequestCompleted(address string, protocol string, endpoint string, status string, errorClass string, duration time.Duration) {
+	m.mu.Lock()
+	m.requests[fmt.Sprintf("%s %s %s %s", protocol, endpoint, status, errorClass)]++
+	m.mu.Unlock()
+}
+
+func (m *testMonitor) StreamConnected(address string, protocol string, connected bool) {}
+
+func (m *testMonitor) EventReceived(address string, protocol string, topic string) {}
+
+func (m *testMonitor) CacheAccessed(address string, cache string, hit bool) {
+	m.mu.Lock()
+	m.caches[fmt.Sprintf("%s %v", cache, hit)]++
+	m.mu.Unlock()
+}
+
+func TestMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	monitor := newTestMonitor()
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		standardhttp.WithMonitor(monitor),
+	)
+	require.NoError(t, err)
+
+	// Genesis is fetched on start, so is cached.
+	_, err = service.Genesis(ctx)
+	require.NoError(t, err)
+	_, err = service.Fork(ctx, "head")
+	require.NoError(t, err)
+
+	monitor.mu.Lock()
+	defer monitor.mu.Unlock()
+	require.Equal(t, 1, monitor.requests["http GET /eth/v1/beacon/genesis 200 "])
+	require.Equal(t, 1, monitor.requests["http GET /eth/v1/beacon/states/head/fork 200 "])
+	require.Equal(t, 1, monitor.caches["genesis false"])
+	require.Equal(t, 1, monitor.caches["genesis true"])
+}
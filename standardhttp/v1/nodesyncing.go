@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
@@ -27,12 +28,15 @@ type syncingJSON struct {
 
 // NodeSyncing provides the syncing information for the node.
 func (s *Service) NodeSyncing(ctx context.Context) (*api.SyncState, error) {
+	ctx, span := s.startSpan(ctx, "NodeSyncing")
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, "/eth/v1/node/syncing")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request syncing")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain syncing")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain syncing")
 	}
 
 	var resp syncingJSON
@@ -17,6 +17,7 @@ import (
 	"context"
 	"encoding/json"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
 )
 
@@ -30,7 +31,11 @@ type nodeVersionDataJSON struct {
 
 // NodeVersion provides the version information of the node.
 func (s *Service) NodeVersion(ctx context.Context) (string, error) {
+	ctx, span := s.startSpan(ctx, "NodeVersion")
+	defer span.End()
+
 	if s.nodeVersion != "" {
+		s.monitorCache("node_version", true)
 		return s.nodeVersion, nil
 	}
 
@@ -38,8 +43,10 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 	defer s.nodeVersionMutex.Unlock()
 	if s.nodeVersion != "" {
 		// Someone else fetched this whilst we were waiting for the lock.
+		s.monitorCache("node_version", true)
 		return s.nodeVersion, nil
 	}
+	s.monitorCache("node_version", false)
 
 	// Up to us to fetch the information.
 	respBodyReader, err := s.get(ctx, "/eth/v1/node/version")
@@ -47,7 +54,7 @@ func (s *Service) NodeVersion(ctx context.Context) (string, error) {
 		return "", errors.Wrap(err, "failed to request node version")
 	}
 	if respBodyReader == nil {
-		return "", errors.New("failed to obtain node version")
+		return "", errors.Wrap(client.ErrNotFound, "failed to obtain node version")
 	}
 
 	var resp nodeVersionJSON
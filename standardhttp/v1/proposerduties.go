@@ -18,9 +18,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type proposerDutiesJSON struct {
@@ -30,12 +32,18 @@ type proposerDutiesJSON struct {
 // ProposerDuties obtains proposer duties for the given epoch.
 // If validators is empty all duties are returned, otherwise only matching duties are returned.
 func (s *Service) ProposerDuties(ctx context.Context, epoch spec.Epoch, validatorIndices []spec.ValidatorIndex) ([]*api.ProposerDuty, error) {
+	ctx, span := s.startSpan(ctx, "ProposerDuties",
+		attribute.Int64("epoch", int64(epoch)),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request proposer duties")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain proposer duties")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain proposer duties")
 	}
 
 	var resp proposerDutiesJSON
@@ -21,5 +21,8 @@ import (
 
 // RANDAODomain provides the RANDAO domain of the chain.
 func (s *Service) RANDAODomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "RANDAODomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_RANDAO"].(spec.DomainType), nil
 }
@@ -21,5 +21,8 @@ import (
 
 // SelectionProofDomain provides the selection proof domain of the chain.
 func (s *Service) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "SelectionProofDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_SELECTION_PROOF"].(spec.DomainType), nil
 }
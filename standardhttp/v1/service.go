@@ -23,22 +23,33 @@ import (
 	"sync"
 	"time"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/metrics"
+	"github.com/attestantio/go-eth2-client/recorder"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
-	zerologger "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Service is an Ethereum 2 client service.
 type Service struct {
-	// Hold the initialising context to use for streams.
-	ctx context.Context
-
-	base    *url.URL
-	address string
-	client  *http.Client
-	timeout time.Duration
+	log zerolog.Logger
+
+	// Hold the initialising context to use for streams; it is cancelled when the service is closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+	// Background goroutines, waited for by Wait().
+	wg sync.WaitGroup
+
+	base     *url.URL
+	address  string
+	client   *http.Client
+	timeout  time.Duration
+	recorder *recorder.Recorder
+	monitor  metrics.Monitor
+	tracer   trace.Tracer
 
 	// Various information from the node that does not change during the
 	// lifetime of a beacon node.
@@ -52,10 +63,14 @@ type Service struct {
 	forkScheduleMutex    sync.Mutex
 	nodeVersion          string
 	nodeVersionMutex     sync.Mutex
-}
 
-// log is a service-wide logger.
-var log zerolog.Logger
+	// State of the connection to the node.
+	stateMu             sync.Mutex
+	closed              bool
+	nodeState           client.ConnectionState
+	disconnectedStreams int
+	connectionStateCh   chan struct{}
+}
 
 // New creates a new Ethereum 2 client service, connecting with a standard HTTP.
 func New(ctx context.Context, params ...Parameter) (*Service, error) {
@@ -65,22 +80,26 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 	}
 
 	// Set logging.
-	log = zerologger.With().Str("service", "client").Str("impl", "standardv1").Logger()
+	log := parameters.logger.With().Str("service", "client").Str("impl", "standardv1").Str("address", parameters.address).Logger()
 	if parameters.logLevel != log.GetLevel() {
 		log = log.Level(parameters.logLevel)
 	}
 
-	client := &http.Client{
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
-				DualStack: true,
-			}).DialContext,
-			MaxIdleConns:        64,
-			MaxIdleConnsPerHost: 64,
-			IdleConnTimeout:     384 * time.Second,
-		},
+	var transport http.RoundTripper = &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			DualStack: true,
+		}).DialContext,
+		MaxIdleConns:        64,
+		MaxIdleConnsPerHost: 64,
+		IdleConnTimeout:     384 * time.Second,
+	}
+	if parameters.recorder != nil {
+		transport = parameters.recorder.RoundTripper(transport)
+	}
+	httpClient := &http.Client{
+		Transport: transport,
 	}
 
 	address := parameters.address
@@ -92,29 +111,54 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		return nil, errors.Wrap(err, "invalid URL")
 	}
 
+	ctx, cancel := context.WithCancel(ctx)
 	s := &Service{
-		ctx:     ctx,
-		base:    base,
-		address: parameters.address,
-		client:  client,
-		timeout: parameters.timeout,
+		log:      log,
+		ctx:      ctx,
+		cancel:   cancel,
+		base:     base,
+		address:  parameters.address,
+		client:   httpClient,
+		timeout:  parameters.timeout,
+		recorder: parameters.recorder,
+		monitor:  parameters.monitor,
+		tracer:   parameters.tracerProvider.Tracer(tracerName),
+	}
+	if parameters.connectionStateHandler != nil {
+		s.connectionStateCh = make(chan struct{}, 1)
 	}
 
 	// Fetch static values to confirm the connection is good.
 	if err := s.fetchStaticValues(ctx); err != nil {
+		cancel()
 		return nil, errors.Wrap(err, "failed to confirm node connection")
 	}
 
-	// Close the service on context done.
-	go func(s *Service) {
-		<-ctx.Done()
-		log.Trace().Msg("Context done; closing connection")
-		s.close()
-	}(s)
+	s.start(parameters.connectionStateHandler)
 
 	return s, nil
 }
 
+// start starts the background goroutines of the service.
+func (s *Service) start(connectionStateHandler client.ConnectionStateHandlerFunc) {
+	// Close the service when it is closed explicitly or on context done.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		<-s.ctx.Done()
+		s.log.Trace().Msg("Context done; closing connection")
+		s.close()
+	}()
+
+	if connectionStateHandler != nil {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.notifyConnectionState(connectionStateHandler)
+		}()
+	}
+}
+
 // fetchStaticValues fetches values that never change.
 // This caches the values, avoiding future API calls.
 func (s *Service) fetchStaticValues(ctx context.Context) error {
@@ -144,6 +188,64 @@ func (s *Service) Address() string {
 	return s.address
 }
 
+// Close closes the service, stopping event streams, cancelling in-flight requests and releasing connections,
+// then waits for its background goroutines to exit.  It returns an error if the context is done first.
+func (s *Service) Close(ctx context.Context) error {
+	s.cancel()
+	return s.Wait(ctx)
+}
+
+// Wait waits for the service's background goroutines to exit, which happens once the service is closed or the
+// context used to create it is done.  It returns an error if the context is done first.
+func (s *Service) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "background goroutines did not exit")
+	}
+}
+
+// goBackground runs a function in a background goroutine that is waited for by Wait().
+// It returns false without running the function if the service has been closed.
+func (s *Service) goBackground(f func()) bool {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if s.closed {
+		return false
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		f()
+	}()
+	return true
+}
+
+// cancelOnClose cancels a context if the service is closed before the context is done.
+// The context must be cancelled when it is no longer required.
+func (s *Service) cancelOnClose(ctx context.Context, cancel context.CancelFunc) {
+	go func() {
+		select {
+		case <-s.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+}
+
 // close closes the service, freeing up resources.
 func (s *Service) close() {
+	s.stateMu.Lock()
+	s.closed = true
+	if s.connectionStateCh != nil {
+		close(s.connectionStateCh)
+	}
+	s.stateMu.Unlock()
+	s.client.CloseIdleConnections()
 }
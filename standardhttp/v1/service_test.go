@@ -14,13 +14,16 @@
 package v1_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
 	client "github.com/attestantio/go-eth2-client"
 	v1 "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -115,3 +118,42 @@ func TestInterfaces(t *testing.T) {
 	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
 
 }
+
+func TestLogger(t *testing.T) {
+	ctx := context.Background()
+
+	zerolog.SetGlobalLevel(zerolog.TraceLevel)
+	defer zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	// Two services with their own loggers.
+	var output1 bytes.Buffer
+	s1, err := v1.New(ctx,
+		v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		v1.WithTimeout(5*time.Second),
+		v1.WithLogger(zerolog.New(&output1).With().Str("node", "node1").Logger()),
+		v1.WithLogLevel(zerolog.TraceLevel),
+	)
+	require.NoError(t, err)
+	var output2 bytes.Buffer
+	s2, err := v1.New(ctx,
+		v1.WithAddress(os.Getenv("HTTP_ADDRESS")),
+		v1.WithTimeout(5*time.Second),
+		v1.WithLogger(zerolog.New(&output2).With().Str("node", "node2").Logger()),
+		v1.WithLogLevel(zerolog.InfoLevel),
+	)
+	require.NoError(t, err)
+	output1.Reset()
+	output2.Reset()
+
+	_, err = s1.Fork(ctx, "head")
+	require.NoError(t, err)
+	_, err = s2.Fork(ctx, "head")
+	require.NoError(t, err)
+
+	// The first service logs its requests with its own fields; the second does not log them at its level.
+	require.Contains(t, output1.String(), `"node":"node1"`)
+	require.Contains(t, output1.String(), fmt.Sprintf(`"address":%q`, os.Getenv("HTTP_ADDRESS")))
+	require.Contains(t, output1.String(), `"message":"GET request"`)
+	require.NotContains(t, output1.String(), `"node":"node2"`)
+	require.Empty(t, output2.String())
+}
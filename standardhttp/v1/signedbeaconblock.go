@@ -20,6 +20,7 @@ import (
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type signedBeaconBlockJSON struct {
@@ -29,6 +30,9 @@ type signedBeaconBlockJSON struct {
 // SignedBeaconBlock fetches a signed beacon block given a block ID.
 // N.B if a signed beacon block for the block ID is not available this will return nil without an error.
 func (s *Service) SignedBeaconBlock(ctx context.Context, blockID string) (*spec.SignedBeaconBlock, error) {
+	ctx, span := s.startSpan(ctx, "SignedBeaconBlock", attribute.String("block_id", blockID))
+	defer span.End()
+
 	respBodyReader, err := s.get(ctx, fmt.Sprintf("/eth/v1/beacon/blocks/%s", blockID))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request signed beacon block")
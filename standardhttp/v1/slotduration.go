@@ -20,5 +20,8 @@ import (
 
 // SlotDuration provides the duration of a slot for the chain.
 func (s *Service) SlotDuration(ctx context.Context) (time.Duration, error) {
+	ctx, span := s.startSpan(ctx, "SlotDuration")
+	defer span.End()
+
 	return s.spec["SECONDS_PER_SLOT"].(time.Duration), nil
 }
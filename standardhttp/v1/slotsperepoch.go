@@ -19,5 +19,8 @@ import (
 
 // SlotsPerEpoch provides the number of slots per epoch for the chain.
 func (s *Service) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "SlotsPerEpoch")
+	defer span.End()
+
 	return s.spec["SLOTS_PER_EPOCH"].(uint64), nil
 }
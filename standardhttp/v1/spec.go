@@ -15,13 +15,10 @@ package v1
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
-	"strconv"
-	"strings"
-	"time"
 
-	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
 )
 
@@ -31,7 +28,11 @@ type specJSON struct {
 
 // Spec provides the spec information of the chain.
 func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
+	ctx, span := s.startSpan(ctx, "Spec")
+	defer span.End()
+
 	if s.spec != nil {
+		s.monitorCache("spec", true)
 		return s.spec, nil
 	}
 
@@ -39,8 +40,10 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 	defer s.specMutex.Unlock()
 	if s.spec != nil {
 		// Someone else fetched this whilst we were waiting for the lock.
+		s.monitorCache("spec", true)
 		return s.spec, nil
 	}
+	s.monitorCache("spec", false)
 
 	// Up to us to fetch the information.
 	respBodyReader, err := s.get(ctx, "/eth/v1/config/spec")
@@ -48,7 +51,7 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 		return nil, errors.Wrap(err, "failed to request spec")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain spec")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain spec")
 	}
 
 	var specJSON specJSON
@@ -56,71 +59,7 @@ func (s *Service) Spec(ctx context.Context) (map[string]interface{}, error) {
 		return nil, errors.Wrap(err, "failed to parse spec")
 	}
 
-	config := make(map[string]interface{})
-	for k, v := range specJSON.Data {
-		// Handle domains.
-		if strings.HasPrefix(k, "DOMAIN_") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				var domainType spec.DomainType
-				copy(domainType[:], byteVal)
-				config[k] = domainType
-				continue
-			}
-		}
-
-		// Handle fork versions.
-		if strings.HasSuffix(k, "_FORK_VERSION") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				var version spec.Version
-				copy(version[:], byteVal)
-				config[k] = version
-				continue
-			}
-		}
-
-		// Handle hex strings.
-		if strings.HasPrefix(v, "0x") {
-			byteVal, err := hex.DecodeString(strings.TrimPrefix(v, "0x"))
-			if err == nil {
-				config[k] = byteVal
-				continue
-			}
-		}
-
-		// Handle times.
-		if strings.HasSuffix(k, "_TIME") {
-			intVal, err := strconv.ParseInt(v, 10, 64)
-			if err == nil && intVal != 0 {
-				config[k] = time.Unix(intVal, 0)
-				continue
-			}
-		}
-
-		// Handle durations.
-		if strings.HasPrefix(k, "SECONDS_PER_") || strings.HasSuffix(k, "_DELAY") {
-			intVal, err := strconv.ParseUint(v, 10, 64)
-			if err == nil && intVal != 0 {
-				config[k] = time.Duration(intVal) * time.Second
-				continue
-			}
-		}
-
-		// Handle integers.
-		if v == "0" {
-			config[k] = uint64(0)
-			continue
-		}
-		intVal, err := strconv.ParseUint(v, 10, 64)
-		if err == nil && intVal != 0 {
-			config[k] = intVal
-			continue
-		}
-
-		// Assume string.
-		config[k] = v
-	}
+	config := api.SpecValues(specJSON.Data)
 	s.spec = config
 	return s.spec, nil
 }
@@ -14,77 +14,199 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
+	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SlotFromStateID parses the state ID and returns the relevant slot.
 func (s *Service) SlotFromStateID(ctx context.Context, stateID string) (spec.Slot, error) {
-	var slot spec.Slot
-	switch {
-	case stateID == "genesis":
-		slot = 0
-	case stateID == "justified":
-		return 0, errors.New("state from justified not implemented")
-	case stateID == "finalized":
-		return 0, errors.New("state from finalized not implemented")
-	case stateID == "head":
-		return 0, errors.New("state from head not implemented")
-	case strings.HasPrefix(stateID, "0x"):
-		return 0, errors.New("state from state root not implemented")
-	default:
-		// State ID should be a slot.
-		tmp, err := strconv.ParseUint(stateID, 10, 64)
-		if err != nil {
-			return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s as a slot", stateID))
-		}
-		slot = spec.Slot(tmp)
+	ctx, span := s.startSpan(ctx, "SlotFromStateID", attribute.String("state_id", stateID))
+	defer span.End()
+
+	id, err := api.ParseStateID(stateID)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s", stateID))
+	}
+	slot, err := s.stateSlot(ctx, id)
+	if err != nil {
+		return 0, err
 	}
 
-	log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
+	s.log.Trace().Str("state", stateID).Uint64("slot", uint64(slot)).Msg("Calculated from state ID")
 	return slot, nil
 }
 
 // EpochFromStateID parses the state ID and returns the relevant epoch.
 func (s *Service) EpochFromStateID(ctx context.Context, stateID string) (spec.Epoch, error) {
-	var epoch spec.Epoch
+	ctx, span := s.startSpan(ctx, "EpochFromStateID", attribute.String("state_id", stateID))
+	defer span.End()
+
+	id, err := api.ParseStateID(stateID)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s", stateID))
+	}
+	slot, err := s.stateSlot(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	epoch := spec.Epoch(uint64(slot) / slotsPerEpoch)
+
+	s.log.Trace().Str("state", stateID).Uint64("epoch", uint64(epoch)).Msg("Calculated from state ID")
+	return epoch, nil
+}
+
+// ResolveStateID resolves a state ID to the slot, epoch and root of the state.
+// The standard API does not provide a lookup from state root to slot, so a state root other
+// than that of the head block is resolved by finding its epoch from its committees and then
+// matching the state roots of the slots in that epoch, which takes a request per slot.
+func (s *Service) ResolveStateID(ctx context.Context, stateID api.StateID) (*api.ResolvedStateID, error) {
+	ctx, span := s.startSpan(ctx, "ResolveStateID", attribute.String("state_id", stateID.String()))
+	defer span.End()
+
+	if !stateID.IsValid() {
+		return nil, errors.New("no state ID specified")
+	}
+
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+
+	var slot spec.Slot
+	root, isRoot := stateID.Root()
 	switch {
-	case stateID == "genesis":
-		epoch = 0
-	case stateID == "justified":
-		finality, err := s.Finality(ctx, stateID)
+	case isRoot:
+		slot, err = s.stateRootSlot(ctx, root)
 		if err != nil {
-			return 0, errors.Wrap(err, "failed to obtain finality for justified epoch")
+			return nil, err
 		}
-		epoch = finality.Justified.Epoch
-	case stateID == "finalized":
-		finality, err := s.Finality(ctx, stateID)
+	case stateID.IsHead():
+		// Obtain both slot and root from the same header, so that they are consistent.
+		header, err := s.headBlockHeader(ctx)
 		if err != nil {
-			return 0, errors.Wrap(err, "failed to obtain finality for finalized epoch")
+			return nil, err
 		}
-		epoch = finality.Finalized.Epoch
-	case stateID == "head":
-		return 0, errors.New("epoch from head not implemented")
-	case strings.HasPrefix(stateID, "0x"):
-		return 0, errors.New("epoch from state root not implemented")
+		slot = header.Slot
+		root = header.StateRoot
 	default:
-		// State ID should be a slot.
-		tmp, err := strconv.ParseUint(stateID, 10, 64)
+		slot, err = s.stateSlot(ctx, stateID)
+		if err != nil {
+			return nil, err
+		}
+		stateRoot, err := s.StateRoot(ctx, stateID.String())
 		if err != nil {
-			return 0, errors.Wrap(err, fmt.Sprintf("failed to parse state ID %s as a slot", stateID))
+			return nil, errors.Wrap(err, "failed to obtain state root")
+		}
+		if len(stateRoot) != len(root) {
+			return nil, errors.Wrapf(client.ErrNotFound, "state root for state %s not available", stateID)
+		}
+		copy(root[:], stateRoot)
+	}
+
+	return &api.ResolvedStateID{
+		Slot:  slot,
+		Epoch: spec.Epoch(uint64(slot) / slotsPerEpoch),
+		Root:  root,
+	}, nil
+}
+
+// stateSlot obtains the slot of the state with the given ID.
+// States for justified and finalized checkpoints are at the first slot of the checkpoint epoch.
+func (s *Service) stateSlot(ctx context.Context, stateID api.StateID) (spec.Slot, error) {
+	if slot, isSlot := stateID.Slot(); isSlot {
+		return slot, nil
+	}
+
+	switch {
+	case stateID.IsGenesis():
+		return 0, nil
+	case stateID.IsJustified(), stateID.IsFinalized():
+		finality, err := s.Finality(ctx, "head")
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to obtain finality")
+		}
+		checkpoint := finality.Finalized
+		if stateID.IsJustified() {
+			checkpoint = finality.Justified
+		}
+		if checkpoint == nil {
+			return 0, errors.New("finality checkpoint not available")
 		}
 		slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
 		if err != nil {
 			return 0, errors.Wrap(err, "failed to obtain slots per epoch")
 		}
-		epoch = spec.Epoch(tmp / slotsPerEpoch)
+		return spec.Slot(uint64(checkpoint.Epoch) * slotsPerEpoch), nil
+	default:
+		if root, isRoot := stateID.Root(); isRoot {
+			return s.stateRootSlot(ctx, root)
+		}
+		header, err := s.headBlockHeader(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return header.Slot, nil
 	}
+}
 
-	log.Trace().Str("state", stateID).Uint64("epoch", uint64(epoch)).Msg("Calculated from state ID")
-	return epoch, nil
+// stateRootSlot obtains the slot of the state with the given root.
+// The committees of the state provide its epoch, and the state roots of the slots in the
+// epoch are then checked in turn.
+func (s *Service) stateRootSlot(ctx context.Context, root spec.Root) (spec.Slot, error) {
+	header, err := s.headBlockHeader(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if header.StateRoot == root {
+		return header.Slot, nil
+	}
+
+	committees, err := s.BeaconCommittees(ctx, fmt.Sprintf("%#x", root))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to obtain committees for state root %#x", root)
+	}
+	if len(committees) == 0 {
+		return 0, errors.Wrapf(client.ErrNotFound, "no committees for state root %#x", root)
+	}
+	slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	epoch := spec.Epoch(uint64(committees[0].Slot) / slotsPerEpoch)
+
+	startSlot := spec.Slot(uint64(epoch) * slotsPerEpoch)
+	for slot := startSlot; slot < startSlot+spec.Slot(slotsPerEpoch) && slot <= header.Slot; slot++ {
+		stateRoot, err := s.StateRoot(ctx, fmt.Sprintf("%d", slot))
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to obtain state root for slot %d", slot)
+		}
+		if bytes.Equal(stateRoot, root[:]) {
+			return slot, nil
+		}
+	}
+
+	return 0, errors.Wrapf(client.ErrNotFound, "state root %#x not found in epoch %d", root, epoch)
+}
+
+// headBlockHeader obtains the header of the head block.
+func (s *Service) headBlockHeader(ctx context.Context) (*spec.BeaconBlockHeader, error) {
+	header, err := s.BeaconBlockHeader(ctx, "head")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain head block header")
+	}
+	if header == nil || header.Header == nil || header.Header.Message == nil {
+		return nil, errors.Wrap(client.ErrNotFound, "head block header not available")
+	}
+	return header.Header.Message, nil
 }
@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveStateID(t *testing.T) {
+	tests := []struct {
+		name    string
+		stateID api.StateID
+		err     string
+	}{
+		{
+			name: "Invalid",
+			err:  "no state ID specified",
+		},
+		{
+			name:    "Genesis",
+			stateID: api.StateIDGenesis(),
+		},
+		{
+			name:    "Head",
+			stateID: api.StateIDHead(),
+		},
+		{
+			name:    "Justified",
+			stateID: api.StateIDJustified(),
+		},
+		{
+			name:    "Finalized",
+			stateID: api.StateIDFinalized(),
+		},
+		{
+			name:    "Slot",
+			stateID: api.StateIDSlot(1),
+		},
+		{
+			name:    "UnknownRoot",
+			stateID: api.StateIDRoot(spec.Root{0x01}),
+			err:     "failed to obtain committees for state root 0x0100000000000000000000000000000000000000000000000000000000000000: failed to obtain beacon committees: not found",
+		},
+	}
+
+	service, err := standardhttp.New(context.Background(),
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(os.Getenv("HTTP_ADDRESS")),
+	)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := service.ResolveStateID(context.Background(), test.stateID)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			// Resolving by the root should provide the same result.
+			byRoot, err := service.ResolveStateID(context.Background(), api.StateIDRoot(res.Root))
+			require.NoError(t, err)
+			require.Equal(t, res, byRoot)
+
+			slot, err := service.SlotFromStateID(context.Background(), test.stateID.String())
+			require.NoError(t, err)
+			require.Equal(t, res.Slot, slot)
+			slot, err = service.SlotFromStateID(context.Background(), api.StateIDRoot(res.Root).String())
+			require.NoError(t, err)
+			require.Equal(t, res.Slot, slot)
+		})
+	}
+}
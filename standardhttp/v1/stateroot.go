@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type stateRootJSON struct {
@@ -33,6 +35,9 @@ type stateRootDataJSON struct {
 
 // StateRoot provides the state root given a state ID.
 func (s *Service) StateRoot(ctx context.Context, stateID string) ([]byte, error) {
+	ctx, span := s.startSpan(ctx, "StateRoot", attribute.String("state_id", stateID))
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -42,7 +47,7 @@ func (s *Service) StateRoot(ctx context.Context, stateID string) ([]byte, error)
 		return nil, errors.Wrap(err, "failed to request state root")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain state root")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain state root")
 	}
 
 	var stateRootJSON stateRootJSON
@@ -20,10 +20,14 @@ import (
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitAggregateAttestations submits aggregate attestations.
 func (s *Service) SubmitAggregateAttestations(ctx context.Context, aggregateAndProofs []*spec.SignedAggregateAndProof) error {
+	ctx, span := s.startSpan(ctx, "SubmitAggregateAttestations", attribute.Int("aggregate_and_proofs", len(aggregateAndProofs)))
+	defer span.End()
+
 	specJSON, err := json.Marshal(aggregateAndProofs)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
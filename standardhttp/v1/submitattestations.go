@@ -20,10 +20,15 @@ import (
 
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitAttestations submits attestations.
+// If the node rejects individual attestations the error is a *client.BatchError listing them.
 func (s *Service) SubmitAttestations(ctx context.Context, attestations []*spec.Attestation) error {
+	ctx, span := s.startSpan(ctx, "SubmitAttestations", attribute.Int("attestations", len(attestations)))
+	defer span.End()
+
 	specJSON, err := json.Marshal(attestations)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -31,7 +36,7 @@ func (s *Service) SubmitAttestations(ctx context.Context, attestations []*spec.A
 
 	_, err = s.post(ctx, "/eth/v1/beacon/pool/attestations", bytes.NewBuffer(specJSON))
 	if err != nil {
-		return errors.Wrap(err, "failed to submit beacon attestations")
+		return batchError(len(attestations), errors.Wrap(err, "failed to submit beacon attestations"))
 	}
 
 	return nil
@@ -24,6 +24,9 @@ import (
 
 // SubmitBeaconBlock submits a beacon block.
 func (s *Service) SubmitBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) error {
+	ctx, span := s.startSpan(ctx, "SubmitBeaconBlock")
+	defer span.End()
+
 	specJSON, err := json.Marshal(block)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
@@ -20,10 +20,14 @@ import (
 
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SubmitBeaconCommitteeSubscriptions subscribes to beacon committees.
+// If the node rejects individual subscriptions the error is a *client.BatchError listing them.
 func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscriptions []*api.BeaconCommitteeSubscription) error {
+	ctx, span := s.startSpan(ctx, "SubmitBeaconCommitteeSubscriptions", attribute.Int("subscriptions", len(subscriptions)))
+	defer span.End()
 
 	var reqBodyReader bytes.Buffer
 	if err := json.NewEncoder(&reqBodyReader).Encode(subscriptions); err != nil {
@@ -32,7 +36,7 @@ func (s *Service) SubmitBeaconCommitteeSubscriptions(ctx context.Context, subscr
 
 	_, err := s.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", &reqBodyReader)
 	if err != nil {
-		return errors.Wrap(err, "failed to request beacon committee subscriptions")
+		return batchError(len(subscriptions), errors.Wrap(err, "failed to request beacon committee subscriptions"))
 	}
 
 	return nil
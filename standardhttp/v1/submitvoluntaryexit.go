@@ -24,6 +24,9 @@ import (
 
 // SubmitVoluntaryExit submits a voluntary exit.
 func (s *Service) SubmitVoluntaryExit(ctx context.Context, voluntaryExit *spec.SignedVoluntaryExit) error {
+	ctx, span := s.startSpan(ctx, "SubmitVoluntaryExit")
+	defer span.End()
+
 	specJSON, err := json.Marshal(voluntaryExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to marshal JSON")
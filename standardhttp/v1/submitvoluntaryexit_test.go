@@ -49,6 +49,7 @@ func TestSubmitVoluntaryExit(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			err := service.SubmitVoluntaryExit(context.Background(), test.exit)
+			require.Error(t, err)
 			require.Contains(t, err.Error(), "400")
 		})
 	}
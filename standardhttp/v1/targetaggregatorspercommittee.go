@@ -21,6 +21,9 @@ import (
 
 // TargetAggregatorsPerCommittee provides the target aggregators per committee of the chain.
 func (s *Service) TargetAggregatorsPerCommittee(ctx context.Context) (uint64, error) {
+	ctx, span := s.startSpan(ctx, "TargetAggregatorsPerCommittee")
+	defer span.End()
+
 	spec, err := s.Spec(ctx)
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to obtain spec")
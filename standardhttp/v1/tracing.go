@@ -0,0 +1,60 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer used by the service.
+const tracerName = "github.com/attestantio/go-eth2-client/standardhttp/v1"
+
+// propagator propagates trace context to the node in W3C trace context headers.
+var propagator = propagation.TraceContext{}
+
+// startSpan starts a span for a provider call.
+func (s *Service) startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// startRequestSpan starts a span for a request to the node.
+func (s *Service) startRequestSpan(ctx context.Context, method string, endpoint string) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, endpointLabel(method, endpoint),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", method),
+			attribute.String("endpoint", endpoint),
+		),
+	)
+}
+
+// injectTraceContext adds the trace context headers for a span to a request.
+func injectTraceContext(ctx context.Context, req *http.Request) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+}
+
+// endSpan ends a span, recording the error if present.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,115 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	standardhttp "github.com/attestantio/go-eth2-client/standardhttp/v1"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spanAttribute returns the value of an attribute of a span, or nil if it is not present.
+func spanAttribute(span tracetest.SpanStub, key string) *attribute.Value {
+	for _, kv := range span.Attributes {
+		if string(kv.Key) == key {
+			value := kv.Value
+			return &value
+		}
+	}
+	return nil
+}
+
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+
+	// Proxy requests to the node, capturing their trace context headers.
+	address := os.Getenv("HTTP_ADDRESS")
+	if !strings.HasPrefix(address, "http") {
+		address = fmt.Sprintf("http://%s", address)
+	}
+	target, err := url.Parse(address)
+	require.NoError(t, err)
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	var traceparentsMu sync.Mutex
+	traceparents := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparentsMu.Lock()
+		traceparents = append(traceparents, r.Header.Get("traceparent"))
+		traceparentsMu.Unlock()
+		proxy.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	service, err := standardhttp.New(ctx,
+		standardhttp.WithTimeout(timeout),
+		standardhttp.WithAddress(server.URL),
+		standardhttp.WithTracerProvider(tracerProvider),
+	)
+	require.NoError(t, err)
+	exporter.Reset()
+	traceparentsMu.Lock()
+	traceparents = traceparents[:0]
+	traceparentsMu.Unlock()
+
+	// Call the provider within a parent span.
+	parentCtx, parent := tracerProvider.Tracer("test").Start(ctx, "parent")
+	duties, err := service.AttesterDuties(parentCtx, 1, []spec.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, duties)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	spansByName := make(map[string]tracetest.SpanStub)
+	for _, span := range spans {
+		spansByName[span.Name] = span
+	}
+
+	// Provider span.
+	providerSpan, exists := spansByName["AttesterDuties"]
+	require.True(t, exists)
+	require.Equal(t, parent.SpanContext().SpanID(), providerSpan.Parent.SpanID())
+	require.Equal(t, int64(1), spanAttribute(providerSpan, "epoch").AsInt64())
+	require.Equal(t, int64(2), spanAttribute(providerSpan, "validators").AsInt64())
+
+	// Request span.
+	requestSpan, exists := spansByName["POST /eth/v1/validator/duties/attester/{id}"]
+	require.True(t, exists)
+	require.Equal(t, providerSpan.SpanContext.SpanID(), requestSpan.Parent.SpanID())
+	require.Equal(t, "/eth/v1/validator/duties/attester/1", spanAttribute(requestSpan, "endpoint").AsString())
+	require.Equal(t, int64(http.StatusOK), spanAttribute(requestSpan, "http.status_code").AsInt64())
+	require.Greater(t, spanAttribute(requestSpan, "response_size").AsInt64(), int64(0))
+
+	// Trace context propagated to the node.
+	traceparentsMu.Lock()
+	defer traceparentsMu.Unlock()
+	require.Len(t, traceparents, 1)
+	require.Equal(t, fmt.Sprintf("00-%s-%s-01", requestSpan.SpanContext.TraceID(), requestSpan.SpanContext.SpanID()), traceparents[0])
+}
@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type validatorBalancesJSON struct {
@@ -33,6 +35,12 @@ type validatorBalancesJSON struct {
 // validatorIndices is a list of validator indices to restrict the returned values.  If no validators are supplied no filter
 // will be applied.
 func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]spec.Gwei, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorBalances",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -55,7 +63,7 @@ func (s *Service) ValidatorBalances(ctx context.Context, stateID string, validat
 		return nil, errors.Wrap(err, "failed to request validator balances")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validator balances")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validator balances")
 	}
 
 	var validatorBalancesJSON validatorBalancesJSON
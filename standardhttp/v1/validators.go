@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type validatorsJSON struct {
@@ -39,6 +41,12 @@ var indexChunkSize = 1000
 // stateID can be a slot number or state root, or one of the special values "genesis", "head", "justified" or "finalized".
 // validatorIndices is a list of validators to restrict the returned values.  If no validators are supplied no filter will be applied.
 func (s *Service) Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "Validators",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorIndices)),
+	)
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -61,7 +69,7 @@ func (s *Service) Validators(ctx context.Context, stateID string, validatorIndic
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validators")
 	}
 
 	var validatorsJSON validatorsJSON
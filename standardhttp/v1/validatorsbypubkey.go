@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"strings"
 
+	client "github.com/attestantio/go-eth2-client"
 	api "github.com/attestantio/go-eth2-client/api/v1"
 	spec "github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type validatorsByPubKeyJSON struct {
@@ -40,6 +42,12 @@ var pubKeyChunkSize = 75
 // validatorPubKeys is a list of validator public keys to restrict the returned values.  If no validators public keys are
 // supplied no filter will be applied.
 func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	ctx, span := s.startSpan(ctx, "ValidatorsByPubKey",
+		attribute.String("state_id", stateID),
+		attribute.Int("validators", len(validatorPubKeys)),
+	)
+	defer span.End()
+
 	if stateID == "" {
 		return nil, errors.New("no state ID specified")
 	}
@@ -62,7 +70,7 @@ func (s *Service) ValidatorsByPubKey(ctx context.Context, stateID string, valida
 		return nil, errors.Wrap(err, "failed to request validators")
 	}
 	if respBodyReader == nil {
-		return nil, errors.New("failed to obtain validators")
+		return nil, errors.Wrap(client.ErrNotFound, "failed to obtain validators")
 	}
 
 	var validatorsByPubKeyJSON validatorsByPubKeyJSON
@@ -21,5 +21,8 @@ import (
 
 // VoluntaryExitDomain provides the voluntary exit domain of the chain.
 func (s *Service) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	ctx, span := s.startSpan(ctx, "VoluntaryExitDomain")
+	defer span.End()
+
 	return s.spec["DOMAIN_VOLUNTARY_EXIT"].(spec.DomainType), nil
 }
@@ -0,0 +1,43 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// DepositContract provides details of the Ethereum 1 deposit contract for the chain.
+func (s *Service) DepositContract(ctx context.Context) (*api.DepositContract, error) {
+	return &api.DepositContract{
+		ChainID: s.chainSpec.DepositChainID,
+		Address: s.chainSpec.DepositContractAddress,
+	}, nil
+}
+
+// DepositContractAddress provides the Ethereum 1 address of the deposit contract.
+func (s *Service) DepositContractAddress(ctx context.Context) ([]byte, error) {
+	return s.chainSpec.DepositContractAddress, nil
+}
+
+// DepositContractChainID provides the Ethereum 1 chain ID of the deposit contract.
+func (s *Service) DepositContractChainID(ctx context.Context) (uint64, error) {
+	return s.chainSpec.DepositChainID, nil
+}
+
+// DepositContractNetworkID provides the Ethereum 1 network ID of the deposit contract.
+func (s *Service) DepositContractNetworkID(ctx context.Context) (uint64, error) {
+	return s.chainSpec.DepositNetworkID, nil
+}
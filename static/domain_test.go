@@ -0,0 +1,55 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDomain(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := static.New(ctx, static.WithLogLevel(zerolog.Disabled), static.WithNetwork("mainnet"))
+	require.NoError(t, err)
+
+	domainType, err := s.BeaconAttesterDomain(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spec.DomainType{0x01, 0x00, 0x00, 0x00}, domainType)
+
+	genesis, err := s.Genesis(ctx)
+	require.NoError(t, err)
+	forkData := &spec.ForkData{
+		CurrentVersion:        genesis.GenesisForkVersion,
+		GenesisValidatorsRoot: genesis.GenesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	require.NoError(t, err)
+
+	domain, err := s.Domain(ctx, domainType, 1000)
+	require.NoError(t, err)
+	require.Equal(t, domainType[:], domain[:4])
+	require.Equal(t, root[:28], domain[4:])
+
+	// Domains cannot be calculated without a genesis validators root.
+	s, err = static.New(ctx, static.WithLogLevel(zerolog.Disabled), static.WithNetwork("minimal"))
+	require.NoError(t, err)
+	_, err = s.Domain(ctx, domainType, 0)
+	require.EqualError(t, err, "failed to obtain genesis: genesis not known for this chain")
+}
@@ -0,0 +1,87 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// farFutureEpoch is the epoch used to denote a fork that is not scheduled.
+const farFutureEpoch = spec.Epoch(0xffffffffffffffff)
+
+// ForkSchedule provides details of past and future changes in the chain's fork version.
+func (s *Service) ForkSchedule(ctx context.Context) ([]*spec.Fork, error) {
+	return s.forkSchedule, nil
+}
+
+// forkSchedule builds the fork schedule from the genesis fork version and any
+// scheduled forks in the config, defined by pairs of <FORK>_FORK_VERSION and
+// <FORK>_FORK_EPOCH values.
+func forkSchedule(config map[string]string, genesisForkVersion spec.Version) ([]*spec.Fork, error) {
+	scheduled := make([]*spec.Fork, 0)
+	for k, v := range config {
+		if !strings.HasSuffix(k, "_FORK_EPOCH") {
+			continue
+		}
+		epoch, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", k)
+		}
+		if spec.Epoch(epoch) == farFutureEpoch {
+			continue
+		}
+		versionKey := fmt.Sprintf("%s_FORK_VERSION", strings.TrimSuffix(k, "_FORK_EPOCH"))
+		versionStr, exists := config[versionKey]
+		if !exists {
+			return nil, fmt.Errorf("%s missing", versionKey)
+		}
+		version, err := hex.DecodeString(strings.TrimPrefix(versionStr, "0x"))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %s", versionKey)
+		}
+		if len(version) != 4 {
+			return nil, fmt.Errorf("incorrect length for %s", versionKey)
+		}
+		fork := &spec.Fork{
+			Epoch: spec.Epoch(epoch),
+		}
+		copy(fork.CurrentVersion[:], version)
+		scheduled = append(scheduled, fork)
+	}
+	sort.Slice(scheduled, func(i, j int) bool {
+		return scheduled[i].Epoch < scheduled[j].Epoch
+	})
+
+	forks := []*spec.Fork{
+		{
+			PreviousVersion: genesisForkVersion,
+			CurrentVersion:  genesisForkVersion,
+			Epoch:           0,
+		},
+	}
+	for _, fork := range scheduled {
+		fork.PreviousVersion = forks[len(forks)-1].CurrentVersion
+		forks = append(forks, fork)
+	}
+
+	return forks, nil
+}
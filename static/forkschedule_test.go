@@ -0,0 +1,86 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkSchedule(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		parameters []static.Parameter
+		forks      []*spec.Fork
+	}{
+		{
+			name:       "Mainnet",
+			parameters: []static.Parameter{static.WithNetwork("mainnet")},
+			forks: []*spec.Fork{
+				{
+					PreviousVersion: spec.Version{0x00, 0x00, 0x00, 0x00},
+					CurrentVersion:  spec.Version{0x00, 0x00, 0x00, 0x00},
+					Epoch:           0,
+				},
+			},
+		},
+		{
+			name: "Scheduled",
+			parameters: []static.Parameter{static.WithConfigFile(writeConfig(t, `
+GENESIS_FORK_VERSION: 0x00000001
+SHARDING_FORK_VERSION: 0x03000001
+SHARDING_FORK_EPOCH: 18446744073709551615
+MERGE_FORK_VERSION: 0x02000001
+MERGE_FORK_EPOCH: 200
+ALTAIR_FORK_VERSION: 0x01000001
+ALTAIR_FORK_EPOCH: 100
+`))},
+			forks: []*spec.Fork{
+				{
+					PreviousVersion: spec.Version{0x00, 0x00, 0x00, 0x01},
+					CurrentVersion:  spec.Version{0x00, 0x00, 0x00, 0x01},
+					Epoch:           0,
+				},
+				{
+					PreviousVersion: spec.Version{0x00, 0x00, 0x00, 0x01},
+					CurrentVersion:  spec.Version{0x01, 0x00, 0x00, 0x01},
+					Epoch:           100,
+				},
+				{
+					PreviousVersion: spec.Version{0x01, 0x00, 0x00, 0x01},
+					CurrentVersion:  spec.Version{0x02, 0x00, 0x00, 0x01},
+					Epoch:           200,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := static.New(ctx, append(test.parameters, static.WithLogLevel(zerolog.Disabled))...)
+			require.NoError(t, err)
+
+			forks, err := s.ForkSchedule(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.forks, forks)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"errors"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// errNoGenesis is returned when genesis information is requested for a chain without a known genesis.
+var errNoGenesis = errors.New("genesis not known for this chain")
+
+// Genesis provides the genesis information of the chain.
+func (s *Service) Genesis(ctx context.Context) (*api.Genesis, error) {
+	if s.genesis == nil {
+		return nil, errNoGenesis
+	}
+	return s.genesis, nil
+}
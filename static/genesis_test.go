@@ -0,0 +1,88 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		parameters  []static.Parameter
+		genesisTime time.Time
+		root        spec.Root
+		err         string
+	}{
+		{
+			name:        "Mainnet",
+			parameters:  []static.Parameter{static.WithNetwork("mainnet")},
+			genesisTime: time.Unix(1606824023, 0),
+			root: spec.Root{
+				0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+				0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+			},
+		},
+		{
+			name:       "Minimal",
+			parameters: []static.Parameter{static.WithNetwork("minimal")},
+			err:        "genesis not known for this chain",
+		},
+		{
+			name: "MinimalWithGenesis",
+			parameters: []static.Parameter{
+				static.WithNetwork("minimal"),
+				static.WithGenesisTime(time.Unix(1600000000, 0)),
+				static.WithGenesisValidatorsRoot(spec.Root{0x01}),
+			},
+			genesisTime: time.Unix(1600000000, 0),
+			root:        spec.Root{0x01},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := static.New(ctx, append(test.parameters, static.WithLogLevel(zerolog.Disabled))...)
+			require.NoError(t, err)
+
+			genesis, err := s.Genesis(ctx)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				_, err = s.GenesisTime(ctx)
+				require.EqualError(t, err, "failed to obtain genesis: "+test.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.genesisTime, genesis.GenesisTime)
+			require.Equal(t, test.root, genesis.GenesisValidatorsRoot)
+
+			genesisTime, err := s.GenesisTime(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.genesisTime, genesisTime)
+
+			root, err := s.GenesisValidatorsRoot(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.root[:], root)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// network contains the values for a well-known network.
+type network struct {
+	// preset is the name of the preset on which the network's configuration is based.
+	preset string
+	// config contains configuration values that override those of the preset.
+	config string
+	// genesisTime is the genesis time of the network; zero if the network has no genesis.
+	genesisTime time.Time
+	// genesisValidatorsRoot is the genesis validators root of the network; nil if the network has no genesis.
+	genesisValidatorsRoot *spec.Root
+}
+
+// networks are the well-known networks, by name.
+var networks = map[string]*network{
+	"mainnet": {
+		preset:      "mainnet",
+		genesisTime: time.Unix(1606824023, 0),
+		genesisValidatorsRoot: &spec.Root{
+			0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+			0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+		},
+	},
+	"prater": {
+		preset: "mainnet",
+		config: `
+CONFIG_NAME: "prater"
+MIN_GENESIS_TIME: 1614588812
+GENESIS_FORK_VERSION: 0x00001020
+GENESIS_DELAY: 1919188
+DEPOSIT_CHAIN_ID: 5
+DEPOSIT_NETWORK_ID: 5
+DEPOSIT_CONTRACT_ADDRESS: 0xff50ed3d0ec03aC01D4C79aAd74928BFF48a7b2b
+`,
+		genesisTime: time.Unix(1616508000, 0),
+		genesisValidatorsRoot: &spec.Root{
+			0x04, 0x3d, 0xb0, 0xd9, 0xa8, 0x38, 0x13, 0x55, 0x1e, 0xe2, 0xf3, 0x34, 0x50, 0xd2, 0x37, 0x97,
+			0x75, 0x7d, 0x43, 0x09, 0x11, 0xa9, 0x32, 0x05, 0x30, 0xad, 0x8a, 0x0e, 0xab, 0xc4, 0x3e, 0xfb,
+		},
+	},
+	"minimal": {
+		preset: "minimal",
+	},
+}
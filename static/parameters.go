@@ -0,0 +1,111 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel              zerolog.Level
+	logger                zerolog.Logger
+	network               string
+	configFile            string
+	genesisTime           time.Time
+	genesisValidatorsRoot *spec.Root
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithNetwork sets the well-known network for which to provide values, for example "mainnet".
+func WithNetwork(network string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.network = network
+	})
+}
+
+// WithConfigFile sets the path to a consensus config.yaml file from which to provide values.
+func WithConfigFile(configFile string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.configFile = configFile
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain.
+// This overrides the value for a well-known network.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithGenesisValidatorsRoot sets the genesis validators root of the chain.
+// This overrides the value for a well-known network.
+func WithGenesisValidatorsRoot(root spec.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisValidatorsRoot = &root
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.network == "" && parameters.configFile == "" {
+		return nil, errors.New("no network or config file specified")
+	}
+	if parameters.network != "" && parameters.configFile != "" {
+		return nil, errors.New("only one of network and config file can be specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,192 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+// mainnetPreset is the phase0 mainnet configuration.
+const mainnetPreset = `
+CONFIG_NAME: "mainnet"
+
+# Misc
+MAX_COMMITTEES_PER_SLOT: 64
+TARGET_COMMITTEE_SIZE: 128
+MAX_VALIDATORS_PER_COMMITTEE: 2048
+MIN_PER_EPOCH_CHURN_LIMIT: 4
+CHURN_LIMIT_QUOTIENT: 65536
+SHUFFLE_ROUND_COUNT: 90
+MIN_GENESIS_ACTIVE_VALIDATOR_COUNT: 16384
+MIN_GENESIS_TIME: 1606824000
+HYSTERESIS_QUOTIENT: 4
+HYSTERESIS_DOWNWARD_MULTIPLIER: 1
+HYSTERESIS_UPWARD_MULTIPLIER: 5
+
+# Fork choice
+SAFE_SLOTS_TO_UPDATE_JUSTIFIED: 8
+
+# Validator
+ETH1_FOLLOW_DISTANCE: 2048
+TARGET_AGGREGATORS_PER_COMMITTEE: 16
+RANDOM_SUBNETS_PER_VALIDATOR: 1
+EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION: 256
+SECONDS_PER_ETH1_BLOCK: 14
+
+# Deposit contract
+DEPOSIT_CHAIN_ID: 1
+DEPOSIT_NETWORK_ID: 1
+DEPOSIT_CONTRACT_ADDRESS: 0x00000000219ab540356cBB839Cbe05303d7705Fa
+
+# Gwei values
+MIN_DEPOSIT_AMOUNT: 1000000000
+MAX_EFFECTIVE_BALANCE: 32000000000
+EJECTION_BALANCE: 16000000000
+EFFECTIVE_BALANCE_INCREMENT: 1000000000
+
+# Initial values
+GENESIS_FORK_VERSION: 0x00000000
+BLS_WITHDRAWAL_PREFIX: 0x00
+
+# Time parameters
+GENESIS_DELAY: 604800
+SECONDS_PER_SLOT: 12
+MIN_ATTESTATION_INCLUSION_DELAY: 1
+SLOTS_PER_EPOCH: 32
+MIN_SEED_LOOKAHEAD: 1
+MAX_SEED_LOOKAHEAD: 4
+EPOCHS_PER_ETH1_VOTING_PERIOD: 64
+SLOTS_PER_HISTORICAL_ROOT: 8192
+MIN_VALIDATOR_WITHDRAWABILITY_DELAY: 256
+SHARD_COMMITTEE_PERIOD: 256
+MIN_EPOCHS_TO_INACTIVITY_PENALTY: 4
+
+# State vector lengths
+EPOCHS_PER_HISTORICAL_VECTOR: 65536
+EPOCHS_PER_SLASHINGS_VECTOR: 8192
+HISTORICAL_ROOTS_LIMIT: 16777216
+VALIDATOR_REGISTRY_LIMIT: 1099511627776
+
+# Reward and penalty quotients
+BASE_REWARD_FACTOR: 64
+WHISTLEBLOWER_REWARD_QUOTIENT: 512
+PROPOSER_REWARD_QUOTIENT: 8
+INACTIVITY_PENALTY_QUOTIENT: 67108864
+MIN_SLASHING_PENALTY_QUOTIENT: 128
+PROPORTIONAL_SLASHING_MULTIPLIER: 1
+
+# Max operations per block
+MAX_PROPOSER_SLASHINGS: 16
+MAX_ATTESTER_SLASHINGS: 2
+MAX_ATTESTATIONS: 128
+MAX_DEPOSITS: 16
+MAX_VOLUNTARY_EXITS: 16
+
+# Signature domains
+DOMAIN_BEACON_PROPOSER: 0x00000000
+DOMAIN_BEACON_ATTESTER: 0x01000000
+DOMAIN_RANDAO: 0x02000000
+DOMAIN_DEPOSIT: 0x03000000
+DOMAIN_VOLUNTARY_EXIT: 0x04000000
+DOMAIN_SELECTION_PROOF: 0x05000000
+DOMAIN_AGGREGATE_AND_PROOF: 0x06000000
+`
+
+// minimalPreset is the phase0 minimal configuration, as used for testing.
+const minimalPreset = `
+CONFIG_NAME: "minimal"
+
+# Misc
+MAX_COMMITTEES_PER_SLOT: 4
+TARGET_COMMITTEE_SIZE: 4
+MAX_VALIDATORS_PER_COMMITTEE: 2048
+MIN_PER_EPOCH_CHURN_LIMIT: 4
+CHURN_LIMIT_QUOTIENT: 65536
+SHUFFLE_ROUND_COUNT: 10
+MIN_GENESIS_ACTIVE_VALIDATOR_COUNT: 64
+MIN_GENESIS_TIME: 1578009600
+HYSTERESIS_QUOTIENT: 4
+HYSTERESIS_DOWNWARD_MULTIPLIER: 1
+HYSTERESIS_UPWARD_MULTIPLIER: 5
+
+# Fork choice
+SAFE_SLOTS_TO_UPDATE_JUSTIFIED: 2
+
+# Validator
+ETH1_FOLLOW_DISTANCE: 16
+TARGET_AGGREGATORS_PER_COMMITTEE: 16
+RANDOM_SUBNETS_PER_VALIDATOR: 1
+EPOCHS_PER_RANDOM_SUBNET_SUBSCRIPTION: 256
+SECONDS_PER_ETH1_BLOCK: 14
+
+# Deposit contract
+DEPOSIT_CHAIN_ID: 5
+DEPOSIT_NETWORK_ID: 5
+DEPOSIT_CONTRACT_ADDRESS: 0x1234567890123456789012345678901234567890
+
+# Gwei values
+MIN_DEPOSIT_AMOUNT: 1000000000
+MAX_EFFECTIVE_BALANCE: 32000000000
+EJECTION_BALANCE: 16000000000
+EFFECTIVE_BALANCE_INCREMENT: 1000000000
+
+# Initial values
+GENESIS_FORK_VERSION: 0x00000001
+BLS_WITHDRAWAL_PREFIX: 0x00
+
+# Time parameters
+GENESIS_DELAY: 300
+SECONDS_PER_SLOT: 6
+MIN_ATTESTATION_INCLUSION_DELAY: 1
+SLOTS_PER_EPOCH: 8
+MIN_SEED_LOOKAHEAD: 1
+MAX_SEED_LOOKAHEAD: 4
+EPOCHS_PER_ETH1_VOTING_PERIOD: 4
+SLOTS_PER_HISTORICAL_ROOT: 64
+MIN_VALIDATOR_WITHDRAWABILITY_DELAY: 256
+SHARD_COMMITTEE_PERIOD: 64
+MIN_EPOCHS_TO_INACTIVITY_PENALTY: 4
+
+# State vector lengths
+EPOCHS_PER_HISTORICAL_VECTOR: 64
+EPOCHS_PER_SLASHINGS_VECTOR: 64
+HISTORICAL_ROOTS_LIMIT: 16777216
+VALIDATOR_REGISTRY_LIMIT: 1099511627776
+
+# Reward and penalty quotients
+BASE_REWARD_FACTOR: 64
+WHISTLEBLOWER_REWARD_QUOTIENT: 512
+PROPOSER_REWARD_QUOTIENT: 8
+INACTIVITY_PENALTY_QUOTIENT: 67108864
+MIN_SLASHING_PENALTY_QUOTIENT: 128
+PROPORTIONAL_SLASHING_MULTIPLIER: 1
+
+# Max operations per block
+MAX_PROPOSER_SLASHINGS: 16
+MAX_ATTESTER_SLASHINGS: 2
+MAX_ATTESTATIONS: 128
+MAX_DEPOSITS: 16
+MAX_VOLUNTARY_EXITS: 16
+
+# Signature domains
+DOMAIN_BEACON_PROPOSER: 0x00000000
+DOMAIN_BEACON_ATTESTER: 0x01000000
+DOMAIN_RANDAO: 0x02000000
+DOMAIN_DEPOSIT: 0x03000000
+DOMAIN_VOLUNTARY_EXIT: 0x04000000
+DOMAIN_SELECTION_PROOF: 0x05000000
+DOMAIN_AGGREGATE_AND_PROOF: 0x06000000
+`
+
+// presets are the base configurations, by name.
+var presets = map[string]string{
+	"mainnet": mainnetPreset,
+	"minimal": minimalPreset,
+}
@@ -0,0 +1,168 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service is an Ethereum 2 client service, providing static chain values without
+// a connection to a beacon node.
+type Service struct {
+	log zerolog.Logger
+
+	address string
+
+	spec         map[string]interface{}
+	chainSpec    *api.ChainSpec
+	genesis      *api.Genesis
+	forkSchedule []*spec.Fork
+}
+
+// New creates a new Ethereum 2 client service, providing values for a well-known network
+// or from a config file.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "client").Str("impl", "static").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log: log,
+	}
+
+	var config map[string]string
+	genesisTime := parameters.genesisTime
+	genesisValidatorsRoot := parameters.genesisValidatorsRoot
+	if parameters.network != "" {
+		network, exists := networks[strings.ToLower(parameters.network)]
+		if !exists {
+			return nil, fmt.Errorf("unknown network %s", parameters.network)
+		}
+		config, err = networkConfig(network)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain network config")
+		}
+		if genesisTime.IsZero() {
+			genesisTime = network.genesisTime
+		}
+		if genesisValidatorsRoot == nil {
+			genesisValidatorsRoot = network.genesisValidatorsRoot
+		}
+		s.address = fmt.Sprintf("static:%s", strings.ToLower(parameters.network))
+	} else {
+		config, err = fileConfig(parameters.configFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain config from file")
+		}
+		s.address = fmt.Sprintf("static:%s", parameters.configFile)
+	}
+	log.Trace().Str("address", s.address).Int("values", len(config)).Msg("Obtained config")
+
+	s.spec = api.SpecValues(config)
+	s.chainSpec, err = api.NewChainSpec(s.spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	s.forkSchedule, err = forkSchedule(config, s.chainSpec.GenesisForkVersion)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fork schedule")
+	}
+
+	if !genesisTime.IsZero() && genesisValidatorsRoot != nil {
+		s.genesis = &api.Genesis{
+			GenesisTime:           genesisTime,
+			GenesisValidatorsRoot: *genesisValidatorsRoot,
+			GenesisForkVersion:    s.chainSpec.GenesisForkVersion,
+		}
+	}
+
+	return s, nil
+}
+
+// networkConfig returns the configuration for a well-known network.
+func networkConfig(network *network) (map[string]string, error) {
+	config, err := parseConfig([]byte(presets[network.preset]))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse preset")
+	}
+	overrides, err := parseConfig([]byte(network.config))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse network config")
+	}
+	for k, v := range overrides {
+		config[k] = v
+	}
+
+	return config, nil
+}
+
+// fileConfig returns the configuration from a config file.
+// Values in the file are applied on top of the preset that the file names, either
+// with PRESET_BASE or CONFIG_NAME, defaulting to mainnet.
+func fileConfig(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config file")
+	}
+	overrides, err := parseConfig(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse config file")
+	}
+
+	presetName := "mainnet"
+	if name, exists := overrides["PRESET_BASE"]; exists {
+		if _, exists := presets[name]; !exists {
+			return nil, fmt.Errorf("unknown preset %s", name)
+		}
+		presetName = name
+	} else if _, exists := presets[overrides["CONFIG_NAME"]]; exists {
+		presetName = overrides["CONFIG_NAME"]
+	}
+
+	config, err := parseConfig([]byte(presets[presetName]))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse preset")
+	}
+	for k, v := range overrides {
+		config[k] = v
+	}
+
+	return config, nil
+}
+
+// Name provides the name of the service.
+func (s *Service) Name() string {
+	return "Static"
+}
+
+// Address provides the address of the service.
+func (s *Service) Address() string {
+	return s.address
+}
@@ -0,0 +1,178 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfig writes a config file to a temporary directory, returning its path.
+func writeConfig(t *testing.T, contents string) string {
+	dir, err := ioutil.TempDir("", "static")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		parameters []static.Parameter
+		address    string
+		err        string
+	}{
+		{
+			name: "Nil",
+			err:  "problem with parameters: no network or config file specified",
+		},
+		{
+			name: "NetworkAndConfigFile",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithNetwork("mainnet"),
+				static.WithConfigFile("config.yaml"),
+			},
+			err: "problem with parameters: only one of network and config file can be specified",
+		},
+		{
+			name: "NetworkUnknown",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithNetwork("unknown"),
+			},
+			err: "unknown network unknown",
+		},
+		{
+			name: "ConfigFileMissing",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(filepath.Join(os.TempDir(), "missing", "config.yaml")),
+			},
+			err: "failed to obtain config from file: failed to read config file: open " + filepath.Join(os.TempDir(), "missing", "config.yaml") + ": no such file or directory",
+		},
+		{
+			name: "ConfigFileInvalid",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(writeConfig(t, "SLOTS_PER_EPOCH 32\n")),
+			},
+			err: "failed to obtain config from file: failed to parse config file: invalid entry on line 1",
+		},
+		{
+			name: "ConfigFilePresetUnknown",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(writeConfig(t, "PRESET_BASE: 'unknown'\n")),
+			},
+			err: "failed to obtain config from file: unknown preset unknown",
+		},
+		{
+			name: "ConfigFileValueInvalid",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(writeConfig(t, "SLOTS_PER_EPOCH: 0\n")),
+			},
+			err: "invalid config: SLOTS_PER_EPOCH cannot be 0",
+		},
+		{
+			name: "ForkEpochInvalid",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(writeConfig(t, "ALTAIR_FORK_VERSION: 0x01000000\nALTAIR_FORK_EPOCH: soon\n")),
+			},
+			err: "invalid fork schedule: invalid value for ALTAIR_FORK_EPOCH: strconv.ParseUint: parsing \"soon\": invalid syntax",
+		},
+		{
+			name: "Mainnet",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithNetwork("mainnet"),
+			},
+			address: "static:mainnet",
+		},
+		{
+			name: "Prater",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithNetwork("Prater"),
+			},
+			address: "static:prater",
+		},
+		{
+			name: "ConfigFile",
+			parameters: []static.Parameter{
+				static.WithLogLevel(zerolog.Disabled),
+				static.WithConfigFile(writeConfig(t, "PRESET_BASE: 'minimal'\n")),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := static.New(ctx, test.parameters...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "Static", s.Name())
+				if test.address != "" {
+					require.Equal(t, test.address, s.Address())
+				}
+			}
+		})
+	}
+}
+
+func TestInterfaces(t *testing.T) {
+	ctx := context.Background()
+	s, err := static.New(ctx, static.WithLogLevel(zerolog.Disabled), static.WithNetwork("mainnet"))
+	require.NoError(t, err)
+
+	assert.Implements(t, (*client.ChainSpecProvider)(nil), s)
+	assert.Implements(t, (*client.DepositContractProvider)(nil), s)
+	assert.Implements(t, (*client.ForkScheduleProvider)(nil), s)
+	assert.Implements(t, (*client.GenesisProvider)(nil), s)
+	assert.Implements(t, (*client.SpecProvider)(nil), s)
+
+	assert.Implements(t, (*client.AggregateAndProofDomainProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconAttesterDomainProvider)(nil), s)
+	assert.Implements(t, (*client.BeaconProposerDomainProvider)(nil), s)
+	assert.Implements(t, (*client.DepositDomainProvider)(nil), s)
+	assert.Implements(t, (*client.DomainProvider)(nil), s)
+	assert.Implements(t, (*client.FarFutureEpochProvider)(nil), s)
+	assert.Implements(t, (*client.GenesisTimeProvider)(nil), s)
+	assert.Implements(t, (*client.GenesisValidatorsRootProvider)(nil), s)
+	assert.Implements(t, (*client.RANDAODomainProvider)(nil), s)
+	assert.Implements(t, (*client.SelectionProofDomainProvider)(nil), s)
+	assert.Implements(t, (*client.SlotDurationProvider)(nil), s)
+	assert.Implements(t, (*client.SlotsPerEpochProvider)(nil), s)
+	assert.Implements(t, (*client.TargetAggregatorsPerCommitteeProvider)(nil), s)
+	assert.Implements(t, (*client.VoluntaryExitDomainProvider)(nil), s)
+}
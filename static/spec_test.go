@@ -0,0 +1,114 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package static_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/static"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		parameters     []static.Parameter
+		configName     string
+		slotsPerEpoch  uint64
+		secondsPerSlot time.Duration
+		forkVersion    spec.Version
+		chainID        uint64
+	}{
+		{
+			name:           "Mainnet",
+			parameters:     []static.Parameter{static.WithNetwork("mainnet")},
+			configName:     "mainnet",
+			slotsPerEpoch:  32,
+			secondsPerSlot: 12 * time.Second,
+			forkVersion:    spec.Version{0x00, 0x00, 0x00, 0x00},
+			chainID:        1,
+		},
+		{
+			name:           "Prater",
+			parameters:     []static.Parameter{static.WithNetwork("prater")},
+			configName:     "prater",
+			slotsPerEpoch:  32,
+			secondsPerSlot: 12 * time.Second,
+			forkVersion:    spec.Version{0x00, 0x00, 0x10, 0x20},
+			chainID:        5,
+		},
+		{
+			name:           "Minimal",
+			parameters:     []static.Parameter{static.WithNetwork("minimal")},
+			configName:     "minimal",
+			slotsPerEpoch:  8,
+			secondsPerSlot: 6 * time.Second,
+			forkVersion:    spec.Version{0x00, 0x00, 0x00, 0x01},
+			chainID:        5,
+		},
+		{
+			name: "ConfigFile",
+			parameters: []static.Parameter{static.WithConfigFile(writeConfig(t, `# Custom network.
+PRESET_BASE: 'minimal'
+CONFIG_NAME: 'custom'
+GENESIS_FORK_VERSION: 0x00000099 # Custom fork version.
+SECONDS_PER_SLOT: 3
+DEPOSIT_CHAIN_ID: 1337
+`))},
+			configName:     "custom",
+			slotsPerEpoch:  8,
+			secondsPerSlot: 3 * time.Second,
+			forkVersion:    spec.Version{0x00, 0x00, 0x00, 0x99},
+			chainID:        1337,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := static.New(ctx, append(test.parameters, static.WithLogLevel(zerolog.Disabled))...)
+			require.NoError(t, err)
+
+			values, err := s.Spec(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.configName, values["CONFIG_NAME"])
+			require.Equal(t, test.slotsPerEpoch, values["SLOTS_PER_EPOCH"])
+			require.Equal(t, test.secondsPerSlot, values["SECONDS_PER_SLOT"])
+			require.Equal(t, test.forkVersion, values["GENESIS_FORK_VERSION"])
+			require.Equal(t, spec.DomainType{0x03, 0x00, 0x00, 0x00}, values["DOMAIN_DEPOSIT"])
+
+			chainSpec, err := s.ChainSpec(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.configName, chainSpec.ConfigName)
+			require.Equal(t, test.forkVersion, chainSpec.GenesisForkVersion)
+
+			slotsPerEpoch, err := s.SlotsPerEpoch(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.slotsPerEpoch, slotsPerEpoch)
+
+			slotDuration, err := s.SlotDuration(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.secondsPerSlot, slotDuration)
+
+			chainID, err := s.DepositContractChainID(ctx)
+			require.NoError(t, err)
+			require.Equal(t, test.chainID, chainID)
+		})
+	}
+}
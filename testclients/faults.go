@@ -0,0 +1,99 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"errors"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// FaultType is the type of a fault injected by the faulty client.
+type FaultType int
+
+const (
+	// FaultError returns an error from the call.
+	FaultError FaultType = iota + 1
+	// FaultHang blocks the call until its context is done.
+	FaultHang
+	// FaultStaleData returns data from the previous epoch, for calls that obtain duties.
+	FaultStaleData
+	// FaultCorruptData returns data for the wrong slot, for calls that obtain attestation data
+	// or beacon block proposals.
+	FaultCorruptData
+)
+
+// ErrInjected is the error returned by a FaultError fault without its own error.
+var ErrInjected = errors.New("injected fault")
+
+// SlotRange is an inclusive range of slots.
+type SlotRange struct {
+	From spec.Slot
+	To   spec.Slot
+}
+
+// CallRange is an inclusive range of call numbers, starting at 1.
+// A To of 0 has no upper bound.
+type CallRange struct {
+	From uint64
+	To   uint64
+}
+
+// Fault is a fault injected by the faulty client in to the calls that it matches.
+type Fault struct {
+	// Type is the type of the fault.
+	Type FaultType
+	// Err is the error returned by a FaultError fault; defaults to ErrInjected.
+	Err error
+	// Methods are the names of the methods that the fault matches, for example "AttestationData".
+	// If empty the fault matches all methods.
+	Methods []string
+	// Slots is the range of slots that the fault matches.  Calls for an epoch use the first slot
+	// of the epoch.  If set the fault only matches calls for a slot or an epoch.
+	Slots *SlotRange
+	// Calls is the range of call numbers that the fault matches.  Calls are counted separately
+	// for each method.
+	Calls *CallRange
+	// Probability is the probability that the fault is injected in to a call that it matches,
+	// from 0 for never to 1 for every call.
+	Probability float64
+}
+
+// matches returns true if the fault matches a call.
+func (f *Fault) matches(method string, call uint64, slot *spec.Slot) bool {
+	if len(f.Methods) > 0 {
+		found := false
+		for i := range f.Methods {
+			if f.Methods[i] == method {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Slots != nil {
+		if slot == nil || *slot < f.Slots.From || *slot > f.Slots.To {
+			return false
+		}
+	}
+	if f.Calls != nil {
+		if call < f.Calls.From || (f.Calls.To != 0 && call > f.Calls.To) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,192 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/interceptor"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// faulty injects scripted faults in to calls.
+type faulty struct {
+	faults []*Fault
+	next   eth2client.Service
+
+	mu            sync.Mutex
+	rand          *rand.Rand
+	calls         map[string]uint64
+	slotsPerEpoch uint64
+}
+
+// NewFaulty creates a new Ethereum 2 client that injects faults in to calls.
+// Faults are checked in order, and the first that matches a call is injected.  Faults
+// with a probability are injected reproducibly for a given seed and sequence of calls.
+func NewFaulty(ctx context.Context,
+	seed int64,
+	faults []*Fault,
+	next eth2client.Service,
+) (eth2client.Service, error) {
+	if next == nil {
+		return nil, errors.New("no next service supplied")
+	}
+	for i, fault := range faults {
+		if fault == nil {
+			return nil, fmt.Errorf("fault %d missing", i)
+		}
+		switch fault.Type {
+		case FaultError, FaultHang, FaultStaleData, FaultCorruptData:
+		default:
+			return nil, fmt.Errorf("fault %d has invalid type", i)
+		}
+		if fault.Probability < 0 || fault.Probability > 1 {
+			return nil, fmt.Errorf("fault %d has invalid probability", i)
+		}
+		if fault.Slots != nil && fault.Slots.To < fault.Slots.From {
+			return nil, fmt.Errorf("fault %d has invalid slot range", i)
+		}
+		if fault.Calls != nil && fault.Calls.To != 0 && fault.Calls.To < fault.Calls.From {
+			return nil, fmt.Errorf("fault %d has invalid call range", i)
+		}
+	}
+
+	s := &faulty{
+		faults: faults,
+		next:   next,
+		// #nosec G404
+		rand:  rand.New(rand.NewSource(seed)),
+		calls: make(map[string]uint64),
+	}
+
+	return interceptor.New(ctx,
+		interceptor.WithService(next),
+		interceptor.WithName(fmt.Sprintf("faulty(%d,%s)", seed, next.Name())),
+		interceptor.WithAddress(fmt.Sprintf("faulty:%d,%s", seed, next.Address())),
+		interceptor.WithInterceptors(s.intercept),
+	)
+}
+
+// intercept injects the first fault that matches a call.
+func (s *faulty) intercept(ctx context.Context, call *interceptor.Call, next interceptor.Invoker) {
+	fault := s.match(call.Method, s.callSlot(ctx, call))
+	if fault == nil {
+		next(ctx, call)
+		return
+	}
+
+	switch fault.Type {
+	case FaultError:
+		if fault.Err != nil {
+			call.Err = fault.Err
+		} else {
+			call.Err = fmt.Errorf("%s: %w", call.Method, ErrInjected)
+		}
+	case FaultHang:
+		<-ctx.Done()
+		call.Err = ctx.Err()
+	case FaultStaleData:
+		switch call.Method {
+		case "AttesterDuties", "ProposerDuties", "PrysmAttesterDuties", "PrysmProposerDuties":
+			if epoch := call.Args[0].(spec.Epoch); epoch > 0 {
+				// Return the duties for the previous epoch.
+				call.Args[0] = epoch - 1
+			}
+		}
+		next(ctx, call)
+	case FaultCorruptData:
+		next(ctx, call)
+		if call.Err != nil || len(call.Results) == 0 {
+			return
+		}
+		// Return the data for the wrong slot.
+		switch result := call.Results[0].(type) {
+		case *spec.AttestationData:
+			if result != nil {
+				corrupted := *result
+				corrupted.Slot++
+				call.Results[0] = &corrupted
+			}
+		case *spec.BeaconBlock:
+			if result != nil {
+				corrupted := *result
+				corrupted.Slot++
+				call.Results[0] = &corrupted
+			}
+		}
+	}
+}
+
+// match returns the first fault that matches a call, or nil if there is no such fault.
+func (s *faulty) match(method string, slot *spec.Slot) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[method]++
+	call := s.calls[method]
+	for _, f := range s.faults {
+		if !f.matches(method, call, slot) {
+			continue
+		}
+		if f.Probability < 1 && s.rand.Float64() >= f.Probability {
+			continue
+		}
+		return f
+	}
+	return nil
+}
+
+// callSlot provides the slot of a call, for matching faults.
+// This is the first slot or epoch argument of the call, with epochs converted to their first slot.
+func (s *faulty) callSlot(ctx context.Context, call *interceptor.Call) *spec.Slot {
+	for _, arg := range call.Args {
+		switch v := arg.(type) {
+		case spec.Slot:
+			return &v
+		case spec.Epoch:
+			return s.epochSlot(ctx, v)
+		}
+	}
+	return nil
+}
+
+// epochSlot provides the first slot of an epoch, for matching faults.
+// It returns nil if the next service does not provide the number of slots in an epoch.
+func (s *faulty) epochSlot(ctx context.Context, epoch spec.Epoch) *spec.Slot {
+	s.mu.Lock()
+	slotsPerEpoch := s.slotsPerEpoch
+	s.mu.Unlock()
+	if slotsPerEpoch == 0 {
+		provider, isProvider := s.next.(eth2client.SlotsPerEpochProvider)
+		if !isProvider {
+			return nil
+		}
+		var err error
+		slotsPerEpoch, err = provider.SlotsPerEpoch(ctx)
+		if err != nil || slotsPerEpoch == 0 {
+			return nil
+		}
+		s.mu.Lock()
+		s.slotsPerEpoch = slotsPerEpoch
+		s.mu.Unlock()
+	}
+
+	slot := spec.Slot(uint64(epoch) * slotsPerEpoch)
+	return &slot
+}
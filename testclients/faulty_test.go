@@ -0,0 +1,336 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testclients_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/mock"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/testclients"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// newMock creates a mock with a chain 20 slots long.
+func newMock(ctx context.Context, t *testing.T) eth2client.Service {
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+		mock.WithManualAdvance(true),
+	)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, client.Advance(ctx))
+	}
+	return client
+}
+
+func TestFaultyNew(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+
+	tests := []struct {
+		name   string
+		faults []*testclients.Fault
+		next   eth2client.Service
+		err    string
+	}{
+		{
+			name: "ClientMissing",
+			err:  "no next service supplied",
+		},
+		{
+			name:   "FaultMissing",
+			faults: []*testclients.Fault{nil},
+			next:   client,
+			err:    "fault 0 missing",
+		},
+		{
+			name: "TypeInvalid",
+			faults: []*testclients.Fault{
+				{},
+			},
+			next: client,
+			err:  "fault 0 has invalid type",
+		},
+		{
+			name: "ProbabilityInvalid",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Probability: 1},
+				{Type: testclients.FaultError, Probability: 1.5},
+			},
+			next: client,
+			err:  "fault 1 has invalid probability",
+		},
+		{
+			name: "SlotRangeInvalid",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Slots: &testclients.SlotRange{From: 2, To: 1}, Probability: 1},
+			},
+			next: client,
+			err:  "fault 0 has invalid slot range",
+		},
+		{
+			name: "CallRangeInvalid",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Calls: &testclients.CallRange{From: 2, To: 1}, Probability: 1},
+			},
+			next: client,
+			err:  "fault 0 has invalid call range",
+		},
+		{
+			name: "Good",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Probability: 1},
+			},
+			next: client,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := testclients.NewFaulty(ctx, 1, test.faults, test.next)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFaultyMatching(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+	customErr := errors.New("custom")
+
+	tests := []struct {
+		name   string
+		faults []*testclients.Fault
+		// slots are the slots for which to request attestation data, in order.
+		slots []spec.Slot
+		// errs are the expected errors for each request.
+		errs []error
+	}{
+		{
+			name:  "None",
+			slots: []spec.Slot{1, 2},
+			errs:  []error{nil, nil},
+		},
+		{
+			name: "All",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Probability: 1},
+			},
+			slots: []spec.Slot{1, 2},
+			errs:  []error{testclients.ErrInjected, testclients.ErrInjected},
+		},
+		{
+			name: "OtherMethod",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Methods: []string{"AttesterDuties"}, Probability: 1},
+			},
+			slots: []spec.Slot{1, 2},
+			errs:  []error{nil, nil},
+		},
+		{
+			name: "CustomError",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Methods: []string{"AttestationData"}, Err: customErr, Probability: 1},
+			},
+			slots: []spec.Slot{1},
+			errs:  []error{customErr},
+		},
+		{
+			name: "Slots",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Slots: &testclients.SlotRange{From: 2, To: 3}, Probability: 1},
+			},
+			slots: []spec.Slot{1, 2, 3, 4},
+			errs:  []error{nil, testclients.ErrInjected, testclients.ErrInjected, nil},
+		},
+		{
+			name: "Calls",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Calls: &testclients.CallRange{From: 2, To: 3}, Probability: 1},
+			},
+			slots: []spec.Slot{1, 1, 1, 1},
+			errs:  []error{nil, testclients.ErrInjected, testclients.ErrInjected, nil},
+		},
+		{
+			name: "CallsUnbounded",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Calls: &testclients.CallRange{From: 3}, Probability: 1},
+			},
+			slots: []spec.Slot{1, 1, 1, 1},
+			errs:  []error{nil, nil, testclients.ErrInjected, testclients.ErrInjected},
+		},
+		{
+			name: "FirstMatch",
+			faults: []*testclients.Fault{
+				{Type: testclients.FaultError, Slots: &testclients.SlotRange{From: 2, To: 2}, Err: customErr, Probability: 1},
+				{Type: testclients.FaultError, Probability: 1},
+			},
+			slots: []spec.Slot{1, 2},
+			errs:  []error{testclients.ErrInjected, customErr},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			faulty, err := testclients.NewFaulty(ctx, 1, test.faults, client)
+			require.NoError(t, err)
+			for i := range test.slots {
+				_, err := faulty.(eth2client.AttestationDataProvider).AttestationData(ctx, test.slots[i], 0)
+				if test.errs[i] == nil {
+					require.NoError(t, err)
+				} else {
+					require.True(t, errors.Is(err, test.errs[i]), "call %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestFaultyEpochSlots(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+	slotsPerEpoch, err := client.(eth2client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+
+	faulty, err := testclients.NewFaulty(ctx, 1, []*testclients.Fault{
+		{
+			Type:        testclients.FaultError,
+			Slots:       &testclients.SlotRange{From: spec.Slot(slotsPerEpoch), To: spec.Slot(2*slotsPerEpoch - 1)},
+			Probability: 1,
+		},
+	}, client)
+	require.NoError(t, err)
+
+	_, err = faulty.(eth2client.AttesterDutiesProvider).AttesterDuties(ctx, 0, []spec.ValidatorIndex{0})
+	require.NoError(t, err)
+	_, err = faulty.(eth2client.AttesterDutiesProvider).AttesterDuties(ctx, 1, []spec.ValidatorIndex{0})
+	require.True(t, errors.Is(err, testclients.ErrInjected))
+	_, err = faulty.(eth2client.AttesterDutiesProvider).AttesterDuties(ctx, 2, []spec.ValidatorIndex{0})
+	require.NoError(t, err)
+
+	// Calls without a slot or epoch do not match faults with slots.
+	_, err = faulty.(eth2client.GenesisProvider).Genesis(ctx)
+	require.NoError(t, err)
+}
+
+func TestFaultyHang(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+
+	faulty, err := testclients.NewFaulty(ctx, 1, []*testclients.Fault{
+		{Type: testclients.FaultHang, Methods: []string{"Genesis"}, Probability: 1},
+	}, client)
+	require.NoError(t, err)
+
+	opCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	_, err = faulty.(eth2client.GenesisProvider).Genesis(opCtx)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.GreaterOrEqual(t, time.Since(started).Milliseconds(), int64(100))
+}
+
+func TestFaultyStaleData(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+	slotsPerEpoch, err := client.(eth2client.SlotsPerEpochProvider).SlotsPerEpoch(ctx)
+	require.NoError(t, err)
+
+	faulty, err := testclients.NewFaulty(ctx, 1, []*testclients.Fault{
+		{Type: testclients.FaultStaleData, Probability: 1},
+	}, client)
+	require.NoError(t, err)
+
+	attesterDuties, err := faulty.(eth2client.AttesterDutiesProvider).AttesterDuties(ctx, 2, []spec.ValidatorIndex{0, 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, attesterDuties)
+	for _, duty := range attesterDuties {
+		require.Equal(t, uint64(1), uint64(duty.Slot)/slotsPerEpoch)
+	}
+
+	proposerDuties, err := faulty.(eth2client.ProposerDutiesProvider).ProposerDuties(ctx, 2, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, proposerDuties)
+	for _, duty := range proposerDuties {
+		require.Equal(t, uint64(1), uint64(duty.Slot)/slotsPerEpoch)
+	}
+}
+
+func TestFaultyCorruptData(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+
+	faulty, err := testclients.NewFaulty(ctx, 1, []*testclients.Fault{
+		{Type: testclients.FaultCorruptData, Probability: 1},
+	}, client)
+	require.NoError(t, err)
+
+	attestationData, err := faulty.(eth2client.AttestationDataProvider).AttestationData(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(11), attestationData.Slot)
+
+	// The underlying data is not altered.
+	attestationData, err = client.(eth2client.AttestationDataProvider).AttestationData(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(10), attestationData.Slot)
+
+	block, err := faulty.(eth2client.BeaconBlockProposalProvider).BeaconBlockProposal(ctx, 21, spec.BLSSignature{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, spec.Slot(22), block.Slot)
+}
+
+func TestFaultyReproducible(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+
+	outcomes := func(seed int64) []bool {
+		faulty, err := testclients.NewFaulty(ctx, seed, []*testclients.Fault{
+			{Type: testclients.FaultError, Probability: 0.5},
+		}, client)
+		require.NoError(t, err)
+		res := make([]bool, 64)
+		for i := range res {
+			_, err := faulty.(eth2client.GenesisProvider).Genesis(ctx)
+			res[i] = err != nil
+		}
+		return res
+	}
+
+	require.Equal(t, outcomes(1), outcomes(1))
+	require.NotEqual(t, outcomes(1), outcomes(2))
+}
+
+func TestFaultyProbabilityZero(t *testing.T) {
+	ctx := context.Background()
+	client := newMock(ctx, t)
+
+	faulty, err := testclients.NewFaulty(ctx, 1, []*testclients.Fault{
+		{Type: testclients.FaultError},
+	}, client)
+	require.NoError(t, err)
+	for i := 0; i < 64; i++ {
+		_, err := faulty.(eth2client.GenesisProvider).Genesis(ctx)
+		require.NoError(t, err)
+	}
+}
@@ -102,3 +102,21 @@ func TestSleep(t *testing.T) {
 		require.LessOrEqual(t, duration.Milliseconds(), (maxSleep + 50*time.Millisecond).Milliseconds())
 	}
 }
+
+func TestSleepCancelled(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := mock.New(ctx,
+		mock.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := testclients.NewSleepy(ctx, time.Second, 2*time.Second, client)
+	require.NoError(t, err)
+
+	opCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	started := time.Now()
+	_, _ = s.(eth2client.GenesisProvider).Genesis(opCtx)
+	require.Less(t, time.Since(started).Milliseconds(), int64(500))
+}
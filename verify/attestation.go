@@ -0,0 +1,59 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VerifyAttestation verifies the aggregate signature of an attestation.
+func (s *Service) VerifyAttestation(ctx context.Context, attestation *spec.Attestation) (bool, error) {
+	if attestation == nil {
+		return false, errors.New("no attestation supplied")
+	}
+	if attestation.Data == nil {
+		return false, errors.New("no attestation data supplied")
+	}
+	if attestation.Data.Target == nil {
+		return false, errors.New("no attestation target supplied")
+	}
+
+	indices, err := s.attestations.AttestingIndices(ctx, attestation)
+	if err != nil {
+		return false, err
+	}
+	if len(indices) == 0 {
+		// An attestation without attesters cannot have a valid signature.
+		return false, nil
+	}
+
+	objectRoot, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate attestation data root")
+	}
+	root, err := s.signingRoot(ctx, objectRoot, s.beaconAttesterDomain, attestation.Data.Target.Epoch)
+	if err != nil {
+		return false, err
+	}
+
+	pubKeys, err := s.pubKeysForIndices(ctx, indices)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain attester public keys")
+	}
+
+	return verify(pubKeys, root, attestation.Signature)
+}
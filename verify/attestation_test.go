@@ -0,0 +1,141 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/stretchr/testify/require"
+)
+
+func _bits(length uint64, set ...uint64) bitfield.Bitlist {
+	bits := bitfield.NewBitlist(length)
+	for _, i := range set {
+		bits.SetBitAt(i, true)
+	}
+	return bits
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(4)
+	service, err := newTestService(chain)
+	require.NoError(t, err)
+
+	data := &spec.AttestationData{
+		Slot:            1,
+		Index:           0,
+		BeaconBlockRoot: _root("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"),
+		Source: &spec.Checkpoint{
+			Epoch: 0,
+			Root:  _root("0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"),
+		},
+		Target: &spec.Checkpoint{
+			Epoch: 0,
+			Root:  _root("0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"),
+		},
+	}
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	attesterDomain, err := chain.BeaconAttesterDomain(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		attestation *spec.Attestation
+		verified    bool
+		err         string
+	}{
+		{
+			name: "Nil",
+			err:  "no attestation supplied",
+		},
+		{
+			name:        "DataMissing",
+			attestation: &spec.Attestation{},
+			err:         "no attestation data supplied",
+		},
+		{
+			name: "CommitteeUnknown",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(4, 0),
+				Data: &spec.AttestationData{
+					Slot:   2,
+					Index:  0,
+					Source: data.Source,
+					Target: data.Target,
+				},
+			},
+			err: "no committee found for slot 2 index 0",
+		},
+		{
+			name: "AggregationBitsWrongLength",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(5, 0),
+				Data:            data,
+			},
+			err: "aggregation bits length 5 does not match committee length 4",
+		},
+		{
+			name: "NoAttesters",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(4),
+				Data:            data,
+			},
+			verified: false,
+		},
+		{
+			name: "AttestersMismatch",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(4, 0, 2),
+				Data:            data,
+				Signature:       chain.sign(dataRoot, attesterDomain, 0, 0, 1),
+			},
+			verified: false,
+		},
+		{
+			name: "Single",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(4, 1),
+				Data:            data,
+				Signature:       chain.sign(dataRoot, attesterDomain, 0, 1),
+			},
+			verified: true,
+		},
+		{
+			name: "Aggregate",
+			attestation: &spec.Attestation{
+				AggregationBits: _bits(4, 0, 2, 3),
+				Data:            data,
+				Signature:       chain.sign(dataRoot, attesterDomain, 0, 0, 2, 3),
+			},
+			verified: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := service.VerifyAttestation(ctx, test.attestation)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+)
+
+// dst is the domain separation tag for Ethereum 2 signatures, which use the proof of possession scheme.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// VerifySignature verifies a signature of a root by a single public key.
+func VerifySignature(pubKey spec.BLSPubKey, root spec.Root, signature spec.BLSSignature) (bool, error) {
+	key, err := decompressPubKey(pubKey)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid public key")
+	}
+	return verify([]*bls.PointG1{key}, root, signature)
+}
+
+// VerifyAggregateSignature verifies an aggregate signature of a root by multiple public keys.
+// This is the FastAggregateVerify function of the Ethereum 2 specification.
+func VerifyAggregateSignature(pubKeys []spec.BLSPubKey, root spec.Root, signature spec.BLSSignature) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, errors.New("no public keys supplied")
+	}
+	keys := make([]*bls.PointG1, len(pubKeys))
+	for i := range pubKeys {
+		key, err := decompressPubKey(pubKeys[i])
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid public key %d", i)
+		}
+		keys[i] = key
+	}
+	return verify(keys, root, signature)
+}
+
+// verify verifies a signature of a root against the aggregate of the supplied public keys.
+func verify(pubKeys []*bls.PointG1, root spec.Root, signature spec.BLSSignature) (bool, error) {
+	if len(pubKeys) == 0 {
+		return false, errors.New("no public keys supplied")
+	}
+
+	g2 := bls.NewG2()
+	sig, err := g2.FromCompressed(signature[:])
+	if err != nil {
+		// An undecodable signature is an invalid signature rather than an error in verification.
+		return false, nil
+	}
+
+	g1 := bls.NewG1()
+	aggregateKey := g1.Zero()
+	for _, pubKey := range pubKeys {
+		g1.Add(aggregateKey, aggregateKey, pubKey)
+	}
+
+	msg, err := g2.HashToCurve(root[:], dst)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to hash root to curve")
+	}
+
+	// e(pk, H(m)) == e(g1, sig)
+	engine := bls.NewEngine()
+	engine.AddPairInv(g1.One(), sig)
+	engine.AddPair(aggregateKey, msg)
+	return engine.Check(), nil
+}
+
+// decompressPubKey decompresses a public key, ensuring that it is valid.
+func decompressPubKey(pubKey spec.BLSPubKey) (*bls.PointG1, error) {
+	g1 := bls.NewG1()
+	key, err := g1.FromCompressed(pubKey[:])
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(key) {
+		return nil, errors.New("public key is the point at infinity")
+	}
+	return key, nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/verify"
+	"github.com/stretchr/testify/require"
+)
+
+func _pubKey(input string) spec.BLSPubKey {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	var res spec.BLSPubKey
+	copy(res[:], data)
+	return res
+}
+
+func _signature(input string) spec.BLSSignature {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	var res spec.BLSSignature
+	copy(res[:], data)
+	return res
+}
+
+func _root(input string) spec.Root {
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		panic(err)
+	}
+	var res spec.Root
+	copy(res[:], data)
+	return res
+}
+
+func TestVerifySignature(t *testing.T) {
+	tests := []struct {
+		name      string
+		pubKey    spec.BLSPubKey
+		root      spec.Root
+		signature spec.BLSSignature
+		verified  bool
+		err       string
+	}{
+		{
+			name:   "PubKeyInvalid",
+			pubKey: spec.BLSPubKey{},
+			root:   _root("0xabababababababababababababababababababababababababababababababab"),
+			err:    "invalid public key: compression flag must be set",
+		},
+		{
+			name:      "PubKeyInfinity",
+			pubKey:    _pubKey("0xc00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+			root:      _root("0xabababababababababababababababababababababababababababababababab"),
+			signature: _signature("0xae82747ddeefe4fd64cf9cedb9b04ae3e8a43420cd255e3c7cd06a8d88b7c7f8638543719981c5d16fa3527c468c25f0026704a6951bde891360c7e8d12ddee0559004ccdbe6046b55bae1b257ee97f7cdb955773d7cf29adf3ccbb9975e4eb9"),
+			err:       "invalid public key: public key is the point at infinity",
+		},
+		{
+			name:      "SignatureInvalid",
+			pubKey:    _pubKey("0xb53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f"),
+			root:      _root("0xabababababababababababababababababababababababababababababababab"),
+			signature: spec.BLSSignature{},
+			verified:  false,
+		},
+		{
+			name:      "RootIncorrect",
+			pubKey:    _pubKey("0xb53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f"),
+			root:      _root("0x5656565656565656565656565656565656565656565656565656565656565656"),
+			signature: _signature("0xae82747ddeefe4fd64cf9cedb9b04ae3e8a43420cd255e3c7cd06a8d88b7c7f8638543719981c5d16fa3527c468c25f0026704a6951bde891360c7e8d12ddee0559004ccdbe6046b55bae1b257ee97f7cdb955773d7cf29adf3ccbb9975e4eb9"),
+			verified:  false,
+		},
+		{
+			name:      "Good",
+			pubKey:    _pubKey("0xb53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f"),
+			root:      _root("0xabababababababababababababababababababababababababababababababab"),
+			signature: _signature("0xae82747ddeefe4fd64cf9cedb9b04ae3e8a43420cd255e3c7cd06a8d88b7c7f8638543719981c5d16fa3527c468c25f0026704a6951bde891360c7e8d12ddee0559004ccdbe6046b55bae1b257ee97f7cdb955773d7cf29adf3ccbb9975e4eb9"),
+			verified:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := verify.VerifySignature(test.pubKey, test.root, test.signature)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
+
+func TestVerifyAggregateSignature(t *testing.T) {
+	chain := newTestChain(4)
+	root := _root("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f")
+	domainType := spec.DomainType{0x01, 0x00, 0x00, 0x00}
+	domain, err := chain.Domain(context.Background(), domainType, 0)
+	require.NoError(t, err)
+	signingRoot, err := verify.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		pubKeys   []spec.BLSPubKey
+		signature spec.BLSSignature
+		verified  bool
+		err       string
+	}{
+		{
+			name: "PubKeysMissing",
+			err:  "no public keys supplied",
+		},
+		{
+			name:      "PubKeysMismatch",
+			pubKeys:   []spec.BLSPubKey{chain.pubKeys[0], chain.pubKeys[1], chain.pubKeys[2]},
+			signature: chain.sign(root, domainType, 0, 0, 1, 3),
+			verified:  false,
+		},
+		{
+			name:      "Single",
+			pubKeys:   []spec.BLSPubKey{chain.pubKeys[2]},
+			signature: chain.sign(root, domainType, 0, 2),
+			verified:  true,
+		},
+		{
+			name:      "Good",
+			pubKeys:   []spec.BLSPubKey{chain.pubKeys[0], chain.pubKeys[1], chain.pubKeys[3]},
+			signature: chain.sign(root, domainType, 0, 0, 1, 3),
+			verified:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := verify.VerifyAggregateSignature(test.pubKeys, signingRoot, test.signature)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"math/big"
+
+	api "github.com/attestantio/go-eth2-client/api/v1"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/attestantio/go-eth2-client/verify"
+	bls "github.com/kilic/bls12-381"
+)
+
+// testChain provides the information required by the verification service for a small set of validators.
+type testChain struct {
+	secretKeys map[spec.ValidatorIndex]*big.Int
+	pubKeys    map[spec.ValidatorIndex]spec.BLSPubKey
+	committees []*api.BeaconCommittee
+}
+
+func newTestChain(validators int) *testChain {
+	c := &testChain{
+		secretKeys: make(map[spec.ValidatorIndex]*big.Int),
+		pubKeys:    make(map[spec.ValidatorIndex]spec.BLSPubKey),
+	}
+	g1 := bls.NewG1()
+	for i := 0; i < validators; i++ {
+		index := spec.ValidatorIndex(i)
+		c.secretKeys[index] = big.NewInt(int64(1000 + i))
+		pubKey := g1.New()
+		g1.MulScalarBig(pubKey, g1.One(), c.secretKeys[index])
+		var compressed spec.BLSPubKey
+		copy(compressed[:], g1.ToCompressed(pubKey))
+		c.pubKeys[index] = compressed
+	}
+	// A single committee at slot 1 index 0 containing all validators.
+	committee := &api.BeaconCommittee{
+		Slot:       1,
+		Index:      0,
+		Validators: make([]spec.ValidatorIndex, validators),
+	}
+	for i := 0; i < validators; i++ {
+		committee.Validators[i] = spec.ValidatorIndex(i)
+	}
+	c.committees = []*api.BeaconCommittee{committee}
+	return c
+}
+
+// sign signs a root with the given domain type by the given validators, aggregating the result.
+func (c *testChain) sign(root spec.Root, domainType spec.DomainType, epoch spec.Epoch, indices ...spec.ValidatorIndex) spec.BLSSignature {
+	domain, err := c.Domain(context.Background(), domainType, epoch)
+	if err != nil {
+		panic(err)
+	}
+	signingRoot, err := verify.ComputeSigningRoot(root, domain)
+	if err != nil {
+		panic(err)
+	}
+	g2 := bls.NewG2()
+	msg, err := g2.HashToCurve(signingRoot[:], []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"))
+	if err != nil {
+		panic(err)
+	}
+	aggregate := g2.Zero()
+	for _, index := range indices {
+		sig := g2.New()
+		g2.MulScalarBig(sig, msg, c.secretKeys[index])
+		g2.Add(aggregate, aggregate, sig)
+	}
+	var res spec.BLSSignature
+	copy(res[:], g2.ToCompressed(aggregate))
+	return res
+}
+
+func (c *testChain) Validators(ctx context.Context, stateID string, validatorIndices []spec.ValidatorIndex) (map[spec.ValidatorIndex]*api.Validator, error) {
+	res := make(map[spec.ValidatorIndex]*api.Validator)
+	for _, index := range validatorIndices {
+		if pubKey, exists := c.pubKeys[index]; exists {
+			res[index] = &api.Validator{
+				Index:     index,
+				Status:    api.ValidatorStateActiveOngoing,
+				Validator: &spec.Validator{PublicKey: pubKey},
+			}
+		}
+	}
+	return res, nil
+}
+
+func (c *testChain) ValidatorsByPubKey(ctx context.Context, stateID string, validatorPubKeys []spec.BLSPubKey) (map[spec.ValidatorIndex]*api.Validator, error) {
+	return nil, nil
+}
+
+func (c *testChain) BeaconCommittees(ctx context.Context, stateID string) ([]*api.BeaconCommittee, error) {
+	return c.committees, nil
+}
+
+func (c *testChain) SlotsPerEpoch(ctx context.Context) (uint64, error) {
+	return 32, nil
+}
+
+func (c *testChain) Domain(ctx context.Context, domainType spec.DomainType, epoch spec.Epoch) (spec.Domain, error) {
+	return verify.ComputeDomain(domainType, spec.Version{0x00, 0x00, 0x00, 0x01}, spec.Root{0x01})
+}
+
+func (c *testChain) BeaconProposerDomain(ctx context.Context) (spec.DomainType, error) {
+	return spec.DomainType{0x00, 0x00, 0x00, 0x00}, nil
+}
+
+func (c *testChain) BeaconAttesterDomain(ctx context.Context) (spec.DomainType, error) {
+	return spec.DomainType{0x01, 0x00, 0x00, 0x00}, nil
+}
+
+func (c *testChain) VoluntaryExitDomain(ctx context.Context) (spec.DomainType, error) {
+	return spec.DomainType{0x04, 0x00, 0x00, 0x00}, nil
+}
+
+func (c *testChain) SelectionProofDomain(ctx context.Context) (spec.DomainType, error) {
+	return spec.DomainType{0x05, 0x00, 0x00, 0x00}, nil
+}
+
+func (c *testChain) AggregateAndProofDomain(ctx context.Context) (spec.DomainType, error) {
+	return spec.DomainType{0x06, 0x00, 0x00, 0x00}, nil
+}
+
+// newTestService creates a verification service backed by the test chain.
+func newTestService(chain *testChain) (*verify.Service, error) {
+	return verify.New(context.Background(),
+		verify.WithValidatorsProvider(chain),
+		verify.WithBeaconCommitteesProvider(chain),
+		verify.WithSlotsPerEpochProvider(chain),
+		verify.WithDomainProvider(chain),
+		verify.WithBeaconProposerDomainProvider(chain),
+		verify.WithBeaconAttesterDomainProvider(chain),
+		verify.WithSelectionProofDomainProvider(chain),
+		verify.WithAggregateAndProofDomainProvider(chain),
+		verify.WithVoluntaryExitDomainProvider(chain),
+	)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+type parameters struct {
+	logLevel                        zerolog.Level
+	logger                          zerolog.Logger
+	validatorsProvider              eth2client.ValidatorsProvider
+	beaconCommitteesProvider        eth2client.BeaconCommitteesProvider
+	slotsPerEpochProvider           eth2client.SlotsPerEpochProvider
+	domainProvider                  eth2client.DomainProvider
+	beaconProposerDomainProvider    eth2client.BeaconProposerDomainProvider
+	beaconAttesterDomainProvider    eth2client.BeaconAttesterDomainProvider
+	selectionProofDomainProvider    eth2client.SelectionProofDomainProvider
+	aggregateAndProofDomainProvider eth2client.AggregateAndProofDomainProvider
+	voluntaryExitDomainProvider     eth2client.VoluntaryExitDomainProvider
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(*parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithLogger sets the logger for the module, allowing callers to add their own fields.
+// Its level is used unless a level is set with a later WithLogLevel().
+func WithLogger(logger zerolog.Logger) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logger = logger
+		p.logLevel = logger.GetLevel()
+	})
+}
+
+// WithValidatorsProvider sets the provider used to resolve validator indices to public keys.
+func WithValidatorsProvider(provider eth2client.ValidatorsProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorsProvider = provider
+	})
+}
+
+// WithBeaconCommitteesProvider sets the provider used to resolve attestation committees.
+func WithBeaconCommitteesProvider(provider eth2client.BeaconCommitteesProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconCommitteesProvider = provider
+	})
+}
+
+// WithSlotsPerEpochProvider sets the slots per epoch provider.
+func WithSlotsPerEpochProvider(provider eth2client.SlotsPerEpochProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotsPerEpochProvider = provider
+	})
+}
+
+// WithDomainProvider sets the provider used to calculate signature domains.
+func WithDomainProvider(provider eth2client.DomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.domainProvider = provider
+	})
+}
+
+// WithBeaconProposerDomainProvider sets the beacon proposer domain provider.
+func WithBeaconProposerDomainProvider(provider eth2client.BeaconProposerDomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconProposerDomainProvider = provider
+	})
+}
+
+// WithBeaconAttesterDomainProvider sets the beacon attester domain provider.
+func WithBeaconAttesterDomainProvider(provider eth2client.BeaconAttesterDomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.beaconAttesterDomainProvider = provider
+	})
+}
+
+// WithSelectionProofDomainProvider sets the selection proof domain provider.
+func WithSelectionProofDomainProvider(provider eth2client.SelectionProofDomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.selectionProofDomainProvider = provider
+	})
+}
+
+// WithAggregateAndProofDomainProvider sets the aggregate and proof domain provider.
+func WithAggregateAndProofDomainProvider(provider eth2client.AggregateAndProofDomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.aggregateAndProofDomainProvider = provider
+	})
+}
+
+// WithVoluntaryExitDomainProvider sets the voluntary exit domain provider.
+func WithVoluntaryExitDomainProvider(provider eth2client.VoluntaryExitDomainProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.voluntaryExitDomainProvider = provider
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel: zerolog.GlobalLevel(),
+		logger:   zerologger.Logger,
+	}
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.validatorsProvider == nil {
+		return nil, errors.New("no validators provider specified")
+	}
+	if parameters.beaconCommitteesProvider == nil {
+		return nil, errors.New("no beacon committees provider specified")
+	}
+	if parameters.slotsPerEpochProvider == nil {
+		return nil, errors.New("no slots per epoch provider specified")
+	}
+	if parameters.domainProvider == nil {
+		return nil, errors.New("no domain provider specified")
+	}
+	if parameters.beaconProposerDomainProvider == nil {
+		return nil, errors.New("no beacon proposer domain provider specified")
+	}
+	if parameters.beaconAttesterDomainProvider == nil {
+		return nil, errors.New("no beacon attester domain provider specified")
+	}
+	if parameters.selectionProofDomainProvider == nil {
+		return nil, errors.New("no selection proof domain provider specified")
+	}
+	if parameters.aggregateAndProofDomainProvider == nil {
+		return nil, errors.New("no aggregate and proof domain provider specified")
+	}
+	if parameters.voluntaryExitDomainProvider == nil {
+		return nil, errors.New("no voluntary exit domain provider specified")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,170 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+	"sync"
+
+	eth2client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/attestations"
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// Service is a signature verification service.
+type Service struct {
+	log                zerolog.Logger
+	validatorsProvider eth2client.ValidatorsProvider
+	domainProvider     eth2client.DomainProvider
+	attestations       *attestations.Service
+
+	// Values that do not change over the lifetime of the chain.
+	slotsPerEpoch           uint64
+	beaconProposerDomain    spec.DomainType
+	beaconAttesterDomain    spec.DomainType
+	selectionProofDomain    spec.DomainType
+	aggregateAndProofDomain spec.DomainType
+	voluntaryExitDomain     spec.DomainType
+
+	// Public keys do not change for a given validator index, so we keep
+	// the decompressed keys to avoid repeated lookups and decompression.
+	pubKeys   map[spec.ValidatorIndex]*bls.PointG1
+	pubKeysMu sync.RWMutex
+}
+
+// New creates a new signature verification service.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := parameters.logger.With().Str("service", "verify").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	slotsPerEpoch, err := parameters.slotsPerEpochProvider.SlotsPerEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain slots per epoch")
+	}
+	beaconProposerDomain, err := parameters.beaconProposerDomainProvider.BeaconProposerDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon proposer domain")
+	}
+	beaconAttesterDomain, err := parameters.beaconAttesterDomainProvider.BeaconAttesterDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain beacon attester domain")
+	}
+	selectionProofDomain, err := parameters.selectionProofDomainProvider.SelectionProofDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain selection proof domain")
+	}
+	aggregateAndProofDomain, err := parameters.aggregateAndProofDomainProvider.AggregateAndProofDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain aggregate and proof domain")
+	}
+	voluntaryExitDomain, err := parameters.voluntaryExitDomainProvider.VoluntaryExitDomain(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain voluntary exit domain")
+	}
+
+	attestationsSvc, err := attestations.New(ctx,
+		attestations.WithLogger(parameters.logger),
+		attestations.WithLogLevel(parameters.logLevel),
+		attestations.WithBeaconCommitteesProvider(parameters.beaconCommitteesProvider),
+		attestations.WithSlotsPerEpochProvider(parameters.slotsPerEpochProvider),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create attestations service")
+	}
+
+	s := &Service{
+		log:                     log,
+		validatorsProvider:      parameters.validatorsProvider,
+		domainProvider:          parameters.domainProvider,
+		attestations:            attestationsSvc,
+		slotsPerEpoch:           slotsPerEpoch,
+		beaconProposerDomain:    beaconProposerDomain,
+		beaconAttesterDomain:    beaconAttesterDomain,
+		selectionProofDomain:    selectionProofDomain,
+		aggregateAndProofDomain: aggregateAndProofDomain,
+		voluntaryExitDomain:     voluntaryExitDomain,
+		pubKeys:                 make(map[spec.ValidatorIndex]*bls.PointG1),
+	}
+
+	return s, nil
+}
+
+// signingRoot calculates the signing root for an object root with the given domain type at the given epoch.
+func (s *Service) signingRoot(ctx context.Context, objectRoot spec.Root, domainType spec.DomainType, epoch spec.Epoch) (spec.Root, error) {
+	domain, err := s.domainProvider.Domain(ctx, domainType, epoch)
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to obtain domain")
+	}
+	return ComputeSigningRoot(objectRoot, domain)
+}
+
+// pubKeysForIndices resolves validator indices to decompressed public keys.
+func (s *Service) pubKeysForIndices(ctx context.Context, indices []spec.ValidatorIndex) ([]*bls.PointG1, error) {
+	res := make([]*bls.PointG1, len(indices))
+
+	// Start by filling in all the keys we already know, and making a note of those we don't.
+	unknownIndices := make([]spec.ValidatorIndex, 0)
+	s.pubKeysMu.RLock()
+	for i, index := range indices {
+		if pubKey, exists := s.pubKeys[index]; exists {
+			res[i] = pubKey
+		} else {
+			unknownIndices = append(unknownIndices, index)
+		}
+	}
+	s.pubKeysMu.RUnlock()
+
+	if len(unknownIndices) == 0 {
+		// We know all of them.
+		return res, nil
+	}
+
+	s.log.Trace().Int("validators", len(unknownIndices)).Msg("Fetching unknown public keys")
+	validators, err := s.validatorsProvider.Validators(ctx, "head", unknownIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain validators")
+	}
+
+	s.pubKeysMu.Lock()
+	for _, index := range unknownIndices {
+		validator, exists := validators[index]
+		if !exists || validator.Validator == nil {
+			s.pubKeysMu.Unlock()
+			return nil, errors.Errorf("unknown validator %d", index)
+		}
+		pubKey, err := decompressPubKey(validator.Validator.PublicKey)
+		if err != nil {
+			s.pubKeysMu.Unlock()
+			return nil, errors.Wrapf(err, "invalid public key for validator %d", index)
+		}
+		s.pubKeys[index] = pubKey
+	}
+	for i, index := range indices {
+		res[i] = s.pubKeys[index]
+	}
+	s.pubKeysMu.Unlock()
+
+	return res, nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/verify"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	chain := newTestChain(1)
+
+	tests := []struct {
+		name   string
+		params []verify.Parameter
+		err    string
+	}{
+		{
+			name: "ValidatorsProviderMissing",
+			params: []verify.Parameter{
+				verify.WithLogLevel(zerolog.Disabled),
+				verify.WithBeaconCommitteesProvider(chain),
+				verify.WithSlotsPerEpochProvider(chain),
+				verify.WithDomainProvider(chain),
+				verify.WithBeaconProposerDomainProvider(chain),
+				verify.WithBeaconAttesterDomainProvider(chain),
+				verify.WithSelectionProofDomainProvider(chain),
+				verify.WithAggregateAndProofDomainProvider(chain),
+				verify.WithVoluntaryExitDomainProvider(chain),
+			},
+			err: "problem with parameters: no validators provider specified",
+		},
+		{
+			name: "BeaconCommitteesProviderMissing",
+			params: []verify.Parameter{
+				verify.WithLogLevel(zerolog.Disabled),
+				verify.WithValidatorsProvider(chain),
+				verify.WithSlotsPerEpochProvider(chain),
+				verify.WithDomainProvider(chain),
+				verify.WithBeaconProposerDomainProvider(chain),
+				verify.WithBeaconAttesterDomainProvider(chain),
+				verify.WithSelectionProofDomainProvider(chain),
+				verify.WithAggregateAndProofDomainProvider(chain),
+				verify.WithVoluntaryExitDomainProvider(chain),
+			},
+			err: "problem with parameters: no beacon committees provider specified",
+		},
+		{
+			name: "DomainProviderMissing",
+			params: []verify.Parameter{
+				verify.WithLogLevel(zerolog.Disabled),
+				verify.WithValidatorsProvider(chain),
+				verify.WithBeaconCommitteesProvider(chain),
+				verify.WithSlotsPerEpochProvider(chain),
+				verify.WithBeaconProposerDomainProvider(chain),
+				verify.WithBeaconAttesterDomainProvider(chain),
+				verify.WithSelectionProofDomainProvider(chain),
+				verify.WithAggregateAndProofDomainProvider(chain),
+				verify.WithVoluntaryExitDomainProvider(chain),
+			},
+			err: "problem with parameters: no domain provider specified",
+		},
+		{
+			name: "Good",
+			params: []verify.Parameter{
+				verify.WithLogLevel(zerolog.Disabled),
+				verify.WithValidatorsProvider(chain),
+				verify.WithBeaconCommitteesProvider(chain),
+				verify.WithSlotsPerEpochProvider(chain),
+				verify.WithDomainProvider(chain),
+				verify.WithBeaconProposerDomainProvider(chain),
+				verify.WithBeaconAttesterDomainProvider(chain),
+				verify.WithSelectionProofDomainProvider(chain),
+				verify.WithAggregateAndProofDomainProvider(chain),
+				verify.WithVoluntaryExitDomainProvider(chain),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := verify.New(context.Background(), test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
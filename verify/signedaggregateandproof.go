@@ -0,0 +1,82 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+)
+
+// VerifySignedAggregateAndProof verifies all signatures of a signed aggregate and proof.
+// This includes the aggregator's signature, the aggregator's selection proof and the
+// aggregate signature of the attestation.
+func (s *Service) VerifySignedAggregateAndProof(ctx context.Context, aggregateAndProof *spec.SignedAggregateAndProof) (bool, error) {
+	if aggregateAndProof == nil {
+		return false, errors.New("no aggregate and proof supplied")
+	}
+	if aggregateAndProof.Message == nil {
+		return false, errors.New("no aggregate and proof message supplied")
+	}
+	if aggregateAndProof.Message.Aggregate == nil {
+		return false, errors.New("no aggregate supplied")
+	}
+	if aggregateAndProof.Message.Aggregate.Data == nil {
+		return false, errors.New("no aggregate data supplied")
+	}
+
+	message := aggregateAndProof.Message
+	epoch := spec.Epoch(uint64(message.Aggregate.Data.Slot) / s.slotsPerEpoch)
+	pubKeys, err := s.pubKeysForIndices(ctx, []spec.ValidatorIndex{message.AggregatorIndex})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain aggregator public key")
+	}
+
+	// Selection proof.
+	root, err := s.signingRoot(ctx, slotRoot(message.Aggregate.Data.Slot), s.selectionProofDomain, epoch)
+	if err != nil {
+		return false, err
+	}
+	verified, err := verify([]*bls.PointG1{pubKeys[0]}, root, message.SelectionProof)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to verify selection proof")
+	}
+	if !verified {
+		s.log.Trace().Uint64("aggregator_index", uint64(message.AggregatorIndex)).Msg("Selection proof invalid")
+		return false, nil
+	}
+
+	// Aggregator signature.
+	objectRoot, err := message.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate aggregate and proof root")
+	}
+	root, err = s.signingRoot(ctx, objectRoot, s.aggregateAndProofDomain, epoch)
+	if err != nil {
+		return false, err
+	}
+	verified, err = verify([]*bls.PointG1{pubKeys[0]}, root, aggregateAndProof.Signature)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to verify aggregator signature")
+	}
+	if !verified {
+		s.log.Trace().Uint64("aggregator_index", uint64(message.AggregatorIndex)).Msg("Aggregator signature invalid")
+		return false, nil
+	}
+
+	// Aggregate attestation.
+	return s.VerifyAttestation(ctx, message.Aggregate)
+}
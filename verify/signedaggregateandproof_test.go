@@ -0,0 +1,156 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignedAggregateAndProof(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(4)
+	service, err := newTestService(chain)
+	require.NoError(t, err)
+
+	data := &spec.AttestationData{
+		Slot:            1,
+		Index:           0,
+		BeaconBlockRoot: _root("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"),
+		Source: &spec.Checkpoint{
+			Epoch: 0,
+			Root:  _root("0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"),
+		},
+		Target: &spec.Checkpoint{
+			Epoch: 0,
+			Root:  _root("0x404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f"),
+		},
+	}
+	dataRoot, err := data.HashTreeRoot()
+	require.NoError(t, err)
+	attesterDomain, err := chain.BeaconAttesterDomain(ctx)
+	require.NoError(t, err)
+	selectionProofDomain, err := chain.SelectionProofDomain(ctx)
+	require.NoError(t, err)
+	aggregateAndProofDomain, err := chain.AggregateAndProofDomain(ctx)
+	require.NoError(t, err)
+
+	var slotRoot spec.Root
+	binary.LittleEndian.PutUint64(slotRoot[:], 1)
+
+	// Build an aggregate and proof from aggregator 1 with a valid aggregate and selection proof.
+	aggregateAndProof := &spec.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &spec.Attestation{
+			AggregationBits: _bits(4, 0, 1, 3),
+			Data:            data,
+			Signature:       chain.sign(dataRoot, attesterDomain, 0, 0, 1, 3),
+		},
+		SelectionProof: chain.sign(slotRoot, selectionProofDomain, 0, 1),
+	}
+	aggregateAndProofRoot, err := aggregateAndProof.HashTreeRoot()
+	require.NoError(t, err)
+
+	badSelectionProof := &spec.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate:       aggregateAndProof.Aggregate,
+		SelectionProof:  chain.sign(slotRoot, selectionProofDomain, 0, 2),
+	}
+	badSelectionProofRoot, err := badSelectionProof.HashTreeRoot()
+	require.NoError(t, err)
+
+	badAggregate := &spec.AggregateAndProof{
+		AggregatorIndex: 1,
+		Aggregate: &spec.Attestation{
+			AggregationBits: _bits(4, 0, 1),
+			Data:            data,
+			Signature:       chain.sign(dataRoot, attesterDomain, 0, 0, 1, 3),
+		},
+		SelectionProof: aggregateAndProof.SelectionProof,
+	}
+	badAggregateRoot, err := badAggregate.HashTreeRoot()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		aggregateAndProof *spec.SignedAggregateAndProof
+		verified          bool
+		err               string
+	}{
+		{
+			name: "Nil",
+			err:  "no aggregate and proof supplied",
+		},
+		{
+			name:              "MessageMissing",
+			aggregateAndProof: &spec.SignedAggregateAndProof{},
+			err:               "no aggregate and proof message supplied",
+		},
+		{
+			name: "AggregateMissing",
+			aggregateAndProof: &spec.SignedAggregateAndProof{
+				Message: &spec.AggregateAndProof{},
+			},
+			err: "no aggregate supplied",
+		},
+		{
+			name: "SelectionProofInvalid",
+			aggregateAndProof: &spec.SignedAggregateAndProof{
+				Message:   badSelectionProof,
+				Signature: chain.sign(badSelectionProofRoot, aggregateAndProofDomain, 0, 1),
+			},
+			verified: false,
+		},
+		{
+			name: "SignatureInvalid",
+			aggregateAndProof: &spec.SignedAggregateAndProof{
+				Message:   aggregateAndProof,
+				Signature: chain.sign(aggregateAndProofRoot, aggregateAndProofDomain, 0, 2),
+			},
+			verified: false,
+		},
+		{
+			name: "AggregateInvalid",
+			aggregateAndProof: &spec.SignedAggregateAndProof{
+				Message:   badAggregate,
+				Signature: chain.sign(badAggregateRoot, aggregateAndProofDomain, 0, 1),
+			},
+			verified: false,
+		},
+		{
+			name: "Good",
+			aggregateAndProof: &spec.SignedAggregateAndProof{
+				Message:   aggregateAndProof,
+				Signature: chain.sign(aggregateAndProofRoot, aggregateAndProofDomain, 0, 1),
+			},
+			verified: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := service.VerifySignedAggregateAndProof(ctx, test.aggregateAndProof)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+)
+
+// VerifySignedBeaconBlock verifies the proposer signature of a signed beacon block.
+func (s *Service) VerifySignedBeaconBlock(ctx context.Context, block *spec.SignedBeaconBlock) (bool, error) {
+	if block == nil {
+		return false, errors.New("no block supplied")
+	}
+	if block.Message == nil {
+		return false, errors.New("no block message supplied")
+	}
+
+	objectRoot, err := block.Message.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate block root")
+	}
+	epoch := spec.Epoch(uint64(block.Message.Slot) / s.slotsPerEpoch)
+	root, err := s.signingRoot(ctx, objectRoot, s.beaconProposerDomain, epoch)
+	if err != nil {
+		return false, err
+	}
+
+	pubKeys, err := s.pubKeysForIndices(ctx, []spec.ValidatorIndex{block.Message.ProposerIndex})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain proposer public key")
+	}
+
+	return verify([]*bls.PointG1{pubKeys[0]}, root, block.Signature)
+}
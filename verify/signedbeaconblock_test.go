@@ -0,0 +1,99 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignedBeaconBlock(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(4)
+	service, err := newTestService(chain)
+	require.NoError(t, err)
+
+	block := &spec.BeaconBlock{
+		Slot:          40,
+		ProposerIndex: 2,
+		ParentRoot:    _root("0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"),
+		StateRoot:     _root("0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f"),
+		Body: &spec.BeaconBlockBody{
+			ETH1Data: &spec.ETH1Data{
+				BlockHash: make([]byte, 32),
+			},
+			Graffiti: make([]byte, 32),
+		},
+	}
+	blockRoot, err := block.HashTreeRoot()
+	require.NoError(t, err)
+	proposerDomain, err := chain.BeaconProposerDomain(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		block    *spec.SignedBeaconBlock
+		verified bool
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no block supplied",
+		},
+		{
+			name:  "MessageMissing",
+			block: &spec.SignedBeaconBlock{},
+			err:   "no block message supplied",
+		},
+		{
+			name: "WrongProposer",
+			block: &spec.SignedBeaconBlock{
+				Message:   block,
+				Signature: chain.sign(blockRoot, proposerDomain, 1, 1),
+			},
+			verified: false,
+		},
+		{
+			name: "WrongDomain",
+			block: &spec.SignedBeaconBlock{
+				Message:   block,
+				Signature: chain.sign(blockRoot, spec.DomainType{0x01, 0x00, 0x00, 0x00}, 1, 2),
+			},
+			verified: false,
+		},
+		{
+			name: "Good",
+			block: &spec.SignedBeaconBlock{
+				Message:   block,
+				Signature: chain.sign(blockRoot, proposerDomain, 1, 2),
+			},
+			verified: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := service.VerifySignedBeaconBlock(ctx, test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"context"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	bls "github.com/kilic/bls12-381"
+	"github.com/pkg/errors"
+)
+
+// VerifySignedVoluntaryExit verifies the signature of a signed voluntary exit.
+func (s *Service) VerifySignedVoluntaryExit(ctx context.Context, exit *spec.SignedVoluntaryExit) (bool, error) {
+	if exit == nil {
+		return false, errors.New("no voluntary exit supplied")
+	}
+	if exit.Message == nil {
+		return false, errors.New("no voluntary exit message supplied")
+	}
+
+	objectRoot, err := exit.Message.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to calculate voluntary exit root")
+	}
+	root, err := s.signingRoot(ctx, objectRoot, s.voluntaryExitDomain, exit.Message.Epoch)
+	if err != nil {
+		return false, err
+	}
+
+	pubKeys, err := s.pubKeysForIndices(ctx, []spec.ValidatorIndex{exit.Message.ValidatorIndex})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to obtain validator public key")
+	}
+
+	return verify([]*bls.PointG1{pubKeys[0]}, root, exit.Signature)
+}
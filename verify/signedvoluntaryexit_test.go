@@ -0,0 +1,93 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify_test
+
+import (
+	"context"
+	"testing"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignedVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	chain := newTestChain(4)
+	service, err := newTestService(chain)
+	require.NoError(t, err)
+
+	exit := &spec.VoluntaryExit{
+		Epoch:          300,
+		ValidatorIndex: 3,
+	}
+	exitRoot, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	exitDomain, err := chain.VoluntaryExitDomain(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		exit     *spec.SignedVoluntaryExit
+		verified bool
+		err      string
+	}{
+		{
+			name: "Nil",
+			err:  "no voluntary exit supplied",
+		},
+		{
+			name: "MessageMissing",
+			exit: &spec.SignedVoluntaryExit{},
+			err:  "no voluntary exit message supplied",
+		},
+		{
+			name: "ValidatorUnknown",
+			exit: &spec.SignedVoluntaryExit{
+				Message: &spec.VoluntaryExit{
+					Epoch:          300,
+					ValidatorIndex: 10,
+				},
+			},
+			err: "failed to obtain validator public key: unknown validator 10",
+		},
+		{
+			name: "WrongValidator",
+			exit: &spec.SignedVoluntaryExit{
+				Message:   exit,
+				Signature: chain.sign(exitRoot, exitDomain, 300, 2),
+			},
+			verified: false,
+		},
+		{
+			name: "Good",
+			exit: &spec.SignedVoluntaryExit{
+				Message:   exit,
+				Signature: chain.sign(exitRoot, exitDomain, 300, 3),
+			},
+			verified: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := service.VerifySignedVoluntaryExit(ctx, test.exit)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"encoding/binary"
+
+	spec "github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// ComputeDomain computes a signature domain given its type, fork version and genesis validators root.
+func ComputeDomain(domainType spec.DomainType, forkVersion spec.Version, genesisValidatorsRoot spec.Root) (spec.Domain, error) {
+	forkData := &spec.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return spec.Domain{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	var domain spec.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:])
+	return domain, nil
+}
+
+// ComputeSigningRoot computes the root that is signed given an object root and a domain.
+func ComputeSigningRoot(objectRoot spec.Root, domain spec.Domain) (spec.Root, error) {
+	signingData := &spec.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return spec.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+	return root, nil
+}
+
+// slotRoot provides the hash tree root of a slot.
+func slotRoot(slot spec.Slot) spec.Root {
+	var root spec.Root
+	binary.LittleEndian.PutUint64(root[:], uint64(slot))
+	return root
+}